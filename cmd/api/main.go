@@ -7,13 +7,27 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/danielng/kin-core-svc/internal/application/availability"
 	"github.com/danielng/kin-core-svc/internal/application/circle"
+	"github.com/danielng/kin-core-svc/internal/application/contact"
+	"github.com/danielng/kin-core-svc/internal/application/conversation"
+	"github.com/danielng/kin-core-svc/internal/application/location"
+	"github.com/danielng/kin-core-svc/internal/application/messaging"
+	"github.com/danielng/kin-core-svc/internal/application/outbox"
+	"github.com/danielng/kin-core-svc/internal/application/presence"
+	"github.com/danielng/kin-core-svc/internal/application/realtime"
 	"github.com/danielng/kin-core-svc/internal/application/user"
 	"github.com/danielng/kin-core-svc/internal/config"
+	contactDomain "github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/event"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
 	"github.com/danielng/kin-core-svc/internal/infrastructure/auth"
+	"github.com/danielng/kin-core-svc/internal/infrastructure/calendar"
+	"github.com/danielng/kin-core-svc/internal/infrastructure/geocoding"
 	"github.com/danielng/kin-core-svc/internal/infrastructure/postgres"
 	"github.com/danielng/kin-core-svc/internal/infrastructure/redis"
 	"github.com/danielng/kin-core-svc/internal/infrastructure/telemetry"
@@ -91,16 +105,55 @@ func main() {
 
 	userRepo := postgres.NewUserRepository(db)
 	circleRepo := postgres.NewCircleRepository(db)
-	_ = redis.NewPresenceRepository(redisClient)
+	conversationRepo := postgres.NewConversationRepository(db)
+	messageRepo := postgres.NewMessageRepository(db)
+	contactRepo := postgres.NewContactRepository(db)
+	availabilityRepo := postgres.NewAvailabilityRepository(db)
+	locationRepo := postgres.NewLocationRepository(db)
+	notificationRepo := postgres.NewNotificationRepository(db)
+	presenceRepo := redis.NewPresenceRepository(redisClient)
+	liveSessionRepo := redis.NewLiveSessionRepository(redisClient)
+	outboxRepo := postgres.NewOutboxRepository(db)
+	eventBroker := redis.NewEventBroker(redisClient, logger)
 
-	userService := user.NewService(userRepo, logger)
-	circleService := circle.NewService(circleRepo, logger)
+	blockPolicy := contactDomain.NewBlockPolicy(contactRepo)
+	projector := privacy.NewProjector(circleRepo, userRepo, blockPolicy)
+
+	presencePublisher := presence.NewPublisher(presenceRepo, eventBroker, projector, logger)
+	realtimeService := realtime.NewService(eventBroker, conversationRepo, circleRepo, presenceRepo, cfg.Presence.TTL, presencePublisher, logger)
+	userService := user.NewService(userRepo, blockPolicy, logger)
+	circleService := circle.NewService(circleRepo, conversationRepo, realtimeService, db, blockPolicy, logger)
+	messagingService := messaging.NewService(messageRepo, conversationRepo, realtimeService, blockPolicy, logger)
+	conversationService := conversation.NewService(conversationRepo, messageRepo, userRepo, blockPolicy, logger)
+	contactService := contact.NewService(contactRepo, userRepo, db, blockPolicy, logger)
+	availabilityService := availability.NewService(availabilityRepo, userRepo, locationRepo, realtimeService, calendar.NewHTTPFetcher(10*time.Second), db, projector, logger)
+	geocoder, err := geocoding.NewOffline(cfg.Location.GeocoderDataPath)
+	if err != nil {
+		logger.Error("failed to load geocoder", "error", err)
+		os.Exit(1)
+	}
+	enricher := location.NewEnricher(
+		locationRepo,
+		geocoding.NewCached(geocoder, cfg.Location.GeocoderCacheSize, cfg.Location.GeocoderCacheTTL),
+		cfg.Location.GeocoderQueueSize,
+		logger,
+	)
+	locationService := location.NewService(locationRepo, liveSessionRepo, userRepo, circleRepo, conversationRepo, notificationRepo, realtimeService, availabilityService, enricher, db, projector, logger)
+
+	presenceService := presence.NewService(presenceRepo, conversationRepo, realtimeService, presencePublisher, projector, cfg.Presence.TTL, logger)
 
 	server, err := connectServer.NewServer(connectServer.ServerConfig{
-		Logger:         logger,
-		Auth0Validator: auth0Validator,
-		UserService:    userService,
-		CircleService:  circleService,
+		Logger:              logger,
+		Auth0Validator:      auth0Validator,
+		UserService:         userService,
+		CircleService:       circleService,
+		MessagingService:    messagingService,
+		ConversationService: conversationService,
+		RealtimeService:     realtimeService,
+		ContactService:      contactService,
+		AvailabilityService: availabilityService,
+		LocationService:     locationService,
+		PresenceService:     presenceService,
 		BuildInfo: connectServer.BuildInfo{
 			Version:   Version,
 			GitCommit: GitCommit,
@@ -122,6 +175,45 @@ func main() {
 		WriteTimeout: cfg.Server.WriteTimeout,
 	}
 
+	relay := outbox.NewRelay(
+		outboxRepo,
+		[]event.Sink{redis.NewStreamSink(redisClient, cfg.Outbox.StreamName, cfg.Outbox.StreamMaxLen)},
+		cfg.Outbox.PollInterval,
+		cfg.Outbox.BatchSize,
+		cfg.Outbox.Retention,
+		logger,
+	)
+	scheduler := availability.NewScheduler(
+		availabilityService,
+		redis.NewLocker(redisClient),
+		cfg.Availability.SchedulerInterval,
+		cfg.Availability.BatchSize,
+		logger,
+	)
+
+	retention := location.NewRetentionJob(
+		locationService,
+		redis.NewLocker(redisClient),
+		cfg.Location.RetentionInterval,
+		cfg.Location.RetentionBatchSize,
+		logger,
+	)
+
+	liveExpiry := location.NewLiveExpiryJob(
+		locationService,
+		redis.NewLocker(redisClient),
+		cfg.Location.LiveExpiryInterval,
+		logger,
+	)
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	workers.Go(func() { relay.Run(workerCtx) })
+	workers.Go(func() { scheduler.Run(workerCtx) })
+	workers.Go(func() { retention.Run(workerCtx) })
+	workers.Go(func() { liveExpiry.Run(workerCtx) })
+	workers.Go(func() { enricher.Run(workerCtx) })
+
 	errCh := make(chan error, 1)
 
 	go func() {
@@ -152,6 +244,9 @@ func main() {
 		logger.Error("server forced to shutdown", "error", err)
 	}
 
+	stopWorkers()
+	workers.Wait()
+
 	logger.Info("server stopped")
 
 	if exitCode != 0 {
@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: kin/v1/availability.proto
+
+package kinv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AvailabilityServiceName is the fully-qualified name of the AvailabilityService service.
+	AvailabilityServiceName = "kin.v1.AvailabilityService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AvailabilityServiceGetAvailabilityProcedure is the fully-qualified name of the
+	// AvailabilityService's GetAvailability RPC.
+	AvailabilityServiceGetAvailabilityProcedure = "/kin.v1.AvailabilityService/GetAvailability"
+	// AvailabilityServiceSetStatusProcedure is the fully-qualified name of the AvailabilityService's
+	// SetStatus RPC.
+	AvailabilityServiceSetStatusProcedure = "/kin.v1.AvailabilityService/SetStatus"
+	// AvailabilityServiceRevertToAutoStatusProcedure is the fully-qualified name of the
+	// AvailabilityService's RevertToAutoStatus RPC.
+	AvailabilityServiceRevertToAutoStatusProcedure = "/kin.v1.AvailabilityService/RevertToAutoStatus"
+	// AvailabilityServiceListWindowsProcedure is the fully-qualified name of the AvailabilityService's
+	// ListWindows RPC.
+	AvailabilityServiceListWindowsProcedure = "/kin.v1.AvailabilityService/ListWindows"
+	// AvailabilityServiceCreateWindowProcedure is the fully-qualified name of the AvailabilityService's
+	// CreateWindow RPC.
+	AvailabilityServiceCreateWindowProcedure = "/kin.v1.AvailabilityService/CreateWindow"
+	// AvailabilityServiceUpdateWindowProcedure is the fully-qualified name of the AvailabilityService's
+	// UpdateWindow RPC.
+	AvailabilityServiceUpdateWindowProcedure = "/kin.v1.AvailabilityService/UpdateWindow"
+	// AvailabilityServiceDeleteWindowProcedure is the fully-qualified name of the AvailabilityService's
+	// DeleteWindow RPC.
+	AvailabilityServiceDeleteWindowProcedure = "/kin.v1.AvailabilityService/DeleteWindow"
+	// AvailabilityServiceListAutoRulesProcedure is the fully-qualified name of the
+	// AvailabilityService's ListAutoRules RPC.
+	AvailabilityServiceListAutoRulesProcedure = "/kin.v1.AvailabilityService/ListAutoRules"
+	// AvailabilityServiceCreateAutoRuleProcedure is the fully-qualified name of the
+	// AvailabilityService's CreateAutoRule RPC.
+	AvailabilityServiceCreateAutoRuleProcedure = "/kin.v1.AvailabilityService/CreateAutoRule"
+	// AvailabilityServiceUpdateAutoRuleProcedure is the fully-qualified name of the
+	// AvailabilityService's UpdateAutoRule RPC.
+	AvailabilityServiceUpdateAutoRuleProcedure = "/kin.v1.AvailabilityService/UpdateAutoRule"
+	// AvailabilityServiceDeleteAutoRuleProcedure is the fully-qualified name of the
+	// AvailabilityService's DeleteAutoRule RPC.
+	AvailabilityServiceDeleteAutoRuleProcedure = "/kin.v1.AvailabilityService/DeleteAutoRule"
+	// AvailabilityServiceListCalendarsProcedure is the fully-qualified name of the
+	// AvailabilityService's ListCalendars RPC.
+	AvailabilityServiceListCalendarsProcedure = "/kin.v1.AvailabilityService/ListCalendars"
+	// AvailabilityServiceCreateCalendarProcedure is the fully-qualified name of the
+	// AvailabilityService's CreateCalendar RPC.
+	AvailabilityServiceCreateCalendarProcedure = "/kin.v1.AvailabilityService/CreateCalendar"
+	// AvailabilityServiceUpdateCalendarProcedure is the fully-qualified name of the
+	// AvailabilityService's UpdateCalendar RPC.
+	AvailabilityServiceUpdateCalendarProcedure = "/kin.v1.AvailabilityService/UpdateCalendar"
+	// AvailabilityServiceDeleteCalendarProcedure is the fully-qualified name of the
+	// AvailabilityService's DeleteCalendar RPC.
+	AvailabilityServiceDeleteCalendarProcedure = "/kin.v1.AvailabilityService/DeleteCalendar"
+	// AvailabilityServiceSyncCalendarProcedure is the fully-qualified name of the AvailabilityService's
+	// SyncCalendar RPC.
+	AvailabilityServiceSyncCalendarProcedure = "/kin.v1.AvailabilityService/SyncCalendar"
+	// AvailabilityServiceFindBestTimesProcedure is the fully-qualified name of the
+	// AvailabilityService's FindBestTimes RPC.
+	AvailabilityServiceFindBestTimesProcedure = "/kin.v1.AvailabilityService/FindBestTimes"
+)
+
+// AvailabilityServiceClient is a client for the kin.v1.AvailabilityService service.
+type AvailabilityServiceClient interface {
+	GetAvailability(context.Context, *connect.Request[v1.GetAvailabilityRequest]) (*connect.Response[v1.GetAvailabilityResponse], error)
+	SetStatus(context.Context, *connect.Request[v1.SetStatusRequest]) (*connect.Response[v1.SetStatusResponse], error)
+	RevertToAutoStatus(context.Context, *connect.Request[v1.RevertToAutoStatusRequest]) (*connect.Response[v1.RevertToAutoStatusResponse], error)
+	ListWindows(context.Context, *connect.Request[v1.ListWindowsRequest]) (*connect.Response[v1.ListWindowsResponse], error)
+	CreateWindow(context.Context, *connect.Request[v1.CreateWindowRequest]) (*connect.Response[v1.CreateWindowResponse], error)
+	UpdateWindow(context.Context, *connect.Request[v1.UpdateWindowRequest]) (*connect.Response[v1.UpdateWindowResponse], error)
+	DeleteWindow(context.Context, *connect.Request[v1.DeleteWindowRequest]) (*connect.Response[v1.DeleteWindowResponse], error)
+	ListAutoRules(context.Context, *connect.Request[v1.ListAutoRulesRequest]) (*connect.Response[v1.ListAutoRulesResponse], error)
+	CreateAutoRule(context.Context, *connect.Request[v1.CreateAutoRuleRequest]) (*connect.Response[v1.CreateAutoRuleResponse], error)
+	UpdateAutoRule(context.Context, *connect.Request[v1.UpdateAutoRuleRequest]) (*connect.Response[v1.UpdateAutoRuleResponse], error)
+	DeleteAutoRule(context.Context, *connect.Request[v1.DeleteAutoRuleRequest]) (*connect.Response[v1.DeleteAutoRuleResponse], error)
+	ListCalendars(context.Context, *connect.Request[v1.ListCalendarsRequest]) (*connect.Response[v1.ListCalendarsResponse], error)
+	CreateCalendar(context.Context, *connect.Request[v1.CreateCalendarRequest]) (*connect.Response[v1.CreateCalendarResponse], error)
+	UpdateCalendar(context.Context, *connect.Request[v1.UpdateCalendarRequest]) (*connect.Response[v1.UpdateCalendarResponse], error)
+	DeleteCalendar(context.Context, *connect.Request[v1.DeleteCalendarRequest]) (*connect.Response[v1.DeleteCalendarResponse], error)
+	SyncCalendar(context.Context, *connect.Request[v1.SyncCalendarRequest]) (*connect.Response[v1.SyncCalendarResponse], error)
+	FindBestTimes(context.Context, *connect.Request[v1.FindBestTimesRequest]) (*connect.Response[v1.FindBestTimesResponse], error)
+}
+
+// NewAvailabilityServiceClient constructs a client for the kin.v1.AvailabilityService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAvailabilityServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AvailabilityServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	availabilityServiceMethods := v1.File_kin_v1_availability_proto.Services().ByName("AvailabilityService").Methods()
+	return &availabilityServiceClient{
+		getAvailability: connect.NewClient[v1.GetAvailabilityRequest, v1.GetAvailabilityResponse](
+			httpClient,
+			baseURL+AvailabilityServiceGetAvailabilityProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("GetAvailability")),
+			connect.WithClientOptions(opts...),
+		),
+		setStatus: connect.NewClient[v1.SetStatusRequest, v1.SetStatusResponse](
+			httpClient,
+			baseURL+AvailabilityServiceSetStatusProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("SetStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		revertToAutoStatus: connect.NewClient[v1.RevertToAutoStatusRequest, v1.RevertToAutoStatusResponse](
+			httpClient,
+			baseURL+AvailabilityServiceRevertToAutoStatusProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("RevertToAutoStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		listWindows: connect.NewClient[v1.ListWindowsRequest, v1.ListWindowsResponse](
+			httpClient,
+			baseURL+AvailabilityServiceListWindowsProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("ListWindows")),
+			connect.WithClientOptions(opts...),
+		),
+		createWindow: connect.NewClient[v1.CreateWindowRequest, v1.CreateWindowResponse](
+			httpClient,
+			baseURL+AvailabilityServiceCreateWindowProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("CreateWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWindow: connect.NewClient[v1.UpdateWindowRequest, v1.UpdateWindowResponse](
+			httpClient,
+			baseURL+AvailabilityServiceUpdateWindowProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("UpdateWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWindow: connect.NewClient[v1.DeleteWindowRequest, v1.DeleteWindowResponse](
+			httpClient,
+			baseURL+AvailabilityServiceDeleteWindowProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("DeleteWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		listAutoRules: connect.NewClient[v1.ListAutoRulesRequest, v1.ListAutoRulesResponse](
+			httpClient,
+			baseURL+AvailabilityServiceListAutoRulesProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("ListAutoRules")),
+			connect.WithClientOptions(opts...),
+		),
+		createAutoRule: connect.NewClient[v1.CreateAutoRuleRequest, v1.CreateAutoRuleResponse](
+			httpClient,
+			baseURL+AvailabilityServiceCreateAutoRuleProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("CreateAutoRule")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAutoRule: connect.NewClient[v1.UpdateAutoRuleRequest, v1.UpdateAutoRuleResponse](
+			httpClient,
+			baseURL+AvailabilityServiceUpdateAutoRuleProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("UpdateAutoRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAutoRule: connect.NewClient[v1.DeleteAutoRuleRequest, v1.DeleteAutoRuleResponse](
+			httpClient,
+			baseURL+AvailabilityServiceDeleteAutoRuleProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("DeleteAutoRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listCalendars: connect.NewClient[v1.ListCalendarsRequest, v1.ListCalendarsResponse](
+			httpClient,
+			baseURL+AvailabilityServiceListCalendarsProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("ListCalendars")),
+			connect.WithClientOptions(opts...),
+		),
+		createCalendar: connect.NewClient[v1.CreateCalendarRequest, v1.CreateCalendarResponse](
+			httpClient,
+			baseURL+AvailabilityServiceCreateCalendarProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("CreateCalendar")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCalendar: connect.NewClient[v1.UpdateCalendarRequest, v1.UpdateCalendarResponse](
+			httpClient,
+			baseURL+AvailabilityServiceUpdateCalendarProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("UpdateCalendar")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCalendar: connect.NewClient[v1.DeleteCalendarRequest, v1.DeleteCalendarResponse](
+			httpClient,
+			baseURL+AvailabilityServiceDeleteCalendarProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("DeleteCalendar")),
+			connect.WithClientOptions(opts...),
+		),
+		syncCalendar: connect.NewClient[v1.SyncCalendarRequest, v1.SyncCalendarResponse](
+			httpClient,
+			baseURL+AvailabilityServiceSyncCalendarProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("SyncCalendar")),
+			connect.WithClientOptions(opts...),
+		),
+		findBestTimes: connect.NewClient[v1.FindBestTimesRequest, v1.FindBestTimesResponse](
+			httpClient,
+			baseURL+AvailabilityServiceFindBestTimesProcedure,
+			connect.WithSchema(availabilityServiceMethods.ByName("FindBestTimes")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// availabilityServiceClient implements AvailabilityServiceClient.
+type availabilityServiceClient struct {
+	getAvailability    *connect.Client[v1.GetAvailabilityRequest, v1.GetAvailabilityResponse]
+	setStatus          *connect.Client[v1.SetStatusRequest, v1.SetStatusResponse]
+	revertToAutoStatus *connect.Client[v1.RevertToAutoStatusRequest, v1.RevertToAutoStatusResponse]
+	listWindows        *connect.Client[v1.ListWindowsRequest, v1.ListWindowsResponse]
+	createWindow       *connect.Client[v1.CreateWindowRequest, v1.CreateWindowResponse]
+	updateWindow       *connect.Client[v1.UpdateWindowRequest, v1.UpdateWindowResponse]
+	deleteWindow       *connect.Client[v1.DeleteWindowRequest, v1.DeleteWindowResponse]
+	listAutoRules      *connect.Client[v1.ListAutoRulesRequest, v1.ListAutoRulesResponse]
+	createAutoRule     *connect.Client[v1.CreateAutoRuleRequest, v1.CreateAutoRuleResponse]
+	updateAutoRule     *connect.Client[v1.UpdateAutoRuleRequest, v1.UpdateAutoRuleResponse]
+	deleteAutoRule     *connect.Client[v1.DeleteAutoRuleRequest, v1.DeleteAutoRuleResponse]
+	listCalendars      *connect.Client[v1.ListCalendarsRequest, v1.ListCalendarsResponse]
+	createCalendar     *connect.Client[v1.CreateCalendarRequest, v1.CreateCalendarResponse]
+	updateCalendar     *connect.Client[v1.UpdateCalendarRequest, v1.UpdateCalendarResponse]
+	deleteCalendar     *connect.Client[v1.DeleteCalendarRequest, v1.DeleteCalendarResponse]
+	syncCalendar       *connect.Client[v1.SyncCalendarRequest, v1.SyncCalendarResponse]
+	findBestTimes      *connect.Client[v1.FindBestTimesRequest, v1.FindBestTimesResponse]
+}
+
+// GetAvailability calls kin.v1.AvailabilityService.GetAvailability.
+func (c *availabilityServiceClient) GetAvailability(ctx context.Context, req *connect.Request[v1.GetAvailabilityRequest]) (*connect.Response[v1.GetAvailabilityResponse], error) {
+	return c.getAvailability.CallUnary(ctx, req)
+}
+
+// SetStatus calls kin.v1.AvailabilityService.SetStatus.
+func (c *availabilityServiceClient) SetStatus(ctx context.Context, req *connect.Request[v1.SetStatusRequest]) (*connect.Response[v1.SetStatusResponse], error) {
+	return c.setStatus.CallUnary(ctx, req)
+}
+
+// RevertToAutoStatus calls kin.v1.AvailabilityService.RevertToAutoStatus.
+func (c *availabilityServiceClient) RevertToAutoStatus(ctx context.Context, req *connect.Request[v1.RevertToAutoStatusRequest]) (*connect.Response[v1.RevertToAutoStatusResponse], error) {
+	return c.revertToAutoStatus.CallUnary(ctx, req)
+}
+
+// ListWindows calls kin.v1.AvailabilityService.ListWindows.
+func (c *availabilityServiceClient) ListWindows(ctx context.Context, req *connect.Request[v1.ListWindowsRequest]) (*connect.Response[v1.ListWindowsResponse], error) {
+	return c.listWindows.CallUnary(ctx, req)
+}
+
+// CreateWindow calls kin.v1.AvailabilityService.CreateWindow.
+func (c *availabilityServiceClient) CreateWindow(ctx context.Context, req *connect.Request[v1.CreateWindowRequest]) (*connect.Response[v1.CreateWindowResponse], error) {
+	return c.createWindow.CallUnary(ctx, req)
+}
+
+// UpdateWindow calls kin.v1.AvailabilityService.UpdateWindow.
+func (c *availabilityServiceClient) UpdateWindow(ctx context.Context, req *connect.Request[v1.UpdateWindowRequest]) (*connect.Response[v1.UpdateWindowResponse], error) {
+	return c.updateWindow.CallUnary(ctx, req)
+}
+
+// DeleteWindow calls kin.v1.AvailabilityService.DeleteWindow.
+func (c *availabilityServiceClient) DeleteWindow(ctx context.Context, req *connect.Request[v1.DeleteWindowRequest]) (*connect.Response[v1.DeleteWindowResponse], error) {
+	return c.deleteWindow.CallUnary(ctx, req)
+}
+
+// ListAutoRules calls kin.v1.AvailabilityService.ListAutoRules.
+func (c *availabilityServiceClient) ListAutoRules(ctx context.Context, req *connect.Request[v1.ListAutoRulesRequest]) (*connect.Response[v1.ListAutoRulesResponse], error) {
+	return c.listAutoRules.CallUnary(ctx, req)
+}
+
+// CreateAutoRule calls kin.v1.AvailabilityService.CreateAutoRule.
+func (c *availabilityServiceClient) CreateAutoRule(ctx context.Context, req *connect.Request[v1.CreateAutoRuleRequest]) (*connect.Response[v1.CreateAutoRuleResponse], error) {
+	return c.createAutoRule.CallUnary(ctx, req)
+}
+
+// UpdateAutoRule calls kin.v1.AvailabilityService.UpdateAutoRule.
+func (c *availabilityServiceClient) UpdateAutoRule(ctx context.Context, req *connect.Request[v1.UpdateAutoRuleRequest]) (*connect.Response[v1.UpdateAutoRuleResponse], error) {
+	return c.updateAutoRule.CallUnary(ctx, req)
+}
+
+// DeleteAutoRule calls kin.v1.AvailabilityService.DeleteAutoRule.
+func (c *availabilityServiceClient) DeleteAutoRule(ctx context.Context, req *connect.Request[v1.DeleteAutoRuleRequest]) (*connect.Response[v1.DeleteAutoRuleResponse], error) {
+	return c.deleteAutoRule.CallUnary(ctx, req)
+}
+
+// ListCalendars calls kin.v1.AvailabilityService.ListCalendars.
+func (c *availabilityServiceClient) ListCalendars(ctx context.Context, req *connect.Request[v1.ListCalendarsRequest]) (*connect.Response[v1.ListCalendarsResponse], error) {
+	return c.listCalendars.CallUnary(ctx, req)
+}
+
+// CreateCalendar calls kin.v1.AvailabilityService.CreateCalendar.
+func (c *availabilityServiceClient) CreateCalendar(ctx context.Context, req *connect.Request[v1.CreateCalendarRequest]) (*connect.Response[v1.CreateCalendarResponse], error) {
+	return c.createCalendar.CallUnary(ctx, req)
+}
+
+// UpdateCalendar calls kin.v1.AvailabilityService.UpdateCalendar.
+func (c *availabilityServiceClient) UpdateCalendar(ctx context.Context, req *connect.Request[v1.UpdateCalendarRequest]) (*connect.Response[v1.UpdateCalendarResponse], error) {
+	return c.updateCalendar.CallUnary(ctx, req)
+}
+
+// DeleteCalendar calls kin.v1.AvailabilityService.DeleteCalendar.
+func (c *availabilityServiceClient) DeleteCalendar(ctx context.Context, req *connect.Request[v1.DeleteCalendarRequest]) (*connect.Response[v1.DeleteCalendarResponse], error) {
+	return c.deleteCalendar.CallUnary(ctx, req)
+}
+
+// SyncCalendar calls kin.v1.AvailabilityService.SyncCalendar.
+func (c *availabilityServiceClient) SyncCalendar(ctx context.Context, req *connect.Request[v1.SyncCalendarRequest]) (*connect.Response[v1.SyncCalendarResponse], error) {
+	return c.syncCalendar.CallUnary(ctx, req)
+}
+
+// FindBestTimes calls kin.v1.AvailabilityService.FindBestTimes.
+func (c *availabilityServiceClient) FindBestTimes(ctx context.Context, req *connect.Request[v1.FindBestTimesRequest]) (*connect.Response[v1.FindBestTimesResponse], error) {
+	return c.findBestTimes.CallUnary(ctx, req)
+}
+
+// AvailabilityServiceHandler is an implementation of the kin.v1.AvailabilityService service.
+type AvailabilityServiceHandler interface {
+	GetAvailability(context.Context, *connect.Request[v1.GetAvailabilityRequest]) (*connect.Response[v1.GetAvailabilityResponse], error)
+	SetStatus(context.Context, *connect.Request[v1.SetStatusRequest]) (*connect.Response[v1.SetStatusResponse], error)
+	RevertToAutoStatus(context.Context, *connect.Request[v1.RevertToAutoStatusRequest]) (*connect.Response[v1.RevertToAutoStatusResponse], error)
+	ListWindows(context.Context, *connect.Request[v1.ListWindowsRequest]) (*connect.Response[v1.ListWindowsResponse], error)
+	CreateWindow(context.Context, *connect.Request[v1.CreateWindowRequest]) (*connect.Response[v1.CreateWindowResponse], error)
+	UpdateWindow(context.Context, *connect.Request[v1.UpdateWindowRequest]) (*connect.Response[v1.UpdateWindowResponse], error)
+	DeleteWindow(context.Context, *connect.Request[v1.DeleteWindowRequest]) (*connect.Response[v1.DeleteWindowResponse], error)
+	ListAutoRules(context.Context, *connect.Request[v1.ListAutoRulesRequest]) (*connect.Response[v1.ListAutoRulesResponse], error)
+	CreateAutoRule(context.Context, *connect.Request[v1.CreateAutoRuleRequest]) (*connect.Response[v1.CreateAutoRuleResponse], error)
+	UpdateAutoRule(context.Context, *connect.Request[v1.UpdateAutoRuleRequest]) (*connect.Response[v1.UpdateAutoRuleResponse], error)
+	DeleteAutoRule(context.Context, *connect.Request[v1.DeleteAutoRuleRequest]) (*connect.Response[v1.DeleteAutoRuleResponse], error)
+	ListCalendars(context.Context, *connect.Request[v1.ListCalendarsRequest]) (*connect.Response[v1.ListCalendarsResponse], error)
+	CreateCalendar(context.Context, *connect.Request[v1.CreateCalendarRequest]) (*connect.Response[v1.CreateCalendarResponse], error)
+	UpdateCalendar(context.Context, *connect.Request[v1.UpdateCalendarRequest]) (*connect.Response[v1.UpdateCalendarResponse], error)
+	DeleteCalendar(context.Context, *connect.Request[v1.DeleteCalendarRequest]) (*connect.Response[v1.DeleteCalendarResponse], error)
+	SyncCalendar(context.Context, *connect.Request[v1.SyncCalendarRequest]) (*connect.Response[v1.SyncCalendarResponse], error)
+	FindBestTimes(context.Context, *connect.Request[v1.FindBestTimesRequest]) (*connect.Response[v1.FindBestTimesResponse], error)
+}
+
+// NewAvailabilityServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAvailabilityServiceHandler(svc AvailabilityServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	availabilityServiceMethods := v1.File_kin_v1_availability_proto.Services().ByName("AvailabilityService").Methods()
+	availabilityServiceGetAvailabilityHandler := connect.NewUnaryHandler(
+		AvailabilityServiceGetAvailabilityProcedure,
+		svc.GetAvailability,
+		connect.WithSchema(availabilityServiceMethods.ByName("GetAvailability")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceSetStatusHandler := connect.NewUnaryHandler(
+		AvailabilityServiceSetStatusProcedure,
+		svc.SetStatus,
+		connect.WithSchema(availabilityServiceMethods.ByName("SetStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceRevertToAutoStatusHandler := connect.NewUnaryHandler(
+		AvailabilityServiceRevertToAutoStatusProcedure,
+		svc.RevertToAutoStatus,
+		connect.WithSchema(availabilityServiceMethods.ByName("RevertToAutoStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceListWindowsHandler := connect.NewUnaryHandler(
+		AvailabilityServiceListWindowsProcedure,
+		svc.ListWindows,
+		connect.WithSchema(availabilityServiceMethods.ByName("ListWindows")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceCreateWindowHandler := connect.NewUnaryHandler(
+		AvailabilityServiceCreateWindowProcedure,
+		svc.CreateWindow,
+		connect.WithSchema(availabilityServiceMethods.ByName("CreateWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceUpdateWindowHandler := connect.NewUnaryHandler(
+		AvailabilityServiceUpdateWindowProcedure,
+		svc.UpdateWindow,
+		connect.WithSchema(availabilityServiceMethods.ByName("UpdateWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceDeleteWindowHandler := connect.NewUnaryHandler(
+		AvailabilityServiceDeleteWindowProcedure,
+		svc.DeleteWindow,
+		connect.WithSchema(availabilityServiceMethods.ByName("DeleteWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceListAutoRulesHandler := connect.NewUnaryHandler(
+		AvailabilityServiceListAutoRulesProcedure,
+		svc.ListAutoRules,
+		connect.WithSchema(availabilityServiceMethods.ByName("ListAutoRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceCreateAutoRuleHandler := connect.NewUnaryHandler(
+		AvailabilityServiceCreateAutoRuleProcedure,
+		svc.CreateAutoRule,
+		connect.WithSchema(availabilityServiceMethods.ByName("CreateAutoRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceUpdateAutoRuleHandler := connect.NewUnaryHandler(
+		AvailabilityServiceUpdateAutoRuleProcedure,
+		svc.UpdateAutoRule,
+		connect.WithSchema(availabilityServiceMethods.ByName("UpdateAutoRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceDeleteAutoRuleHandler := connect.NewUnaryHandler(
+		AvailabilityServiceDeleteAutoRuleProcedure,
+		svc.DeleteAutoRule,
+		connect.WithSchema(availabilityServiceMethods.ByName("DeleteAutoRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceListCalendarsHandler := connect.NewUnaryHandler(
+		AvailabilityServiceListCalendarsProcedure,
+		svc.ListCalendars,
+		connect.WithSchema(availabilityServiceMethods.ByName("ListCalendars")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceCreateCalendarHandler := connect.NewUnaryHandler(
+		AvailabilityServiceCreateCalendarProcedure,
+		svc.CreateCalendar,
+		connect.WithSchema(availabilityServiceMethods.ByName("CreateCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceUpdateCalendarHandler := connect.NewUnaryHandler(
+		AvailabilityServiceUpdateCalendarProcedure,
+		svc.UpdateCalendar,
+		connect.WithSchema(availabilityServiceMethods.ByName("UpdateCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceDeleteCalendarHandler := connect.NewUnaryHandler(
+		AvailabilityServiceDeleteCalendarProcedure,
+		svc.DeleteCalendar,
+		connect.WithSchema(availabilityServiceMethods.ByName("DeleteCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceSyncCalendarHandler := connect.NewUnaryHandler(
+		AvailabilityServiceSyncCalendarProcedure,
+		svc.SyncCalendar,
+		connect.WithSchema(availabilityServiceMethods.ByName("SyncCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	availabilityServiceFindBestTimesHandler := connect.NewUnaryHandler(
+		AvailabilityServiceFindBestTimesProcedure,
+		svc.FindBestTimes,
+		connect.WithSchema(availabilityServiceMethods.ByName("FindBestTimes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/kin.v1.AvailabilityService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AvailabilityServiceGetAvailabilityProcedure:
+			availabilityServiceGetAvailabilityHandler.ServeHTTP(w, r)
+		case AvailabilityServiceSetStatusProcedure:
+			availabilityServiceSetStatusHandler.ServeHTTP(w, r)
+		case AvailabilityServiceRevertToAutoStatusProcedure:
+			availabilityServiceRevertToAutoStatusHandler.ServeHTTP(w, r)
+		case AvailabilityServiceListWindowsProcedure:
+			availabilityServiceListWindowsHandler.ServeHTTP(w, r)
+		case AvailabilityServiceCreateWindowProcedure:
+			availabilityServiceCreateWindowHandler.ServeHTTP(w, r)
+		case AvailabilityServiceUpdateWindowProcedure:
+			availabilityServiceUpdateWindowHandler.ServeHTTP(w, r)
+		case AvailabilityServiceDeleteWindowProcedure:
+			availabilityServiceDeleteWindowHandler.ServeHTTP(w, r)
+		case AvailabilityServiceListAutoRulesProcedure:
+			availabilityServiceListAutoRulesHandler.ServeHTTP(w, r)
+		case AvailabilityServiceCreateAutoRuleProcedure:
+			availabilityServiceCreateAutoRuleHandler.ServeHTTP(w, r)
+		case AvailabilityServiceUpdateAutoRuleProcedure:
+			availabilityServiceUpdateAutoRuleHandler.ServeHTTP(w, r)
+		case AvailabilityServiceDeleteAutoRuleProcedure:
+			availabilityServiceDeleteAutoRuleHandler.ServeHTTP(w, r)
+		case AvailabilityServiceListCalendarsProcedure:
+			availabilityServiceListCalendarsHandler.ServeHTTP(w, r)
+		case AvailabilityServiceCreateCalendarProcedure:
+			availabilityServiceCreateCalendarHandler.ServeHTTP(w, r)
+		case AvailabilityServiceUpdateCalendarProcedure:
+			availabilityServiceUpdateCalendarHandler.ServeHTTP(w, r)
+		case AvailabilityServiceDeleteCalendarProcedure:
+			availabilityServiceDeleteCalendarHandler.ServeHTTP(w, r)
+		case AvailabilityServiceSyncCalendarProcedure:
+			availabilityServiceSyncCalendarHandler.ServeHTTP(w, r)
+		case AvailabilityServiceFindBestTimesProcedure:
+			availabilityServiceFindBestTimesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAvailabilityServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAvailabilityServiceHandler struct{}
+
+func (UnimplementedAvailabilityServiceHandler) GetAvailability(context.Context, *connect.Request[v1.GetAvailabilityRequest]) (*connect.Response[v1.GetAvailabilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.GetAvailability is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) SetStatus(context.Context, *connect.Request[v1.SetStatusRequest]) (*connect.Response[v1.SetStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.SetStatus is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) RevertToAutoStatus(context.Context, *connect.Request[v1.RevertToAutoStatusRequest]) (*connect.Response[v1.RevertToAutoStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.RevertToAutoStatus is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) ListWindows(context.Context, *connect.Request[v1.ListWindowsRequest]) (*connect.Response[v1.ListWindowsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.ListWindows is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) CreateWindow(context.Context, *connect.Request[v1.CreateWindowRequest]) (*connect.Response[v1.CreateWindowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.CreateWindow is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) UpdateWindow(context.Context, *connect.Request[v1.UpdateWindowRequest]) (*connect.Response[v1.UpdateWindowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.UpdateWindow is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) DeleteWindow(context.Context, *connect.Request[v1.DeleteWindowRequest]) (*connect.Response[v1.DeleteWindowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.DeleteWindow is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) ListAutoRules(context.Context, *connect.Request[v1.ListAutoRulesRequest]) (*connect.Response[v1.ListAutoRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.ListAutoRules is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) CreateAutoRule(context.Context, *connect.Request[v1.CreateAutoRuleRequest]) (*connect.Response[v1.CreateAutoRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.CreateAutoRule is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) UpdateAutoRule(context.Context, *connect.Request[v1.UpdateAutoRuleRequest]) (*connect.Response[v1.UpdateAutoRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.UpdateAutoRule is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) DeleteAutoRule(context.Context, *connect.Request[v1.DeleteAutoRuleRequest]) (*connect.Response[v1.DeleteAutoRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.DeleteAutoRule is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) ListCalendars(context.Context, *connect.Request[v1.ListCalendarsRequest]) (*connect.Response[v1.ListCalendarsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.ListCalendars is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) CreateCalendar(context.Context, *connect.Request[v1.CreateCalendarRequest]) (*connect.Response[v1.CreateCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.CreateCalendar is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) UpdateCalendar(context.Context, *connect.Request[v1.UpdateCalendarRequest]) (*connect.Response[v1.UpdateCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.UpdateCalendar is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) DeleteCalendar(context.Context, *connect.Request[v1.DeleteCalendarRequest]) (*connect.Response[v1.DeleteCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.DeleteCalendar is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) SyncCalendar(context.Context, *connect.Request[v1.SyncCalendarRequest]) (*connect.Response[v1.SyncCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.SyncCalendar is not implemented"))
+}
+
+func (UnimplementedAvailabilityServiceHandler) FindBestTimes(context.Context, *connect.Request[v1.FindBestTimesRequest]) (*connect.Response[v1.FindBestTimesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.AvailabilityService.FindBestTimes is not implemented"))
+}
@@ -0,0 +1,428 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: kin/v1/contact.proto
+
+package kinv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ContactServiceName is the fully-qualified name of the ContactService service.
+	ContactServiceName = "kin.v1.ContactService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ContactServiceSendContactRequestProcedure is the fully-qualified name of the ContactService's
+	// SendContactRequest RPC.
+	ContactServiceSendContactRequestProcedure = "/kin.v1.ContactService/SendContactRequest"
+	// ContactServiceListIncomingContactRequestsProcedure is the fully-qualified name of the
+	// ContactService's ListIncomingContactRequests RPC.
+	ContactServiceListIncomingContactRequestsProcedure = "/kin.v1.ContactService/ListIncomingContactRequests"
+	// ContactServiceListOutgoingContactRequestsProcedure is the fully-qualified name of the
+	// ContactService's ListOutgoingContactRequests RPC.
+	ContactServiceListOutgoingContactRequestsProcedure = "/kin.v1.ContactService/ListOutgoingContactRequests"
+	// ContactServiceAcceptContactRequestProcedure is the fully-qualified name of the ContactService's
+	// AcceptContactRequest RPC.
+	ContactServiceAcceptContactRequestProcedure = "/kin.v1.ContactService/AcceptContactRequest"
+	// ContactServiceRejectContactRequestProcedure is the fully-qualified name of the ContactService's
+	// RejectContactRequest RPC.
+	ContactServiceRejectContactRequestProcedure = "/kin.v1.ContactService/RejectContactRequest"
+	// ContactServiceListContactsProcedure is the fully-qualified name of the ContactService's
+	// ListContacts RPC.
+	ContactServiceListContactsProcedure = "/kin.v1.ContactService/ListContacts"
+	// ContactServiceListBlockedUsersProcedure is the fully-qualified name of the ContactService's
+	// ListBlockedUsers RPC.
+	ContactServiceListBlockedUsersProcedure = "/kin.v1.ContactService/ListBlockedUsers"
+	// ContactServiceRemoveContactProcedure is the fully-qualified name of the ContactService's
+	// RemoveContact RPC.
+	ContactServiceRemoveContactProcedure = "/kin.v1.ContactService/RemoveContact"
+	// ContactServiceSetContactNicknameProcedure is the fully-qualified name of the ContactService's
+	// SetContactNickname RPC.
+	ContactServiceSetContactNicknameProcedure = "/kin.v1.ContactService/SetContactNickname"
+	// ContactServiceSetContactFavoriteProcedure is the fully-qualified name of the ContactService's
+	// SetContactFavorite RPC.
+	ContactServiceSetContactFavoriteProcedure = "/kin.v1.ContactService/SetContactFavorite"
+	// ContactServiceBlockUserProcedure is the fully-qualified name of the ContactService's BlockUser
+	// RPC.
+	ContactServiceBlockUserProcedure = "/kin.v1.ContactService/BlockUser"
+	// ContactServiceUnblockUserProcedure is the fully-qualified name of the ContactService's
+	// UnblockUser RPC.
+	ContactServiceUnblockUserProcedure = "/kin.v1.ContactService/UnblockUser"
+)
+
+// ContactServiceClient is a client for the kin.v1.ContactService service.
+type ContactServiceClient interface {
+	SendContactRequest(context.Context, *connect.Request[v1.SendContactRequestRequest]) (*connect.Response[v1.SendContactRequestResponse], error)
+	ListIncomingContactRequests(context.Context, *connect.Request[v1.ListIncomingContactRequestsRequest]) (*connect.Response[v1.ListIncomingContactRequestsResponse], error)
+	ListOutgoingContactRequests(context.Context, *connect.Request[v1.ListOutgoingContactRequestsRequest]) (*connect.Response[v1.ListOutgoingContactRequestsResponse], error)
+	AcceptContactRequest(context.Context, *connect.Request[v1.AcceptContactRequestRequest]) (*connect.Response[v1.AcceptContactRequestResponse], error)
+	RejectContactRequest(context.Context, *connect.Request[v1.RejectContactRequestRequest]) (*connect.Response[v1.RejectContactRequestResponse], error)
+	ListContacts(context.Context, *connect.Request[v1.ListContactsRequest]) (*connect.Response[v1.ListContactsResponse], error)
+	ListBlockedUsers(context.Context, *connect.Request[v1.ListBlockedUsersRequest]) (*connect.Response[v1.ListBlockedUsersResponse], error)
+	RemoveContact(context.Context, *connect.Request[v1.RemoveContactRequest]) (*connect.Response[v1.RemoveContactResponse], error)
+	SetContactNickname(context.Context, *connect.Request[v1.SetContactNicknameRequest]) (*connect.Response[v1.SetContactNicknameResponse], error)
+	SetContactFavorite(context.Context, *connect.Request[v1.SetContactFavoriteRequest]) (*connect.Response[v1.SetContactFavoriteResponse], error)
+	BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error)
+}
+
+// NewContactServiceClient constructs a client for the kin.v1.ContactService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewContactServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ContactServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	contactServiceMethods := v1.File_kin_v1_contact_proto.Services().ByName("ContactService").Methods()
+	return &contactServiceClient{
+		sendContactRequest: connect.NewClient[v1.SendContactRequestRequest, v1.SendContactRequestResponse](
+			httpClient,
+			baseURL+ContactServiceSendContactRequestProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("SendContactRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		listIncomingContactRequests: connect.NewClient[v1.ListIncomingContactRequestsRequest, v1.ListIncomingContactRequestsResponse](
+			httpClient,
+			baseURL+ContactServiceListIncomingContactRequestsProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("ListIncomingContactRequests")),
+			connect.WithClientOptions(opts...),
+		),
+		listOutgoingContactRequests: connect.NewClient[v1.ListOutgoingContactRequestsRequest, v1.ListOutgoingContactRequestsResponse](
+			httpClient,
+			baseURL+ContactServiceListOutgoingContactRequestsProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("ListOutgoingContactRequests")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptContactRequest: connect.NewClient[v1.AcceptContactRequestRequest, v1.AcceptContactRequestResponse](
+			httpClient,
+			baseURL+ContactServiceAcceptContactRequestProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("AcceptContactRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectContactRequest: connect.NewClient[v1.RejectContactRequestRequest, v1.RejectContactRequestResponse](
+			httpClient,
+			baseURL+ContactServiceRejectContactRequestProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("RejectContactRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		listContacts: connect.NewClient[v1.ListContactsRequest, v1.ListContactsResponse](
+			httpClient,
+			baseURL+ContactServiceListContactsProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("ListContacts")),
+			connect.WithClientOptions(opts...),
+		),
+		listBlockedUsers: connect.NewClient[v1.ListBlockedUsersRequest, v1.ListBlockedUsersResponse](
+			httpClient,
+			baseURL+ContactServiceListBlockedUsersProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("ListBlockedUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		removeContact: connect.NewClient[v1.RemoveContactRequest, v1.RemoveContactResponse](
+			httpClient,
+			baseURL+ContactServiceRemoveContactProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("RemoveContact")),
+			connect.WithClientOptions(opts...),
+		),
+		setContactNickname: connect.NewClient[v1.SetContactNicknameRequest, v1.SetContactNicknameResponse](
+			httpClient,
+			baseURL+ContactServiceSetContactNicknameProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("SetContactNickname")),
+			connect.WithClientOptions(opts...),
+		),
+		setContactFavorite: connect.NewClient[v1.SetContactFavoriteRequest, v1.SetContactFavoriteResponse](
+			httpClient,
+			baseURL+ContactServiceSetContactFavoriteProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("SetContactFavorite")),
+			connect.WithClientOptions(opts...),
+		),
+		blockUser: connect.NewClient[v1.BlockUserRequest, v1.BlockUserResponse](
+			httpClient,
+			baseURL+ContactServiceBlockUserProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("BlockUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unblockUser: connect.NewClient[v1.UnblockUserRequest, v1.UnblockUserResponse](
+			httpClient,
+			baseURL+ContactServiceUnblockUserProcedure,
+			connect.WithSchema(contactServiceMethods.ByName("UnblockUser")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// contactServiceClient implements ContactServiceClient.
+type contactServiceClient struct {
+	sendContactRequest          *connect.Client[v1.SendContactRequestRequest, v1.SendContactRequestResponse]
+	listIncomingContactRequests *connect.Client[v1.ListIncomingContactRequestsRequest, v1.ListIncomingContactRequestsResponse]
+	listOutgoingContactRequests *connect.Client[v1.ListOutgoingContactRequestsRequest, v1.ListOutgoingContactRequestsResponse]
+	acceptContactRequest        *connect.Client[v1.AcceptContactRequestRequest, v1.AcceptContactRequestResponse]
+	rejectContactRequest        *connect.Client[v1.RejectContactRequestRequest, v1.RejectContactRequestResponse]
+	listContacts                *connect.Client[v1.ListContactsRequest, v1.ListContactsResponse]
+	listBlockedUsers            *connect.Client[v1.ListBlockedUsersRequest, v1.ListBlockedUsersResponse]
+	removeContact               *connect.Client[v1.RemoveContactRequest, v1.RemoveContactResponse]
+	setContactNickname          *connect.Client[v1.SetContactNicknameRequest, v1.SetContactNicknameResponse]
+	setContactFavorite          *connect.Client[v1.SetContactFavoriteRequest, v1.SetContactFavoriteResponse]
+	blockUser                   *connect.Client[v1.BlockUserRequest, v1.BlockUserResponse]
+	unblockUser                 *connect.Client[v1.UnblockUserRequest, v1.UnblockUserResponse]
+}
+
+// SendContactRequest calls kin.v1.ContactService.SendContactRequest.
+func (c *contactServiceClient) SendContactRequest(ctx context.Context, req *connect.Request[v1.SendContactRequestRequest]) (*connect.Response[v1.SendContactRequestResponse], error) {
+	return c.sendContactRequest.CallUnary(ctx, req)
+}
+
+// ListIncomingContactRequests calls kin.v1.ContactService.ListIncomingContactRequests.
+func (c *contactServiceClient) ListIncomingContactRequests(ctx context.Context, req *connect.Request[v1.ListIncomingContactRequestsRequest]) (*connect.Response[v1.ListIncomingContactRequestsResponse], error) {
+	return c.listIncomingContactRequests.CallUnary(ctx, req)
+}
+
+// ListOutgoingContactRequests calls kin.v1.ContactService.ListOutgoingContactRequests.
+func (c *contactServiceClient) ListOutgoingContactRequests(ctx context.Context, req *connect.Request[v1.ListOutgoingContactRequestsRequest]) (*connect.Response[v1.ListOutgoingContactRequestsResponse], error) {
+	return c.listOutgoingContactRequests.CallUnary(ctx, req)
+}
+
+// AcceptContactRequest calls kin.v1.ContactService.AcceptContactRequest.
+func (c *contactServiceClient) AcceptContactRequest(ctx context.Context, req *connect.Request[v1.AcceptContactRequestRequest]) (*connect.Response[v1.AcceptContactRequestResponse], error) {
+	return c.acceptContactRequest.CallUnary(ctx, req)
+}
+
+// RejectContactRequest calls kin.v1.ContactService.RejectContactRequest.
+func (c *contactServiceClient) RejectContactRequest(ctx context.Context, req *connect.Request[v1.RejectContactRequestRequest]) (*connect.Response[v1.RejectContactRequestResponse], error) {
+	return c.rejectContactRequest.CallUnary(ctx, req)
+}
+
+// ListContacts calls kin.v1.ContactService.ListContacts.
+func (c *contactServiceClient) ListContacts(ctx context.Context, req *connect.Request[v1.ListContactsRequest]) (*connect.Response[v1.ListContactsResponse], error) {
+	return c.listContacts.CallUnary(ctx, req)
+}
+
+// ListBlockedUsers calls kin.v1.ContactService.ListBlockedUsers.
+func (c *contactServiceClient) ListBlockedUsers(ctx context.Context, req *connect.Request[v1.ListBlockedUsersRequest]) (*connect.Response[v1.ListBlockedUsersResponse], error) {
+	return c.listBlockedUsers.CallUnary(ctx, req)
+}
+
+// RemoveContact calls kin.v1.ContactService.RemoveContact.
+func (c *contactServiceClient) RemoveContact(ctx context.Context, req *connect.Request[v1.RemoveContactRequest]) (*connect.Response[v1.RemoveContactResponse], error) {
+	return c.removeContact.CallUnary(ctx, req)
+}
+
+// SetContactNickname calls kin.v1.ContactService.SetContactNickname.
+func (c *contactServiceClient) SetContactNickname(ctx context.Context, req *connect.Request[v1.SetContactNicknameRequest]) (*connect.Response[v1.SetContactNicknameResponse], error) {
+	return c.setContactNickname.CallUnary(ctx, req)
+}
+
+// SetContactFavorite calls kin.v1.ContactService.SetContactFavorite.
+func (c *contactServiceClient) SetContactFavorite(ctx context.Context, req *connect.Request[v1.SetContactFavoriteRequest]) (*connect.Response[v1.SetContactFavoriteResponse], error) {
+	return c.setContactFavorite.CallUnary(ctx, req)
+}
+
+// BlockUser calls kin.v1.ContactService.BlockUser.
+func (c *contactServiceClient) BlockUser(ctx context.Context, req *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	return c.blockUser.CallUnary(ctx, req)
+}
+
+// UnblockUser calls kin.v1.ContactService.UnblockUser.
+func (c *contactServiceClient) UnblockUser(ctx context.Context, req *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	return c.unblockUser.CallUnary(ctx, req)
+}
+
+// ContactServiceHandler is an implementation of the kin.v1.ContactService service.
+type ContactServiceHandler interface {
+	SendContactRequest(context.Context, *connect.Request[v1.SendContactRequestRequest]) (*connect.Response[v1.SendContactRequestResponse], error)
+	ListIncomingContactRequests(context.Context, *connect.Request[v1.ListIncomingContactRequestsRequest]) (*connect.Response[v1.ListIncomingContactRequestsResponse], error)
+	ListOutgoingContactRequests(context.Context, *connect.Request[v1.ListOutgoingContactRequestsRequest]) (*connect.Response[v1.ListOutgoingContactRequestsResponse], error)
+	AcceptContactRequest(context.Context, *connect.Request[v1.AcceptContactRequestRequest]) (*connect.Response[v1.AcceptContactRequestResponse], error)
+	RejectContactRequest(context.Context, *connect.Request[v1.RejectContactRequestRequest]) (*connect.Response[v1.RejectContactRequestResponse], error)
+	ListContacts(context.Context, *connect.Request[v1.ListContactsRequest]) (*connect.Response[v1.ListContactsResponse], error)
+	ListBlockedUsers(context.Context, *connect.Request[v1.ListBlockedUsersRequest]) (*connect.Response[v1.ListBlockedUsersResponse], error)
+	RemoveContact(context.Context, *connect.Request[v1.RemoveContactRequest]) (*connect.Response[v1.RemoveContactResponse], error)
+	SetContactNickname(context.Context, *connect.Request[v1.SetContactNicknameRequest]) (*connect.Response[v1.SetContactNicknameResponse], error)
+	SetContactFavorite(context.Context, *connect.Request[v1.SetContactFavoriteRequest]) (*connect.Response[v1.SetContactFavoriteResponse], error)
+	BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error)
+}
+
+// NewContactServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewContactServiceHandler(svc ContactServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	contactServiceMethods := v1.File_kin_v1_contact_proto.Services().ByName("ContactService").Methods()
+	contactServiceSendContactRequestHandler := connect.NewUnaryHandler(
+		ContactServiceSendContactRequestProcedure,
+		svc.SendContactRequest,
+		connect.WithSchema(contactServiceMethods.ByName("SendContactRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceListIncomingContactRequestsHandler := connect.NewUnaryHandler(
+		ContactServiceListIncomingContactRequestsProcedure,
+		svc.ListIncomingContactRequests,
+		connect.WithSchema(contactServiceMethods.ByName("ListIncomingContactRequests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceListOutgoingContactRequestsHandler := connect.NewUnaryHandler(
+		ContactServiceListOutgoingContactRequestsProcedure,
+		svc.ListOutgoingContactRequests,
+		connect.WithSchema(contactServiceMethods.ByName("ListOutgoingContactRequests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceAcceptContactRequestHandler := connect.NewUnaryHandler(
+		ContactServiceAcceptContactRequestProcedure,
+		svc.AcceptContactRequest,
+		connect.WithSchema(contactServiceMethods.ByName("AcceptContactRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceRejectContactRequestHandler := connect.NewUnaryHandler(
+		ContactServiceRejectContactRequestProcedure,
+		svc.RejectContactRequest,
+		connect.WithSchema(contactServiceMethods.ByName("RejectContactRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceListContactsHandler := connect.NewUnaryHandler(
+		ContactServiceListContactsProcedure,
+		svc.ListContacts,
+		connect.WithSchema(contactServiceMethods.ByName("ListContacts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceListBlockedUsersHandler := connect.NewUnaryHandler(
+		ContactServiceListBlockedUsersProcedure,
+		svc.ListBlockedUsers,
+		connect.WithSchema(contactServiceMethods.ByName("ListBlockedUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceRemoveContactHandler := connect.NewUnaryHandler(
+		ContactServiceRemoveContactProcedure,
+		svc.RemoveContact,
+		connect.WithSchema(contactServiceMethods.ByName("RemoveContact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceSetContactNicknameHandler := connect.NewUnaryHandler(
+		ContactServiceSetContactNicknameProcedure,
+		svc.SetContactNickname,
+		connect.WithSchema(contactServiceMethods.ByName("SetContactNickname")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceSetContactFavoriteHandler := connect.NewUnaryHandler(
+		ContactServiceSetContactFavoriteProcedure,
+		svc.SetContactFavorite,
+		connect.WithSchema(contactServiceMethods.ByName("SetContactFavorite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceBlockUserHandler := connect.NewUnaryHandler(
+		ContactServiceBlockUserProcedure,
+		svc.BlockUser,
+		connect.WithSchema(contactServiceMethods.ByName("BlockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	contactServiceUnblockUserHandler := connect.NewUnaryHandler(
+		ContactServiceUnblockUserProcedure,
+		svc.UnblockUser,
+		connect.WithSchema(contactServiceMethods.ByName("UnblockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/kin.v1.ContactService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ContactServiceSendContactRequestProcedure:
+			contactServiceSendContactRequestHandler.ServeHTTP(w, r)
+		case ContactServiceListIncomingContactRequestsProcedure:
+			contactServiceListIncomingContactRequestsHandler.ServeHTTP(w, r)
+		case ContactServiceListOutgoingContactRequestsProcedure:
+			contactServiceListOutgoingContactRequestsHandler.ServeHTTP(w, r)
+		case ContactServiceAcceptContactRequestProcedure:
+			contactServiceAcceptContactRequestHandler.ServeHTTP(w, r)
+		case ContactServiceRejectContactRequestProcedure:
+			contactServiceRejectContactRequestHandler.ServeHTTP(w, r)
+		case ContactServiceListContactsProcedure:
+			contactServiceListContactsHandler.ServeHTTP(w, r)
+		case ContactServiceListBlockedUsersProcedure:
+			contactServiceListBlockedUsersHandler.ServeHTTP(w, r)
+		case ContactServiceRemoveContactProcedure:
+			contactServiceRemoveContactHandler.ServeHTTP(w, r)
+		case ContactServiceSetContactNicknameProcedure:
+			contactServiceSetContactNicknameHandler.ServeHTTP(w, r)
+		case ContactServiceSetContactFavoriteProcedure:
+			contactServiceSetContactFavoriteHandler.ServeHTTP(w, r)
+		case ContactServiceBlockUserProcedure:
+			contactServiceBlockUserHandler.ServeHTTP(w, r)
+		case ContactServiceUnblockUserProcedure:
+			contactServiceUnblockUserHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedContactServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedContactServiceHandler struct{}
+
+func (UnimplementedContactServiceHandler) SendContactRequest(context.Context, *connect.Request[v1.SendContactRequestRequest]) (*connect.Response[v1.SendContactRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.SendContactRequest is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) ListIncomingContactRequests(context.Context, *connect.Request[v1.ListIncomingContactRequestsRequest]) (*connect.Response[v1.ListIncomingContactRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.ListIncomingContactRequests is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) ListOutgoingContactRequests(context.Context, *connect.Request[v1.ListOutgoingContactRequestsRequest]) (*connect.Response[v1.ListOutgoingContactRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.ListOutgoingContactRequests is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) AcceptContactRequest(context.Context, *connect.Request[v1.AcceptContactRequestRequest]) (*connect.Response[v1.AcceptContactRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.AcceptContactRequest is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) RejectContactRequest(context.Context, *connect.Request[v1.RejectContactRequestRequest]) (*connect.Response[v1.RejectContactRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.RejectContactRequest is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) ListContacts(context.Context, *connect.Request[v1.ListContactsRequest]) (*connect.Response[v1.ListContactsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.ListContacts is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) ListBlockedUsers(context.Context, *connect.Request[v1.ListBlockedUsersRequest]) (*connect.Response[v1.ListBlockedUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.ListBlockedUsers is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) RemoveContact(context.Context, *connect.Request[v1.RemoveContactRequest]) (*connect.Response[v1.RemoveContactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.RemoveContact is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) SetContactNickname(context.Context, *connect.Request[v1.SetContactNicknameRequest]) (*connect.Response[v1.SetContactNicknameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.SetContactNickname is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) SetContactFavorite(context.Context, *connect.Request[v1.SetContactFavoriteRequest]) (*connect.Response[v1.SetContactFavoriteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.SetContactFavorite is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.BlockUser is not implemented"))
+}
+
+func (UnimplementedContactServiceHandler) UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ContactService.UnblockUser is not implemented"))
+}
@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: kin/v1/conversation.proto
+
+package kinv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ConversationServiceName is the fully-qualified name of the ConversationService service.
+	ConversationServiceName = "kin.v1.ConversationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ConversationServiceStartDirectConversationProcedure is the fully-qualified name of the
+	// ConversationService's StartDirectConversation RPC.
+	ConversationServiceStartDirectConversationProcedure = "/kin.v1.ConversationService/StartDirectConversation"
+	// ConversationServiceListConversationsProcedure is the fully-qualified name of the
+	// ConversationService's ListConversations RPC.
+	ConversationServiceListConversationsProcedure = "/kin.v1.ConversationService/ListConversations"
+	// ConversationServiceGetConversationProcedure is the fully-qualified name of the
+	// ConversationService's GetConversation RPC.
+	ConversationServiceGetConversationProcedure = "/kin.v1.ConversationService/GetConversation"
+	// ConversationServiceMuteConversationProcedure is the fully-qualified name of the
+	// ConversationService's MuteConversation RPC.
+	ConversationServiceMuteConversationProcedure = "/kin.v1.ConversationService/MuteConversation"
+	// ConversationServiceUnmuteConversationProcedure is the fully-qualified name of the
+	// ConversationService's UnmuteConversation RPC.
+	ConversationServiceUnmuteConversationProcedure = "/kin.v1.ConversationService/UnmuteConversation"
+	// ConversationServiceArchiveConversationProcedure is the fully-qualified name of the
+	// ConversationService's ArchiveConversation RPC.
+	ConversationServiceArchiveConversationProcedure = "/kin.v1.ConversationService/ArchiveConversation"
+	// ConversationServiceUnarchiveConversationProcedure is the fully-qualified name of the
+	// ConversationService's UnarchiveConversation RPC.
+	ConversationServiceUnarchiveConversationProcedure = "/kin.v1.ConversationService/UnarchiveConversation"
+	// ConversationServiceLeaveConversationProcedure is the fully-qualified name of the
+	// ConversationService's LeaveConversation RPC.
+	ConversationServiceLeaveConversationProcedure = "/kin.v1.ConversationService/LeaveConversation"
+)
+
+// ConversationServiceClient is a client for the kin.v1.ConversationService service.
+type ConversationServiceClient interface {
+	StartDirectConversation(context.Context, *connect.Request[v1.StartDirectConversationRequest]) (*connect.Response[v1.StartDirectConversationResponse], error)
+	ListConversations(context.Context, *connect.Request[v1.ListConversationsRequest]) (*connect.Response[v1.ListConversationsResponse], error)
+	GetConversation(context.Context, *connect.Request[v1.GetConversationRequest]) (*connect.Response[v1.GetConversationResponse], error)
+	MuteConversation(context.Context, *connect.Request[v1.MuteConversationRequest]) (*connect.Response[v1.MuteConversationResponse], error)
+	UnmuteConversation(context.Context, *connect.Request[v1.UnmuteConversationRequest]) (*connect.Response[v1.UnmuteConversationResponse], error)
+	ArchiveConversation(context.Context, *connect.Request[v1.ArchiveConversationRequest]) (*connect.Response[v1.ArchiveConversationResponse], error)
+	UnarchiveConversation(context.Context, *connect.Request[v1.UnarchiveConversationRequest]) (*connect.Response[v1.UnarchiveConversationResponse], error)
+	LeaveConversation(context.Context, *connect.Request[v1.LeaveConversationRequest]) (*connect.Response[v1.LeaveConversationResponse], error)
+}
+
+// NewConversationServiceClient constructs a client for the kin.v1.ConversationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConversationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ConversationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	conversationServiceMethods := v1.File_kin_v1_conversation_proto.Services().ByName("ConversationService").Methods()
+	return &conversationServiceClient{
+		startDirectConversation: connect.NewClient[v1.StartDirectConversationRequest, v1.StartDirectConversationResponse](
+			httpClient,
+			baseURL+ConversationServiceStartDirectConversationProcedure,
+			connect.WithSchema(conversationServiceMethods.ByName("StartDirectConversation")),
+			connect.WithClientOptions(opts...),
+		),
+		listConversations: connect.NewClient[v1.ListConversationsRequest, v1.ListConversationsResponse](
+			httpClient,
+			baseURL+ConversationServiceListConversationsProcedure,
+			connect.WithSchema(conversationServiceMethods.ByName("ListConversations")),
+			connect.WithClientOptions(opts...),
+		),
+		getConversation: connect.NewClient[v1.GetConversationRequest, v1.GetConversationResponse](
+			httpClient,
+			baseURL+ConversationServiceGetConversationProcedure,
+			connect.WithSchema(conversationServiceMethods.ByName("GetConversation")),
+			connect.WithClientOptions(opts...),
+		),
+		muteConversation: connect.NewClient[v1.MuteConversationRequest, v1.MuteConversationResponse](
+			httpClient,
+			baseURL+ConversationServiceMuteConversationProcedure,
+			connect.WithSchema(conversationServiceMethods.ByName("MuteConversation")),
+			connect.WithClientOptions(opts...),
+		),
+		unmuteConversation: connect.NewClient[v1.UnmuteConversationRequest, v1.UnmuteConversationResponse](
+			httpClient,
+			baseURL+ConversationServiceUnmuteConversationProcedure,
+			connect.WithSchema(conversationServiceMethods.ByName("UnmuteConversation")),
+			connect.WithClientOptions(opts...),
+		),
+		archiveConversation: connect.NewClient[v1.ArchiveConversationRequest, v1.ArchiveConversationResponse](
+			httpClient,
+			baseURL+ConversationServiceArchiveConversationProcedure,
+			connect.WithSchema(conversationServiceMethods.ByName("ArchiveConversation")),
+			connect.WithClientOptions(opts...),
+		),
+		unarchiveConversation: connect.NewClient[v1.UnarchiveConversationRequest, v1.UnarchiveConversationResponse](
+			httpClient,
+			baseURL+ConversationServiceUnarchiveConversationProcedure,
+			connect.WithSchema(conversationServiceMethods.ByName("UnarchiveConversation")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveConversation: connect.NewClient[v1.LeaveConversationRequest, v1.LeaveConversationResponse](
+			httpClient,
+			baseURL+ConversationServiceLeaveConversationProcedure,
+			connect.WithSchema(conversationServiceMethods.ByName("LeaveConversation")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// conversationServiceClient implements ConversationServiceClient.
+type conversationServiceClient struct {
+	startDirectConversation *connect.Client[v1.StartDirectConversationRequest, v1.StartDirectConversationResponse]
+	listConversations       *connect.Client[v1.ListConversationsRequest, v1.ListConversationsResponse]
+	getConversation         *connect.Client[v1.GetConversationRequest, v1.GetConversationResponse]
+	muteConversation        *connect.Client[v1.MuteConversationRequest, v1.MuteConversationResponse]
+	unmuteConversation      *connect.Client[v1.UnmuteConversationRequest, v1.UnmuteConversationResponse]
+	archiveConversation     *connect.Client[v1.ArchiveConversationRequest, v1.ArchiveConversationResponse]
+	unarchiveConversation   *connect.Client[v1.UnarchiveConversationRequest, v1.UnarchiveConversationResponse]
+	leaveConversation       *connect.Client[v1.LeaveConversationRequest, v1.LeaveConversationResponse]
+}
+
+// StartDirectConversation calls kin.v1.ConversationService.StartDirectConversation.
+func (c *conversationServiceClient) StartDirectConversation(ctx context.Context, req *connect.Request[v1.StartDirectConversationRequest]) (*connect.Response[v1.StartDirectConversationResponse], error) {
+	return c.startDirectConversation.CallUnary(ctx, req)
+}
+
+// ListConversations calls kin.v1.ConversationService.ListConversations.
+func (c *conversationServiceClient) ListConversations(ctx context.Context, req *connect.Request[v1.ListConversationsRequest]) (*connect.Response[v1.ListConversationsResponse], error) {
+	return c.listConversations.CallUnary(ctx, req)
+}
+
+// GetConversation calls kin.v1.ConversationService.GetConversation.
+func (c *conversationServiceClient) GetConversation(ctx context.Context, req *connect.Request[v1.GetConversationRequest]) (*connect.Response[v1.GetConversationResponse], error) {
+	return c.getConversation.CallUnary(ctx, req)
+}
+
+// MuteConversation calls kin.v1.ConversationService.MuteConversation.
+func (c *conversationServiceClient) MuteConversation(ctx context.Context, req *connect.Request[v1.MuteConversationRequest]) (*connect.Response[v1.MuteConversationResponse], error) {
+	return c.muteConversation.CallUnary(ctx, req)
+}
+
+// UnmuteConversation calls kin.v1.ConversationService.UnmuteConversation.
+func (c *conversationServiceClient) UnmuteConversation(ctx context.Context, req *connect.Request[v1.UnmuteConversationRequest]) (*connect.Response[v1.UnmuteConversationResponse], error) {
+	return c.unmuteConversation.CallUnary(ctx, req)
+}
+
+// ArchiveConversation calls kin.v1.ConversationService.ArchiveConversation.
+func (c *conversationServiceClient) ArchiveConversation(ctx context.Context, req *connect.Request[v1.ArchiveConversationRequest]) (*connect.Response[v1.ArchiveConversationResponse], error) {
+	return c.archiveConversation.CallUnary(ctx, req)
+}
+
+// UnarchiveConversation calls kin.v1.ConversationService.UnarchiveConversation.
+func (c *conversationServiceClient) UnarchiveConversation(ctx context.Context, req *connect.Request[v1.UnarchiveConversationRequest]) (*connect.Response[v1.UnarchiveConversationResponse], error) {
+	return c.unarchiveConversation.CallUnary(ctx, req)
+}
+
+// LeaveConversation calls kin.v1.ConversationService.LeaveConversation.
+func (c *conversationServiceClient) LeaveConversation(ctx context.Context, req *connect.Request[v1.LeaveConversationRequest]) (*connect.Response[v1.LeaveConversationResponse], error) {
+	return c.leaveConversation.CallUnary(ctx, req)
+}
+
+// ConversationServiceHandler is an implementation of the kin.v1.ConversationService service.
+type ConversationServiceHandler interface {
+	StartDirectConversation(context.Context, *connect.Request[v1.StartDirectConversationRequest]) (*connect.Response[v1.StartDirectConversationResponse], error)
+	ListConversations(context.Context, *connect.Request[v1.ListConversationsRequest]) (*connect.Response[v1.ListConversationsResponse], error)
+	GetConversation(context.Context, *connect.Request[v1.GetConversationRequest]) (*connect.Response[v1.GetConversationResponse], error)
+	MuteConversation(context.Context, *connect.Request[v1.MuteConversationRequest]) (*connect.Response[v1.MuteConversationResponse], error)
+	UnmuteConversation(context.Context, *connect.Request[v1.UnmuteConversationRequest]) (*connect.Response[v1.UnmuteConversationResponse], error)
+	ArchiveConversation(context.Context, *connect.Request[v1.ArchiveConversationRequest]) (*connect.Response[v1.ArchiveConversationResponse], error)
+	UnarchiveConversation(context.Context, *connect.Request[v1.UnarchiveConversationRequest]) (*connect.Response[v1.UnarchiveConversationResponse], error)
+	LeaveConversation(context.Context, *connect.Request[v1.LeaveConversationRequest]) (*connect.Response[v1.LeaveConversationResponse], error)
+}
+
+// NewConversationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConversationServiceHandler(svc ConversationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	conversationServiceMethods := v1.File_kin_v1_conversation_proto.Services().ByName("ConversationService").Methods()
+	conversationServiceStartDirectConversationHandler := connect.NewUnaryHandler(
+		ConversationServiceStartDirectConversationProcedure,
+		svc.StartDirectConversation,
+		connect.WithSchema(conversationServiceMethods.ByName("StartDirectConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	conversationServiceListConversationsHandler := connect.NewUnaryHandler(
+		ConversationServiceListConversationsProcedure,
+		svc.ListConversations,
+		connect.WithSchema(conversationServiceMethods.ByName("ListConversations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	conversationServiceGetConversationHandler := connect.NewUnaryHandler(
+		ConversationServiceGetConversationProcedure,
+		svc.GetConversation,
+		connect.WithSchema(conversationServiceMethods.ByName("GetConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	conversationServiceMuteConversationHandler := connect.NewUnaryHandler(
+		ConversationServiceMuteConversationProcedure,
+		svc.MuteConversation,
+		connect.WithSchema(conversationServiceMethods.ByName("MuteConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	conversationServiceUnmuteConversationHandler := connect.NewUnaryHandler(
+		ConversationServiceUnmuteConversationProcedure,
+		svc.UnmuteConversation,
+		connect.WithSchema(conversationServiceMethods.ByName("UnmuteConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	conversationServiceArchiveConversationHandler := connect.NewUnaryHandler(
+		ConversationServiceArchiveConversationProcedure,
+		svc.ArchiveConversation,
+		connect.WithSchema(conversationServiceMethods.ByName("ArchiveConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	conversationServiceUnarchiveConversationHandler := connect.NewUnaryHandler(
+		ConversationServiceUnarchiveConversationProcedure,
+		svc.UnarchiveConversation,
+		connect.WithSchema(conversationServiceMethods.ByName("UnarchiveConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	conversationServiceLeaveConversationHandler := connect.NewUnaryHandler(
+		ConversationServiceLeaveConversationProcedure,
+		svc.LeaveConversation,
+		connect.WithSchema(conversationServiceMethods.ByName("LeaveConversation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/kin.v1.ConversationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ConversationServiceStartDirectConversationProcedure:
+			conversationServiceStartDirectConversationHandler.ServeHTTP(w, r)
+		case ConversationServiceListConversationsProcedure:
+			conversationServiceListConversationsHandler.ServeHTTP(w, r)
+		case ConversationServiceGetConversationProcedure:
+			conversationServiceGetConversationHandler.ServeHTTP(w, r)
+		case ConversationServiceMuteConversationProcedure:
+			conversationServiceMuteConversationHandler.ServeHTTP(w, r)
+		case ConversationServiceUnmuteConversationProcedure:
+			conversationServiceUnmuteConversationHandler.ServeHTTP(w, r)
+		case ConversationServiceArchiveConversationProcedure:
+			conversationServiceArchiveConversationHandler.ServeHTTP(w, r)
+		case ConversationServiceUnarchiveConversationProcedure:
+			conversationServiceUnarchiveConversationHandler.ServeHTTP(w, r)
+		case ConversationServiceLeaveConversationProcedure:
+			conversationServiceLeaveConversationHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedConversationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConversationServiceHandler struct{}
+
+func (UnimplementedConversationServiceHandler) StartDirectConversation(context.Context, *connect.Request[v1.StartDirectConversationRequest]) (*connect.Response[v1.StartDirectConversationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ConversationService.StartDirectConversation is not implemented"))
+}
+
+func (UnimplementedConversationServiceHandler) ListConversations(context.Context, *connect.Request[v1.ListConversationsRequest]) (*connect.Response[v1.ListConversationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ConversationService.ListConversations is not implemented"))
+}
+
+func (UnimplementedConversationServiceHandler) GetConversation(context.Context, *connect.Request[v1.GetConversationRequest]) (*connect.Response[v1.GetConversationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ConversationService.GetConversation is not implemented"))
+}
+
+func (UnimplementedConversationServiceHandler) MuteConversation(context.Context, *connect.Request[v1.MuteConversationRequest]) (*connect.Response[v1.MuteConversationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ConversationService.MuteConversation is not implemented"))
+}
+
+func (UnimplementedConversationServiceHandler) UnmuteConversation(context.Context, *connect.Request[v1.UnmuteConversationRequest]) (*connect.Response[v1.UnmuteConversationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ConversationService.UnmuteConversation is not implemented"))
+}
+
+func (UnimplementedConversationServiceHandler) ArchiveConversation(context.Context, *connect.Request[v1.ArchiveConversationRequest]) (*connect.Response[v1.ArchiveConversationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ConversationService.ArchiveConversation is not implemented"))
+}
+
+func (UnimplementedConversationServiceHandler) UnarchiveConversation(context.Context, *connect.Request[v1.UnarchiveConversationRequest]) (*connect.Response[v1.UnarchiveConversationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ConversationService.UnarchiveConversation is not implemented"))
+}
+
+func (UnimplementedConversationServiceHandler) LeaveConversation(context.Context, *connect.Request[v1.LeaveConversationRequest]) (*connect.Response[v1.LeaveConversationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.ConversationService.LeaveConversation is not implemented"))
+}
@@ -0,0 +1,486 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: kin/v1/location.proto
+
+package kinv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LocationServiceName is the fully-qualified name of the LocationService service.
+	LocationServiceName = "kin.v1.LocationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LocationServiceUpdateLocationProcedure is the fully-qualified name of the LocationService's
+	// UpdateLocation RPC.
+	LocationServiceUpdateLocationProcedure = "/kin.v1.LocationService/UpdateLocation"
+	// LocationServiceBatchUpdateLocationProcedure is the fully-qualified name of the LocationService's
+	// BatchUpdateLocation RPC.
+	LocationServiceBatchUpdateLocationProcedure = "/kin.v1.LocationService/BatchUpdateLocation"
+	// LocationServiceGetLocationHistoryProcedure is the fully-qualified name of the LocationService's
+	// GetLocationHistory RPC.
+	LocationServiceGetLocationHistoryProcedure = "/kin.v1.LocationService/GetLocationHistory"
+	// LocationServiceDeleteLocationHistoryProcedure is the fully-qualified name of the
+	// LocationService's DeleteLocationHistory RPC.
+	LocationServiceDeleteLocationHistoryProcedure = "/kin.v1.LocationService/DeleteLocationHistory"
+	// LocationServiceListCircleLocationsProcedure is the fully-qualified name of the LocationService's
+	// ListCircleLocations RPC.
+	LocationServiceListCircleLocationsProcedure = "/kin.v1.LocationService/ListCircleLocations"
+	// LocationServiceListNearbyMembersProcedure is the fully-qualified name of the LocationService's
+	// ListNearbyMembers RPC.
+	LocationServiceListNearbyMembersProcedure = "/kin.v1.LocationService/ListNearbyMembers"
+	// LocationServiceStartLiveSessionProcedure is the fully-qualified name of the LocationService's
+	// StartLiveSession RPC.
+	LocationServiceStartLiveSessionProcedure = "/kin.v1.LocationService/StartLiveSession"
+	// LocationServiceStopLiveSessionProcedure is the fully-qualified name of the LocationService's
+	// StopLiveSession RPC.
+	LocationServiceStopLiveSessionProcedure = "/kin.v1.LocationService/StopLiveSession"
+	// LocationServiceListLiveSessionsProcedure is the fully-qualified name of the LocationService's
+	// ListLiveSessions RPC.
+	LocationServiceListLiveSessionsProcedure = "/kin.v1.LocationService/ListLiveSessions"
+	// LocationServiceListPlacesProcedure is the fully-qualified name of the LocationService's
+	// ListPlaces RPC.
+	LocationServiceListPlacesProcedure = "/kin.v1.LocationService/ListPlaces"
+	// LocationServiceCreatePlaceProcedure is the fully-qualified name of the LocationService's
+	// CreatePlace RPC.
+	LocationServiceCreatePlaceProcedure = "/kin.v1.LocationService/CreatePlace"
+	// LocationServiceUpdatePlaceProcedure is the fully-qualified name of the LocationService's
+	// UpdatePlace RPC.
+	LocationServiceUpdatePlaceProcedure = "/kin.v1.LocationService/UpdatePlace"
+	// LocationServiceDeletePlaceProcedure is the fully-qualified name of the LocationService's
+	// DeletePlace RPC.
+	LocationServiceDeletePlaceProcedure = "/kin.v1.LocationService/DeletePlace"
+	// LocationServiceListCheckInsProcedure is the fully-qualified name of the LocationService's
+	// ListCheckIns RPC.
+	LocationServiceListCheckInsProcedure = "/kin.v1.LocationService/ListCheckIns"
+)
+
+// LocationServiceClient is a client for the kin.v1.LocationService service.
+type LocationServiceClient interface {
+	UpdateLocation(context.Context, *connect.Request[v1.UpdateLocationRequest]) (*connect.Response[v1.UpdateLocationResponse], error)
+	BatchUpdateLocation(context.Context, *connect.Request[v1.BatchUpdateLocationRequest]) (*connect.Response[v1.BatchUpdateLocationResponse], error)
+	GetLocationHistory(context.Context, *connect.Request[v1.GetLocationHistoryRequest]) (*connect.Response[v1.GetLocationHistoryResponse], error)
+	DeleteLocationHistory(context.Context, *connect.Request[v1.DeleteLocationHistoryRequest]) (*connect.Response[v1.DeleteLocationHistoryResponse], error)
+	ListCircleLocations(context.Context, *connect.Request[v1.ListCircleLocationsRequest]) (*connect.Response[v1.ListCircleLocationsResponse], error)
+	ListNearbyMembers(context.Context, *connect.Request[v1.ListNearbyMembersRequest]) (*connect.Response[v1.ListNearbyMembersResponse], error)
+	StartLiveSession(context.Context, *connect.Request[v1.StartLiveSessionRequest]) (*connect.Response[v1.StartLiveSessionResponse], error)
+	StopLiveSession(context.Context, *connect.Request[v1.StopLiveSessionRequest]) (*connect.Response[v1.StopLiveSessionResponse], error)
+	ListLiveSessions(context.Context, *connect.Request[v1.ListLiveSessionsRequest]) (*connect.Response[v1.ListLiveSessionsResponse], error)
+	ListPlaces(context.Context, *connect.Request[v1.ListPlacesRequest]) (*connect.Response[v1.ListPlacesResponse], error)
+	CreatePlace(context.Context, *connect.Request[v1.CreatePlaceRequest]) (*connect.Response[v1.CreatePlaceResponse], error)
+	UpdatePlace(context.Context, *connect.Request[v1.UpdatePlaceRequest]) (*connect.Response[v1.UpdatePlaceResponse], error)
+	DeletePlace(context.Context, *connect.Request[v1.DeletePlaceRequest]) (*connect.Response[v1.DeletePlaceResponse], error)
+	ListCheckIns(context.Context, *connect.Request[v1.ListCheckInsRequest]) (*connect.Response[v1.ListCheckInsResponse], error)
+}
+
+// NewLocationServiceClient constructs a client for the kin.v1.LocationService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLocationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LocationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	locationServiceMethods := v1.File_kin_v1_location_proto.Services().ByName("LocationService").Methods()
+	return &locationServiceClient{
+		updateLocation: connect.NewClient[v1.UpdateLocationRequest, v1.UpdateLocationResponse](
+			httpClient,
+			baseURL+LocationServiceUpdateLocationProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("UpdateLocation")),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdateLocation: connect.NewClient[v1.BatchUpdateLocationRequest, v1.BatchUpdateLocationResponse](
+			httpClient,
+			baseURL+LocationServiceBatchUpdateLocationProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("BatchUpdateLocation")),
+			connect.WithClientOptions(opts...),
+		),
+		getLocationHistory: connect.NewClient[v1.GetLocationHistoryRequest, v1.GetLocationHistoryResponse](
+			httpClient,
+			baseURL+LocationServiceGetLocationHistoryProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("GetLocationHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteLocationHistory: connect.NewClient[v1.DeleteLocationHistoryRequest, v1.DeleteLocationHistoryResponse](
+			httpClient,
+			baseURL+LocationServiceDeleteLocationHistoryProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("DeleteLocationHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		listCircleLocations: connect.NewClient[v1.ListCircleLocationsRequest, v1.ListCircleLocationsResponse](
+			httpClient,
+			baseURL+LocationServiceListCircleLocationsProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("ListCircleLocations")),
+			connect.WithClientOptions(opts...),
+		),
+		listNearbyMembers: connect.NewClient[v1.ListNearbyMembersRequest, v1.ListNearbyMembersResponse](
+			httpClient,
+			baseURL+LocationServiceListNearbyMembersProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("ListNearbyMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		startLiveSession: connect.NewClient[v1.StartLiveSessionRequest, v1.StartLiveSessionResponse](
+			httpClient,
+			baseURL+LocationServiceStartLiveSessionProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("StartLiveSession")),
+			connect.WithClientOptions(opts...),
+		),
+		stopLiveSession: connect.NewClient[v1.StopLiveSessionRequest, v1.StopLiveSessionResponse](
+			httpClient,
+			baseURL+LocationServiceStopLiveSessionProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("StopLiveSession")),
+			connect.WithClientOptions(opts...),
+		),
+		listLiveSessions: connect.NewClient[v1.ListLiveSessionsRequest, v1.ListLiveSessionsResponse](
+			httpClient,
+			baseURL+LocationServiceListLiveSessionsProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("ListLiveSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		listPlaces: connect.NewClient[v1.ListPlacesRequest, v1.ListPlacesResponse](
+			httpClient,
+			baseURL+LocationServiceListPlacesProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("ListPlaces")),
+			connect.WithClientOptions(opts...),
+		),
+		createPlace: connect.NewClient[v1.CreatePlaceRequest, v1.CreatePlaceResponse](
+			httpClient,
+			baseURL+LocationServiceCreatePlaceProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("CreatePlace")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePlace: connect.NewClient[v1.UpdatePlaceRequest, v1.UpdatePlaceResponse](
+			httpClient,
+			baseURL+LocationServiceUpdatePlaceProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("UpdatePlace")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePlace: connect.NewClient[v1.DeletePlaceRequest, v1.DeletePlaceResponse](
+			httpClient,
+			baseURL+LocationServiceDeletePlaceProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("DeletePlace")),
+			connect.WithClientOptions(opts...),
+		),
+		listCheckIns: connect.NewClient[v1.ListCheckInsRequest, v1.ListCheckInsResponse](
+			httpClient,
+			baseURL+LocationServiceListCheckInsProcedure,
+			connect.WithSchema(locationServiceMethods.ByName("ListCheckIns")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// locationServiceClient implements LocationServiceClient.
+type locationServiceClient struct {
+	updateLocation        *connect.Client[v1.UpdateLocationRequest, v1.UpdateLocationResponse]
+	batchUpdateLocation   *connect.Client[v1.BatchUpdateLocationRequest, v1.BatchUpdateLocationResponse]
+	getLocationHistory    *connect.Client[v1.GetLocationHistoryRequest, v1.GetLocationHistoryResponse]
+	deleteLocationHistory *connect.Client[v1.DeleteLocationHistoryRequest, v1.DeleteLocationHistoryResponse]
+	listCircleLocations   *connect.Client[v1.ListCircleLocationsRequest, v1.ListCircleLocationsResponse]
+	listNearbyMembers     *connect.Client[v1.ListNearbyMembersRequest, v1.ListNearbyMembersResponse]
+	startLiveSession      *connect.Client[v1.StartLiveSessionRequest, v1.StartLiveSessionResponse]
+	stopLiveSession       *connect.Client[v1.StopLiveSessionRequest, v1.StopLiveSessionResponse]
+	listLiveSessions      *connect.Client[v1.ListLiveSessionsRequest, v1.ListLiveSessionsResponse]
+	listPlaces            *connect.Client[v1.ListPlacesRequest, v1.ListPlacesResponse]
+	createPlace           *connect.Client[v1.CreatePlaceRequest, v1.CreatePlaceResponse]
+	updatePlace           *connect.Client[v1.UpdatePlaceRequest, v1.UpdatePlaceResponse]
+	deletePlace           *connect.Client[v1.DeletePlaceRequest, v1.DeletePlaceResponse]
+	listCheckIns          *connect.Client[v1.ListCheckInsRequest, v1.ListCheckInsResponse]
+}
+
+// UpdateLocation calls kin.v1.LocationService.UpdateLocation.
+func (c *locationServiceClient) UpdateLocation(ctx context.Context, req *connect.Request[v1.UpdateLocationRequest]) (*connect.Response[v1.UpdateLocationResponse], error) {
+	return c.updateLocation.CallUnary(ctx, req)
+}
+
+// BatchUpdateLocation calls kin.v1.LocationService.BatchUpdateLocation.
+func (c *locationServiceClient) BatchUpdateLocation(ctx context.Context, req *connect.Request[v1.BatchUpdateLocationRequest]) (*connect.Response[v1.BatchUpdateLocationResponse], error) {
+	return c.batchUpdateLocation.CallUnary(ctx, req)
+}
+
+// GetLocationHistory calls kin.v1.LocationService.GetLocationHistory.
+func (c *locationServiceClient) GetLocationHistory(ctx context.Context, req *connect.Request[v1.GetLocationHistoryRequest]) (*connect.Response[v1.GetLocationHistoryResponse], error) {
+	return c.getLocationHistory.CallUnary(ctx, req)
+}
+
+// DeleteLocationHistory calls kin.v1.LocationService.DeleteLocationHistory.
+func (c *locationServiceClient) DeleteLocationHistory(ctx context.Context, req *connect.Request[v1.DeleteLocationHistoryRequest]) (*connect.Response[v1.DeleteLocationHistoryResponse], error) {
+	return c.deleteLocationHistory.CallUnary(ctx, req)
+}
+
+// ListCircleLocations calls kin.v1.LocationService.ListCircleLocations.
+func (c *locationServiceClient) ListCircleLocations(ctx context.Context, req *connect.Request[v1.ListCircleLocationsRequest]) (*connect.Response[v1.ListCircleLocationsResponse], error) {
+	return c.listCircleLocations.CallUnary(ctx, req)
+}
+
+// ListNearbyMembers calls kin.v1.LocationService.ListNearbyMembers.
+func (c *locationServiceClient) ListNearbyMembers(ctx context.Context, req *connect.Request[v1.ListNearbyMembersRequest]) (*connect.Response[v1.ListNearbyMembersResponse], error) {
+	return c.listNearbyMembers.CallUnary(ctx, req)
+}
+
+// StartLiveSession calls kin.v1.LocationService.StartLiveSession.
+func (c *locationServiceClient) StartLiveSession(ctx context.Context, req *connect.Request[v1.StartLiveSessionRequest]) (*connect.Response[v1.StartLiveSessionResponse], error) {
+	return c.startLiveSession.CallUnary(ctx, req)
+}
+
+// StopLiveSession calls kin.v1.LocationService.StopLiveSession.
+func (c *locationServiceClient) StopLiveSession(ctx context.Context, req *connect.Request[v1.StopLiveSessionRequest]) (*connect.Response[v1.StopLiveSessionResponse], error) {
+	return c.stopLiveSession.CallUnary(ctx, req)
+}
+
+// ListLiveSessions calls kin.v1.LocationService.ListLiveSessions.
+func (c *locationServiceClient) ListLiveSessions(ctx context.Context, req *connect.Request[v1.ListLiveSessionsRequest]) (*connect.Response[v1.ListLiveSessionsResponse], error) {
+	return c.listLiveSessions.CallUnary(ctx, req)
+}
+
+// ListPlaces calls kin.v1.LocationService.ListPlaces.
+func (c *locationServiceClient) ListPlaces(ctx context.Context, req *connect.Request[v1.ListPlacesRequest]) (*connect.Response[v1.ListPlacesResponse], error) {
+	return c.listPlaces.CallUnary(ctx, req)
+}
+
+// CreatePlace calls kin.v1.LocationService.CreatePlace.
+func (c *locationServiceClient) CreatePlace(ctx context.Context, req *connect.Request[v1.CreatePlaceRequest]) (*connect.Response[v1.CreatePlaceResponse], error) {
+	return c.createPlace.CallUnary(ctx, req)
+}
+
+// UpdatePlace calls kin.v1.LocationService.UpdatePlace.
+func (c *locationServiceClient) UpdatePlace(ctx context.Context, req *connect.Request[v1.UpdatePlaceRequest]) (*connect.Response[v1.UpdatePlaceResponse], error) {
+	return c.updatePlace.CallUnary(ctx, req)
+}
+
+// DeletePlace calls kin.v1.LocationService.DeletePlace.
+func (c *locationServiceClient) DeletePlace(ctx context.Context, req *connect.Request[v1.DeletePlaceRequest]) (*connect.Response[v1.DeletePlaceResponse], error) {
+	return c.deletePlace.CallUnary(ctx, req)
+}
+
+// ListCheckIns calls kin.v1.LocationService.ListCheckIns.
+func (c *locationServiceClient) ListCheckIns(ctx context.Context, req *connect.Request[v1.ListCheckInsRequest]) (*connect.Response[v1.ListCheckInsResponse], error) {
+	return c.listCheckIns.CallUnary(ctx, req)
+}
+
+// LocationServiceHandler is an implementation of the kin.v1.LocationService service.
+type LocationServiceHandler interface {
+	UpdateLocation(context.Context, *connect.Request[v1.UpdateLocationRequest]) (*connect.Response[v1.UpdateLocationResponse], error)
+	BatchUpdateLocation(context.Context, *connect.Request[v1.BatchUpdateLocationRequest]) (*connect.Response[v1.BatchUpdateLocationResponse], error)
+	GetLocationHistory(context.Context, *connect.Request[v1.GetLocationHistoryRequest]) (*connect.Response[v1.GetLocationHistoryResponse], error)
+	DeleteLocationHistory(context.Context, *connect.Request[v1.DeleteLocationHistoryRequest]) (*connect.Response[v1.DeleteLocationHistoryResponse], error)
+	ListCircleLocations(context.Context, *connect.Request[v1.ListCircleLocationsRequest]) (*connect.Response[v1.ListCircleLocationsResponse], error)
+	ListNearbyMembers(context.Context, *connect.Request[v1.ListNearbyMembersRequest]) (*connect.Response[v1.ListNearbyMembersResponse], error)
+	StartLiveSession(context.Context, *connect.Request[v1.StartLiveSessionRequest]) (*connect.Response[v1.StartLiveSessionResponse], error)
+	StopLiveSession(context.Context, *connect.Request[v1.StopLiveSessionRequest]) (*connect.Response[v1.StopLiveSessionResponse], error)
+	ListLiveSessions(context.Context, *connect.Request[v1.ListLiveSessionsRequest]) (*connect.Response[v1.ListLiveSessionsResponse], error)
+	ListPlaces(context.Context, *connect.Request[v1.ListPlacesRequest]) (*connect.Response[v1.ListPlacesResponse], error)
+	CreatePlace(context.Context, *connect.Request[v1.CreatePlaceRequest]) (*connect.Response[v1.CreatePlaceResponse], error)
+	UpdatePlace(context.Context, *connect.Request[v1.UpdatePlaceRequest]) (*connect.Response[v1.UpdatePlaceResponse], error)
+	DeletePlace(context.Context, *connect.Request[v1.DeletePlaceRequest]) (*connect.Response[v1.DeletePlaceResponse], error)
+	ListCheckIns(context.Context, *connect.Request[v1.ListCheckInsRequest]) (*connect.Response[v1.ListCheckInsResponse], error)
+}
+
+// NewLocationServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLocationServiceHandler(svc LocationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	locationServiceMethods := v1.File_kin_v1_location_proto.Services().ByName("LocationService").Methods()
+	locationServiceUpdateLocationHandler := connect.NewUnaryHandler(
+		LocationServiceUpdateLocationProcedure,
+		svc.UpdateLocation,
+		connect.WithSchema(locationServiceMethods.ByName("UpdateLocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceBatchUpdateLocationHandler := connect.NewUnaryHandler(
+		LocationServiceBatchUpdateLocationProcedure,
+		svc.BatchUpdateLocation,
+		connect.WithSchema(locationServiceMethods.ByName("BatchUpdateLocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceGetLocationHistoryHandler := connect.NewUnaryHandler(
+		LocationServiceGetLocationHistoryProcedure,
+		svc.GetLocationHistory,
+		connect.WithSchema(locationServiceMethods.ByName("GetLocationHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceDeleteLocationHistoryHandler := connect.NewUnaryHandler(
+		LocationServiceDeleteLocationHistoryProcedure,
+		svc.DeleteLocationHistory,
+		connect.WithSchema(locationServiceMethods.ByName("DeleteLocationHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceListCircleLocationsHandler := connect.NewUnaryHandler(
+		LocationServiceListCircleLocationsProcedure,
+		svc.ListCircleLocations,
+		connect.WithSchema(locationServiceMethods.ByName("ListCircleLocations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceListNearbyMembersHandler := connect.NewUnaryHandler(
+		LocationServiceListNearbyMembersProcedure,
+		svc.ListNearbyMembers,
+		connect.WithSchema(locationServiceMethods.ByName("ListNearbyMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceStartLiveSessionHandler := connect.NewUnaryHandler(
+		LocationServiceStartLiveSessionProcedure,
+		svc.StartLiveSession,
+		connect.WithSchema(locationServiceMethods.ByName("StartLiveSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceStopLiveSessionHandler := connect.NewUnaryHandler(
+		LocationServiceStopLiveSessionProcedure,
+		svc.StopLiveSession,
+		connect.WithSchema(locationServiceMethods.ByName("StopLiveSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceListLiveSessionsHandler := connect.NewUnaryHandler(
+		LocationServiceListLiveSessionsProcedure,
+		svc.ListLiveSessions,
+		connect.WithSchema(locationServiceMethods.ByName("ListLiveSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceListPlacesHandler := connect.NewUnaryHandler(
+		LocationServiceListPlacesProcedure,
+		svc.ListPlaces,
+		connect.WithSchema(locationServiceMethods.ByName("ListPlaces")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceCreatePlaceHandler := connect.NewUnaryHandler(
+		LocationServiceCreatePlaceProcedure,
+		svc.CreatePlace,
+		connect.WithSchema(locationServiceMethods.ByName("CreatePlace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceUpdatePlaceHandler := connect.NewUnaryHandler(
+		LocationServiceUpdatePlaceProcedure,
+		svc.UpdatePlace,
+		connect.WithSchema(locationServiceMethods.ByName("UpdatePlace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceDeletePlaceHandler := connect.NewUnaryHandler(
+		LocationServiceDeletePlaceProcedure,
+		svc.DeletePlace,
+		connect.WithSchema(locationServiceMethods.ByName("DeletePlace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	locationServiceListCheckInsHandler := connect.NewUnaryHandler(
+		LocationServiceListCheckInsProcedure,
+		svc.ListCheckIns,
+		connect.WithSchema(locationServiceMethods.ByName("ListCheckIns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/kin.v1.LocationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LocationServiceUpdateLocationProcedure:
+			locationServiceUpdateLocationHandler.ServeHTTP(w, r)
+		case LocationServiceBatchUpdateLocationProcedure:
+			locationServiceBatchUpdateLocationHandler.ServeHTTP(w, r)
+		case LocationServiceGetLocationHistoryProcedure:
+			locationServiceGetLocationHistoryHandler.ServeHTTP(w, r)
+		case LocationServiceDeleteLocationHistoryProcedure:
+			locationServiceDeleteLocationHistoryHandler.ServeHTTP(w, r)
+		case LocationServiceListCircleLocationsProcedure:
+			locationServiceListCircleLocationsHandler.ServeHTTP(w, r)
+		case LocationServiceListNearbyMembersProcedure:
+			locationServiceListNearbyMembersHandler.ServeHTTP(w, r)
+		case LocationServiceStartLiveSessionProcedure:
+			locationServiceStartLiveSessionHandler.ServeHTTP(w, r)
+		case LocationServiceStopLiveSessionProcedure:
+			locationServiceStopLiveSessionHandler.ServeHTTP(w, r)
+		case LocationServiceListLiveSessionsProcedure:
+			locationServiceListLiveSessionsHandler.ServeHTTP(w, r)
+		case LocationServiceListPlacesProcedure:
+			locationServiceListPlacesHandler.ServeHTTP(w, r)
+		case LocationServiceCreatePlaceProcedure:
+			locationServiceCreatePlaceHandler.ServeHTTP(w, r)
+		case LocationServiceUpdatePlaceProcedure:
+			locationServiceUpdatePlaceHandler.ServeHTTP(w, r)
+		case LocationServiceDeletePlaceProcedure:
+			locationServiceDeletePlaceHandler.ServeHTTP(w, r)
+		case LocationServiceListCheckInsProcedure:
+			locationServiceListCheckInsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLocationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLocationServiceHandler struct{}
+
+func (UnimplementedLocationServiceHandler) UpdateLocation(context.Context, *connect.Request[v1.UpdateLocationRequest]) (*connect.Response[v1.UpdateLocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.UpdateLocation is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) BatchUpdateLocation(context.Context, *connect.Request[v1.BatchUpdateLocationRequest]) (*connect.Response[v1.BatchUpdateLocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.BatchUpdateLocation is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) GetLocationHistory(context.Context, *connect.Request[v1.GetLocationHistoryRequest]) (*connect.Response[v1.GetLocationHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.GetLocationHistory is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) DeleteLocationHistory(context.Context, *connect.Request[v1.DeleteLocationHistoryRequest]) (*connect.Response[v1.DeleteLocationHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.DeleteLocationHistory is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) ListCircleLocations(context.Context, *connect.Request[v1.ListCircleLocationsRequest]) (*connect.Response[v1.ListCircleLocationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.ListCircleLocations is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) ListNearbyMembers(context.Context, *connect.Request[v1.ListNearbyMembersRequest]) (*connect.Response[v1.ListNearbyMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.ListNearbyMembers is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) StartLiveSession(context.Context, *connect.Request[v1.StartLiveSessionRequest]) (*connect.Response[v1.StartLiveSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.StartLiveSession is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) StopLiveSession(context.Context, *connect.Request[v1.StopLiveSessionRequest]) (*connect.Response[v1.StopLiveSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.StopLiveSession is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) ListLiveSessions(context.Context, *connect.Request[v1.ListLiveSessionsRequest]) (*connect.Response[v1.ListLiveSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.ListLiveSessions is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) ListPlaces(context.Context, *connect.Request[v1.ListPlacesRequest]) (*connect.Response[v1.ListPlacesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.ListPlaces is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) CreatePlace(context.Context, *connect.Request[v1.CreatePlaceRequest]) (*connect.Response[v1.CreatePlaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.CreatePlace is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) UpdatePlace(context.Context, *connect.Request[v1.UpdatePlaceRequest]) (*connect.Response[v1.UpdatePlaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.UpdatePlace is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) DeletePlace(context.Context, *connect.Request[v1.DeletePlaceRequest]) (*connect.Response[v1.DeletePlaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.DeletePlace is not implemented"))
+}
+
+func (UnimplementedLocationServiceHandler) ListCheckIns(context.Context, *connect.Request[v1.ListCheckInsRequest]) (*connect.Response[v1.ListCheckInsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.LocationService.ListCheckIns is not implemented"))
+}
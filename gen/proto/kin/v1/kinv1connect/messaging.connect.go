@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: kin/v1/messaging.proto
+
+package kinv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MessagingServiceName is the fully-qualified name of the MessagingService service.
+	MessagingServiceName = "kin.v1.MessagingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MessagingServiceSendMessageProcedure is the fully-qualified name of the MessagingService's
+	// SendMessage RPC.
+	MessagingServiceSendMessageProcedure = "/kin.v1.MessagingService/SendMessage"
+	// MessagingServiceGetMessageProcedure is the fully-qualified name of the MessagingService's
+	// GetMessage RPC.
+	MessagingServiceGetMessageProcedure = "/kin.v1.MessagingService/GetMessage"
+	// MessagingServiceListMessagesProcedure is the fully-qualified name of the MessagingService's
+	// ListMessages RPC.
+	MessagingServiceListMessagesProcedure = "/kin.v1.MessagingService/ListMessages"
+	// MessagingServiceEditMessageProcedure is the fully-qualified name of the MessagingService's
+	// EditMessage RPC.
+	MessagingServiceEditMessageProcedure = "/kin.v1.MessagingService/EditMessage"
+	// MessagingServiceDeleteMessageProcedure is the fully-qualified name of the MessagingService's
+	// DeleteMessage RPC.
+	MessagingServiceDeleteMessageProcedure = "/kin.v1.MessagingService/DeleteMessage"
+	// MessagingServiceAddReactionProcedure is the fully-qualified name of the MessagingService's
+	// AddReaction RPC.
+	MessagingServiceAddReactionProcedure = "/kin.v1.MessagingService/AddReaction"
+	// MessagingServiceRemoveReactionProcedure is the fully-qualified name of the MessagingService's
+	// RemoveReaction RPC.
+	MessagingServiceRemoveReactionProcedure = "/kin.v1.MessagingService/RemoveReaction"
+	// MessagingServiceListReactionsProcedure is the fully-qualified name of the MessagingService's
+	// ListReactions RPC.
+	MessagingServiceListReactionsProcedure = "/kin.v1.MessagingService/ListReactions"
+	// MessagingServiceMarkAsReadProcedure is the fully-qualified name of the MessagingService's
+	// MarkAsRead RPC.
+	MessagingServiceMarkAsReadProcedure = "/kin.v1.MessagingService/MarkAsRead"
+	// MessagingServiceMarkAsDeliveredProcedure is the fully-qualified name of the MessagingService's
+	// MarkAsDelivered RPC.
+	MessagingServiceMarkAsDeliveredProcedure = "/kin.v1.MessagingService/MarkAsDelivered"
+	// MessagingServiceSearchMessagesProcedure is the fully-qualified name of the MessagingService's
+	// SearchMessages RPC.
+	MessagingServiceSearchMessagesProcedure = "/kin.v1.MessagingService/SearchMessages"
+)
+
+// MessagingServiceClient is a client for the kin.v1.MessagingService service.
+type MessagingServiceClient interface {
+	SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error)
+	GetMessage(context.Context, *connect.Request[v1.GetMessageRequest]) (*connect.Response[v1.GetMessageResponse], error)
+	ListMessages(context.Context, *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error)
+	EditMessage(context.Context, *connect.Request[v1.EditMessageRequest]) (*connect.Response[v1.EditMessageResponse], error)
+	DeleteMessage(context.Context, *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error)
+	AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error)
+	RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error)
+	ListReactions(context.Context, *connect.Request[v1.ListReactionsRequest]) (*connect.Response[v1.ListReactionsResponse], error)
+	MarkAsRead(context.Context, *connect.Request[v1.MarkAsReadRequest]) (*connect.Response[v1.MarkAsReadResponse], error)
+	MarkAsDelivered(context.Context, *connect.Request[v1.MarkAsDeliveredRequest]) (*connect.Response[v1.MarkAsDeliveredResponse], error)
+	SearchMessages(context.Context, *connect.Request[v1.SearchMessagesRequest]) (*connect.Response[v1.SearchMessagesResponse], error)
+}
+
+// NewMessagingServiceClient constructs a client for the kin.v1.MessagingService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMessagingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MessagingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	messagingServiceMethods := v1.File_kin_v1_messaging_proto.Services().ByName("MessagingService").Methods()
+	return &messagingServiceClient{
+		sendMessage: connect.NewClient[v1.SendMessageRequest, v1.SendMessageResponse](
+			httpClient,
+			baseURL+MessagingServiceSendMessageProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("SendMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		getMessage: connect.NewClient[v1.GetMessageRequest, v1.GetMessageResponse](
+			httpClient,
+			baseURL+MessagingServiceGetMessageProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("GetMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		listMessages: connect.NewClient[v1.ListMessagesRequest, v1.ListMessagesResponse](
+			httpClient,
+			baseURL+MessagingServiceListMessagesProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("ListMessages")),
+			connect.WithClientOptions(opts...),
+		),
+		editMessage: connect.NewClient[v1.EditMessageRequest, v1.EditMessageResponse](
+			httpClient,
+			baseURL+MessagingServiceEditMessageProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("EditMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMessage: connect.NewClient[v1.DeleteMessageRequest, v1.DeleteMessageResponse](
+			httpClient,
+			baseURL+MessagingServiceDeleteMessageProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("DeleteMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		addReaction: connect.NewClient[v1.AddReactionRequest, v1.AddReactionResponse](
+			httpClient,
+			baseURL+MessagingServiceAddReactionProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("AddReaction")),
+			connect.WithClientOptions(opts...),
+		),
+		removeReaction: connect.NewClient[v1.RemoveReactionRequest, v1.RemoveReactionResponse](
+			httpClient,
+			baseURL+MessagingServiceRemoveReactionProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("RemoveReaction")),
+			connect.WithClientOptions(opts...),
+		),
+		listReactions: connect.NewClient[v1.ListReactionsRequest, v1.ListReactionsResponse](
+			httpClient,
+			baseURL+MessagingServiceListReactionsProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("ListReactions")),
+			connect.WithClientOptions(opts...),
+		),
+		markAsRead: connect.NewClient[v1.MarkAsReadRequest, v1.MarkAsReadResponse](
+			httpClient,
+			baseURL+MessagingServiceMarkAsReadProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("MarkAsRead")),
+			connect.WithClientOptions(opts...),
+		),
+		markAsDelivered: connect.NewClient[v1.MarkAsDeliveredRequest, v1.MarkAsDeliveredResponse](
+			httpClient,
+			baseURL+MessagingServiceMarkAsDeliveredProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("MarkAsDelivered")),
+			connect.WithClientOptions(opts...),
+		),
+		searchMessages: connect.NewClient[v1.SearchMessagesRequest, v1.SearchMessagesResponse](
+			httpClient,
+			baseURL+MessagingServiceSearchMessagesProcedure,
+			connect.WithSchema(messagingServiceMethods.ByName("SearchMessages")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// messagingServiceClient implements MessagingServiceClient.
+type messagingServiceClient struct {
+	sendMessage     *connect.Client[v1.SendMessageRequest, v1.SendMessageResponse]
+	getMessage      *connect.Client[v1.GetMessageRequest, v1.GetMessageResponse]
+	listMessages    *connect.Client[v1.ListMessagesRequest, v1.ListMessagesResponse]
+	editMessage     *connect.Client[v1.EditMessageRequest, v1.EditMessageResponse]
+	deleteMessage   *connect.Client[v1.DeleteMessageRequest, v1.DeleteMessageResponse]
+	addReaction     *connect.Client[v1.AddReactionRequest, v1.AddReactionResponse]
+	removeReaction  *connect.Client[v1.RemoveReactionRequest, v1.RemoveReactionResponse]
+	listReactions   *connect.Client[v1.ListReactionsRequest, v1.ListReactionsResponse]
+	markAsRead      *connect.Client[v1.MarkAsReadRequest, v1.MarkAsReadResponse]
+	markAsDelivered *connect.Client[v1.MarkAsDeliveredRequest, v1.MarkAsDeliveredResponse]
+	searchMessages  *connect.Client[v1.SearchMessagesRequest, v1.SearchMessagesResponse]
+}
+
+// SendMessage calls kin.v1.MessagingService.SendMessage.
+func (c *messagingServiceClient) SendMessage(ctx context.Context, req *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error) {
+	return c.sendMessage.CallUnary(ctx, req)
+}
+
+// GetMessage calls kin.v1.MessagingService.GetMessage.
+func (c *messagingServiceClient) GetMessage(ctx context.Context, req *connect.Request[v1.GetMessageRequest]) (*connect.Response[v1.GetMessageResponse], error) {
+	return c.getMessage.CallUnary(ctx, req)
+}
+
+// ListMessages calls kin.v1.MessagingService.ListMessages.
+func (c *messagingServiceClient) ListMessages(ctx context.Context, req *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error) {
+	return c.listMessages.CallUnary(ctx, req)
+}
+
+// EditMessage calls kin.v1.MessagingService.EditMessage.
+func (c *messagingServiceClient) EditMessage(ctx context.Context, req *connect.Request[v1.EditMessageRequest]) (*connect.Response[v1.EditMessageResponse], error) {
+	return c.editMessage.CallUnary(ctx, req)
+}
+
+// DeleteMessage calls kin.v1.MessagingService.DeleteMessage.
+func (c *messagingServiceClient) DeleteMessage(ctx context.Context, req *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error) {
+	return c.deleteMessage.CallUnary(ctx, req)
+}
+
+// AddReaction calls kin.v1.MessagingService.AddReaction.
+func (c *messagingServiceClient) AddReaction(ctx context.Context, req *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error) {
+	return c.addReaction.CallUnary(ctx, req)
+}
+
+// RemoveReaction calls kin.v1.MessagingService.RemoveReaction.
+func (c *messagingServiceClient) RemoveReaction(ctx context.Context, req *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error) {
+	return c.removeReaction.CallUnary(ctx, req)
+}
+
+// ListReactions calls kin.v1.MessagingService.ListReactions.
+func (c *messagingServiceClient) ListReactions(ctx context.Context, req *connect.Request[v1.ListReactionsRequest]) (*connect.Response[v1.ListReactionsResponse], error) {
+	return c.listReactions.CallUnary(ctx, req)
+}
+
+// MarkAsRead calls kin.v1.MessagingService.MarkAsRead.
+func (c *messagingServiceClient) MarkAsRead(ctx context.Context, req *connect.Request[v1.MarkAsReadRequest]) (*connect.Response[v1.MarkAsReadResponse], error) {
+	return c.markAsRead.CallUnary(ctx, req)
+}
+
+// MarkAsDelivered calls kin.v1.MessagingService.MarkAsDelivered.
+func (c *messagingServiceClient) MarkAsDelivered(ctx context.Context, req *connect.Request[v1.MarkAsDeliveredRequest]) (*connect.Response[v1.MarkAsDeliveredResponse], error) {
+	return c.markAsDelivered.CallUnary(ctx, req)
+}
+
+// SearchMessages calls kin.v1.MessagingService.SearchMessages.
+func (c *messagingServiceClient) SearchMessages(ctx context.Context, req *connect.Request[v1.SearchMessagesRequest]) (*connect.Response[v1.SearchMessagesResponse], error) {
+	return c.searchMessages.CallUnary(ctx, req)
+}
+
+// MessagingServiceHandler is an implementation of the kin.v1.MessagingService service.
+type MessagingServiceHandler interface {
+	SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error)
+	GetMessage(context.Context, *connect.Request[v1.GetMessageRequest]) (*connect.Response[v1.GetMessageResponse], error)
+	ListMessages(context.Context, *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error)
+	EditMessage(context.Context, *connect.Request[v1.EditMessageRequest]) (*connect.Response[v1.EditMessageResponse], error)
+	DeleteMessage(context.Context, *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error)
+	AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error)
+	RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error)
+	ListReactions(context.Context, *connect.Request[v1.ListReactionsRequest]) (*connect.Response[v1.ListReactionsResponse], error)
+	MarkAsRead(context.Context, *connect.Request[v1.MarkAsReadRequest]) (*connect.Response[v1.MarkAsReadResponse], error)
+	MarkAsDelivered(context.Context, *connect.Request[v1.MarkAsDeliveredRequest]) (*connect.Response[v1.MarkAsDeliveredResponse], error)
+	SearchMessages(context.Context, *connect.Request[v1.SearchMessagesRequest]) (*connect.Response[v1.SearchMessagesResponse], error)
+}
+
+// NewMessagingServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMessagingServiceHandler(svc MessagingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	messagingServiceMethods := v1.File_kin_v1_messaging_proto.Services().ByName("MessagingService").Methods()
+	messagingServiceSendMessageHandler := connect.NewUnaryHandler(
+		MessagingServiceSendMessageProcedure,
+		svc.SendMessage,
+		connect.WithSchema(messagingServiceMethods.ByName("SendMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceGetMessageHandler := connect.NewUnaryHandler(
+		MessagingServiceGetMessageProcedure,
+		svc.GetMessage,
+		connect.WithSchema(messagingServiceMethods.ByName("GetMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceListMessagesHandler := connect.NewUnaryHandler(
+		MessagingServiceListMessagesProcedure,
+		svc.ListMessages,
+		connect.WithSchema(messagingServiceMethods.ByName("ListMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceEditMessageHandler := connect.NewUnaryHandler(
+		MessagingServiceEditMessageProcedure,
+		svc.EditMessage,
+		connect.WithSchema(messagingServiceMethods.ByName("EditMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceDeleteMessageHandler := connect.NewUnaryHandler(
+		MessagingServiceDeleteMessageProcedure,
+		svc.DeleteMessage,
+		connect.WithSchema(messagingServiceMethods.ByName("DeleteMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceAddReactionHandler := connect.NewUnaryHandler(
+		MessagingServiceAddReactionProcedure,
+		svc.AddReaction,
+		connect.WithSchema(messagingServiceMethods.ByName("AddReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceRemoveReactionHandler := connect.NewUnaryHandler(
+		MessagingServiceRemoveReactionProcedure,
+		svc.RemoveReaction,
+		connect.WithSchema(messagingServiceMethods.ByName("RemoveReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceListReactionsHandler := connect.NewUnaryHandler(
+		MessagingServiceListReactionsProcedure,
+		svc.ListReactions,
+		connect.WithSchema(messagingServiceMethods.ByName("ListReactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceMarkAsReadHandler := connect.NewUnaryHandler(
+		MessagingServiceMarkAsReadProcedure,
+		svc.MarkAsRead,
+		connect.WithSchema(messagingServiceMethods.ByName("MarkAsRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceMarkAsDeliveredHandler := connect.NewUnaryHandler(
+		MessagingServiceMarkAsDeliveredProcedure,
+		svc.MarkAsDelivered,
+		connect.WithSchema(messagingServiceMethods.ByName("MarkAsDelivered")),
+		connect.WithHandlerOptions(opts...),
+	)
+	messagingServiceSearchMessagesHandler := connect.NewUnaryHandler(
+		MessagingServiceSearchMessagesProcedure,
+		svc.SearchMessages,
+		connect.WithSchema(messagingServiceMethods.ByName("SearchMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/kin.v1.MessagingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MessagingServiceSendMessageProcedure:
+			messagingServiceSendMessageHandler.ServeHTTP(w, r)
+		case MessagingServiceGetMessageProcedure:
+			messagingServiceGetMessageHandler.ServeHTTP(w, r)
+		case MessagingServiceListMessagesProcedure:
+			messagingServiceListMessagesHandler.ServeHTTP(w, r)
+		case MessagingServiceEditMessageProcedure:
+			messagingServiceEditMessageHandler.ServeHTTP(w, r)
+		case MessagingServiceDeleteMessageProcedure:
+			messagingServiceDeleteMessageHandler.ServeHTTP(w, r)
+		case MessagingServiceAddReactionProcedure:
+			messagingServiceAddReactionHandler.ServeHTTP(w, r)
+		case MessagingServiceRemoveReactionProcedure:
+			messagingServiceRemoveReactionHandler.ServeHTTP(w, r)
+		case MessagingServiceListReactionsProcedure:
+			messagingServiceListReactionsHandler.ServeHTTP(w, r)
+		case MessagingServiceMarkAsReadProcedure:
+			messagingServiceMarkAsReadHandler.ServeHTTP(w, r)
+		case MessagingServiceMarkAsDeliveredProcedure:
+			messagingServiceMarkAsDeliveredHandler.ServeHTTP(w, r)
+		case MessagingServiceSearchMessagesProcedure:
+			messagingServiceSearchMessagesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMessagingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMessagingServiceHandler struct{}
+
+func (UnimplementedMessagingServiceHandler) SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.SendMessage is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) GetMessage(context.Context, *connect.Request[v1.GetMessageRequest]) (*connect.Response[v1.GetMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.GetMessage is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) ListMessages(context.Context, *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.ListMessages is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) EditMessage(context.Context, *connect.Request[v1.EditMessageRequest]) (*connect.Response[v1.EditMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.EditMessage is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) DeleteMessage(context.Context, *connect.Request[v1.DeleteMessageRequest]) (*connect.Response[v1.DeleteMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.DeleteMessage is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) AddReaction(context.Context, *connect.Request[v1.AddReactionRequest]) (*connect.Response[v1.AddReactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.AddReaction is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.RemoveReaction is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) ListReactions(context.Context, *connect.Request[v1.ListReactionsRequest]) (*connect.Response[v1.ListReactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.ListReactions is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) MarkAsRead(context.Context, *connect.Request[v1.MarkAsReadRequest]) (*connect.Response[v1.MarkAsReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.MarkAsRead is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) MarkAsDelivered(context.Context, *connect.Request[v1.MarkAsDeliveredRequest]) (*connect.Response[v1.MarkAsDeliveredResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.MarkAsDelivered is not implemented"))
+}
+
+func (UnimplementedMessagingServiceHandler) SearchMessages(context.Context, *connect.Request[v1.SearchMessagesRequest]) (*connect.Response[v1.SearchMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.MessagingService.SearchMessages is not implemented"))
+}
@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: kin/v1/presence.proto
+
+package kinv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PresenceServiceName is the fully-qualified name of the PresenceService service.
+	PresenceServiceName = "kin.v1.PresenceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PresenceServiceHeartbeatProcedure is the fully-qualified name of the PresenceService's Heartbeat
+	// RPC.
+	PresenceServiceHeartbeatProcedure = "/kin.v1.PresenceService/Heartbeat"
+	// PresenceServiceGoOfflineProcedure is the fully-qualified name of the PresenceService's GoOffline
+	// RPC.
+	PresenceServiceGoOfflineProcedure = "/kin.v1.PresenceService/GoOffline"
+	// PresenceServiceGetPresenceProcedure is the fully-qualified name of the PresenceService's
+	// GetPresence RPC.
+	PresenceServiceGetPresenceProcedure = "/kin.v1.PresenceService/GetPresence"
+	// PresenceServiceListCirclePresencesProcedure is the fully-qualified name of the PresenceService's
+	// ListCirclePresences RPC.
+	PresenceServiceListCirclePresencesProcedure = "/kin.v1.PresenceService/ListCirclePresences"
+	// PresenceServiceSetTypingProcedure is the fully-qualified name of the PresenceService's SetTyping
+	// RPC.
+	PresenceServiceSetTypingProcedure = "/kin.v1.PresenceService/SetTyping"
+	// PresenceServiceClearTypingProcedure is the fully-qualified name of the PresenceService's
+	// ClearTyping RPC.
+	PresenceServiceClearTypingProcedure = "/kin.v1.PresenceService/ClearTyping"
+)
+
+// PresenceServiceClient is a client for the kin.v1.PresenceService service.
+type PresenceServiceClient interface {
+	Heartbeat(context.Context, *connect.Request[v1.HeartbeatRequest]) (*connect.Response[v1.HeartbeatResponse], error)
+	GoOffline(context.Context, *connect.Request[v1.GoOfflineRequest]) (*connect.Response[v1.GoOfflineResponse], error)
+	GetPresence(context.Context, *connect.Request[v1.GetPresenceRequest]) (*connect.Response[v1.GetPresenceResponse], error)
+	ListCirclePresences(context.Context, *connect.Request[v1.ListCirclePresencesRequest]) (*connect.Response[v1.ListCirclePresencesResponse], error)
+	SetTyping(context.Context, *connect.Request[v1.SetTypingRequest]) (*connect.Response[v1.SetTypingResponse], error)
+	ClearTyping(context.Context, *connect.Request[v1.ClearTypingRequest]) (*connect.Response[v1.ClearTypingResponse], error)
+}
+
+// NewPresenceServiceClient constructs a client for the kin.v1.PresenceService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPresenceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PresenceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	presenceServiceMethods := v1.File_kin_v1_presence_proto.Services().ByName("PresenceService").Methods()
+	return &presenceServiceClient{
+		heartbeat: connect.NewClient[v1.HeartbeatRequest, v1.HeartbeatResponse](
+			httpClient,
+			baseURL+PresenceServiceHeartbeatProcedure,
+			connect.WithSchema(presenceServiceMethods.ByName("Heartbeat")),
+			connect.WithClientOptions(opts...),
+		),
+		goOffline: connect.NewClient[v1.GoOfflineRequest, v1.GoOfflineResponse](
+			httpClient,
+			baseURL+PresenceServiceGoOfflineProcedure,
+			connect.WithSchema(presenceServiceMethods.ByName("GoOffline")),
+			connect.WithClientOptions(opts...),
+		),
+		getPresence: connect.NewClient[v1.GetPresenceRequest, v1.GetPresenceResponse](
+			httpClient,
+			baseURL+PresenceServiceGetPresenceProcedure,
+			connect.WithSchema(presenceServiceMethods.ByName("GetPresence")),
+			connect.WithClientOptions(opts...),
+		),
+		listCirclePresences: connect.NewClient[v1.ListCirclePresencesRequest, v1.ListCirclePresencesResponse](
+			httpClient,
+			baseURL+PresenceServiceListCirclePresencesProcedure,
+			connect.WithSchema(presenceServiceMethods.ByName("ListCirclePresences")),
+			connect.WithClientOptions(opts...),
+		),
+		setTyping: connect.NewClient[v1.SetTypingRequest, v1.SetTypingResponse](
+			httpClient,
+			baseURL+PresenceServiceSetTypingProcedure,
+			connect.WithSchema(presenceServiceMethods.ByName("SetTyping")),
+			connect.WithClientOptions(opts...),
+		),
+		clearTyping: connect.NewClient[v1.ClearTypingRequest, v1.ClearTypingResponse](
+			httpClient,
+			baseURL+PresenceServiceClearTypingProcedure,
+			connect.WithSchema(presenceServiceMethods.ByName("ClearTyping")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// presenceServiceClient implements PresenceServiceClient.
+type presenceServiceClient struct {
+	heartbeat           *connect.Client[v1.HeartbeatRequest, v1.HeartbeatResponse]
+	goOffline           *connect.Client[v1.GoOfflineRequest, v1.GoOfflineResponse]
+	getPresence         *connect.Client[v1.GetPresenceRequest, v1.GetPresenceResponse]
+	listCirclePresences *connect.Client[v1.ListCirclePresencesRequest, v1.ListCirclePresencesResponse]
+	setTyping           *connect.Client[v1.SetTypingRequest, v1.SetTypingResponse]
+	clearTyping         *connect.Client[v1.ClearTypingRequest, v1.ClearTypingResponse]
+}
+
+// Heartbeat calls kin.v1.PresenceService.Heartbeat.
+func (c *presenceServiceClient) Heartbeat(ctx context.Context, req *connect.Request[v1.HeartbeatRequest]) (*connect.Response[v1.HeartbeatResponse], error) {
+	return c.heartbeat.CallUnary(ctx, req)
+}
+
+// GoOffline calls kin.v1.PresenceService.GoOffline.
+func (c *presenceServiceClient) GoOffline(ctx context.Context, req *connect.Request[v1.GoOfflineRequest]) (*connect.Response[v1.GoOfflineResponse], error) {
+	return c.goOffline.CallUnary(ctx, req)
+}
+
+// GetPresence calls kin.v1.PresenceService.GetPresence.
+func (c *presenceServiceClient) GetPresence(ctx context.Context, req *connect.Request[v1.GetPresenceRequest]) (*connect.Response[v1.GetPresenceResponse], error) {
+	return c.getPresence.CallUnary(ctx, req)
+}
+
+// ListCirclePresences calls kin.v1.PresenceService.ListCirclePresences.
+func (c *presenceServiceClient) ListCirclePresences(ctx context.Context, req *connect.Request[v1.ListCirclePresencesRequest]) (*connect.Response[v1.ListCirclePresencesResponse], error) {
+	return c.listCirclePresences.CallUnary(ctx, req)
+}
+
+// SetTyping calls kin.v1.PresenceService.SetTyping.
+func (c *presenceServiceClient) SetTyping(ctx context.Context, req *connect.Request[v1.SetTypingRequest]) (*connect.Response[v1.SetTypingResponse], error) {
+	return c.setTyping.CallUnary(ctx, req)
+}
+
+// ClearTyping calls kin.v1.PresenceService.ClearTyping.
+func (c *presenceServiceClient) ClearTyping(ctx context.Context, req *connect.Request[v1.ClearTypingRequest]) (*connect.Response[v1.ClearTypingResponse], error) {
+	return c.clearTyping.CallUnary(ctx, req)
+}
+
+// PresenceServiceHandler is an implementation of the kin.v1.PresenceService service.
+type PresenceServiceHandler interface {
+	Heartbeat(context.Context, *connect.Request[v1.HeartbeatRequest]) (*connect.Response[v1.HeartbeatResponse], error)
+	GoOffline(context.Context, *connect.Request[v1.GoOfflineRequest]) (*connect.Response[v1.GoOfflineResponse], error)
+	GetPresence(context.Context, *connect.Request[v1.GetPresenceRequest]) (*connect.Response[v1.GetPresenceResponse], error)
+	ListCirclePresences(context.Context, *connect.Request[v1.ListCirclePresencesRequest]) (*connect.Response[v1.ListCirclePresencesResponse], error)
+	SetTyping(context.Context, *connect.Request[v1.SetTypingRequest]) (*connect.Response[v1.SetTypingResponse], error)
+	ClearTyping(context.Context, *connect.Request[v1.ClearTypingRequest]) (*connect.Response[v1.ClearTypingResponse], error)
+}
+
+// NewPresenceServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPresenceServiceHandler(svc PresenceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	presenceServiceMethods := v1.File_kin_v1_presence_proto.Services().ByName("PresenceService").Methods()
+	presenceServiceHeartbeatHandler := connect.NewUnaryHandler(
+		PresenceServiceHeartbeatProcedure,
+		svc.Heartbeat,
+		connect.WithSchema(presenceServiceMethods.ByName("Heartbeat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	presenceServiceGoOfflineHandler := connect.NewUnaryHandler(
+		PresenceServiceGoOfflineProcedure,
+		svc.GoOffline,
+		connect.WithSchema(presenceServiceMethods.ByName("GoOffline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	presenceServiceGetPresenceHandler := connect.NewUnaryHandler(
+		PresenceServiceGetPresenceProcedure,
+		svc.GetPresence,
+		connect.WithSchema(presenceServiceMethods.ByName("GetPresence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	presenceServiceListCirclePresencesHandler := connect.NewUnaryHandler(
+		PresenceServiceListCirclePresencesProcedure,
+		svc.ListCirclePresences,
+		connect.WithSchema(presenceServiceMethods.ByName("ListCirclePresences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	presenceServiceSetTypingHandler := connect.NewUnaryHandler(
+		PresenceServiceSetTypingProcedure,
+		svc.SetTyping,
+		connect.WithSchema(presenceServiceMethods.ByName("SetTyping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	presenceServiceClearTypingHandler := connect.NewUnaryHandler(
+		PresenceServiceClearTypingProcedure,
+		svc.ClearTyping,
+		connect.WithSchema(presenceServiceMethods.ByName("ClearTyping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/kin.v1.PresenceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PresenceServiceHeartbeatProcedure:
+			presenceServiceHeartbeatHandler.ServeHTTP(w, r)
+		case PresenceServiceGoOfflineProcedure:
+			presenceServiceGoOfflineHandler.ServeHTTP(w, r)
+		case PresenceServiceGetPresenceProcedure:
+			presenceServiceGetPresenceHandler.ServeHTTP(w, r)
+		case PresenceServiceListCirclePresencesProcedure:
+			presenceServiceListCirclePresencesHandler.ServeHTTP(w, r)
+		case PresenceServiceSetTypingProcedure:
+			presenceServiceSetTypingHandler.ServeHTTP(w, r)
+		case PresenceServiceClearTypingProcedure:
+			presenceServiceClearTypingHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPresenceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPresenceServiceHandler struct{}
+
+func (UnimplementedPresenceServiceHandler) Heartbeat(context.Context, *connect.Request[v1.HeartbeatRequest]) (*connect.Response[v1.HeartbeatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.PresenceService.Heartbeat is not implemented"))
+}
+
+func (UnimplementedPresenceServiceHandler) GoOffline(context.Context, *connect.Request[v1.GoOfflineRequest]) (*connect.Response[v1.GoOfflineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.PresenceService.GoOffline is not implemented"))
+}
+
+func (UnimplementedPresenceServiceHandler) GetPresence(context.Context, *connect.Request[v1.GetPresenceRequest]) (*connect.Response[v1.GetPresenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.PresenceService.GetPresence is not implemented"))
+}
+
+func (UnimplementedPresenceServiceHandler) ListCirclePresences(context.Context, *connect.Request[v1.ListCirclePresencesRequest]) (*connect.Response[v1.ListCirclePresencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.PresenceService.ListCirclePresences is not implemented"))
+}
+
+func (UnimplementedPresenceServiceHandler) SetTyping(context.Context, *connect.Request[v1.SetTypingRequest]) (*connect.Response[v1.SetTypingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.PresenceService.SetTyping is not implemented"))
+}
+
+func (UnimplementedPresenceServiceHandler) ClearTyping(context.Context, *connect.Request[v1.ClearTypingRequest]) (*connect.Response[v1.ClearTypingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.PresenceService.ClearTyping is not implemented"))
+}
@@ -47,6 +47,8 @@ const (
 	// UserServiceUpdatePreferencesProcedure is the fully-qualified name of the UserService's
 	// UpdatePreferences RPC.
 	UserServiceUpdatePreferencesProcedure = "/kin.v1.UserService/UpdatePreferences"
+	// UserServiceSearchUsersProcedure is the fully-qualified name of the UserService's SearchUsers RPC.
+	UserServiceSearchUsersProcedure = "/kin.v1.UserService/SearchUsers"
 )
 
 // UserServiceClient is a client for the kin.v1.UserService service.
@@ -56,6 +58,7 @@ type UserServiceClient interface {
 	UpdateTimezone(context.Context, *connect.Request[v1.UpdateTimezoneRequest]) (*connect.Response[v1.UpdateTimezoneResponse], error)
 	GetPreferences(context.Context, *connect.Request[v1.GetPreferencesRequest]) (*connect.Response[v1.GetPreferencesResponse], error)
 	UpdatePreferences(context.Context, *connect.Request[v1.UpdatePreferencesRequest]) (*connect.Response[v1.UpdatePreferencesResponse], error)
+	SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the kin.v1.UserService service. By default, it uses
@@ -99,6 +102,12 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("UpdatePreferences")),
 			connect.WithClientOptions(opts...),
 		),
+		searchUsers: connect.NewClient[v1.SearchUsersRequest, v1.SearchUsersResponse](
+			httpClient,
+			baseURL+UserServiceSearchUsersProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SearchUsers")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -109,6 +118,7 @@ type userServiceClient struct {
 	updateTimezone    *connect.Client[v1.UpdateTimezoneRequest, v1.UpdateTimezoneResponse]
 	getPreferences    *connect.Client[v1.GetPreferencesRequest, v1.GetPreferencesResponse]
 	updatePreferences *connect.Client[v1.UpdatePreferencesRequest, v1.UpdatePreferencesResponse]
+	searchUsers       *connect.Client[v1.SearchUsersRequest, v1.SearchUsersResponse]
 }
 
 // GetMe calls kin.v1.UserService.GetMe.
@@ -136,6 +146,11 @@ func (c *userServiceClient) UpdatePreferences(ctx context.Context, req *connect.
 	return c.updatePreferences.CallUnary(ctx, req)
 }
 
+// SearchUsers calls kin.v1.UserService.SearchUsers.
+func (c *userServiceClient) SearchUsers(ctx context.Context, req *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error) {
+	return c.searchUsers.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the kin.v1.UserService service.
 type UserServiceHandler interface {
 	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
@@ -143,6 +158,7 @@ type UserServiceHandler interface {
 	UpdateTimezone(context.Context, *connect.Request[v1.UpdateTimezoneRequest]) (*connect.Response[v1.UpdateTimezoneResponse], error)
 	GetPreferences(context.Context, *connect.Request[v1.GetPreferencesRequest]) (*connect.Response[v1.GetPreferencesResponse], error)
 	UpdatePreferences(context.Context, *connect.Request[v1.UpdatePreferencesRequest]) (*connect.Response[v1.UpdatePreferencesResponse], error)
+	SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -182,6 +198,12 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("UpdatePreferences")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceSearchUsersHandler := connect.NewUnaryHandler(
+		UserServiceSearchUsersProcedure,
+		svc.SearchUsers,
+		connect.WithSchema(userServiceMethods.ByName("SearchUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/kin.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetMeProcedure:
@@ -194,6 +216,8 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceGetPreferencesHandler.ServeHTTP(w, r)
 		case UserServiceUpdatePreferencesProcedure:
 			userServiceUpdatePreferencesHandler.ServeHTTP(w, r)
+		case UserServiceSearchUsersProcedure:
+			userServiceSearchUsersHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -222,3 +246,7 @@ func (UnimplementedUserServiceHandler) GetPreferences(context.Context, *connect.
 func (UnimplementedUserServiceHandler) UpdatePreferences(context.Context, *connect.Request[v1.UpdatePreferencesRequest]) (*connect.Response[v1.UpdatePreferencesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.UserService.UpdatePreferences is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kin.v1.UserService.SearchUsers is not implemented"))
+}
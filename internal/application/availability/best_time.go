@@ -0,0 +1,159 @@
+package availability
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultBestTimeDuration = 30 * time.Minute
+	defaultBestTimeLimit    = 10
+)
+
+// FindBestTimes returns ranked slots in which every participant is expected to
+// be free. Participants who do not share their availability with the
+// requester are left out of the search and reported as excluded; timezones are
+// only returned for participants who share them.
+func (s *Service) FindBestTimes(ctx context.Context, query FindBestTimesQuery) (*availability.BestTimes, error) {
+	from, to := query.From, query.To
+	if now := time.Now(); from.Before(now) {
+		from = now
+	}
+	if !to.After(from) {
+		return nil, availability.ErrInvalidTimeRange
+	}
+	if to.Sub(from) > availability.MaxSearchRange {
+		return nil, availability.ErrTimeRangeTooLong
+	}
+
+	minDuration := query.MinDuration
+	if minDuration <= 0 {
+		minDuration = defaultBestTimeDuration
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultBestTimeLimit
+	}
+
+	var (
+		grants map[uuid.UUID]privacy.Grant
+		err    error
+	)
+	if query.CircleID != nil {
+		grants, err = s.projector.CircleGrants(ctx, query.UserID, *query.CircleID)
+	} else {
+		participants := participantIDs(query.UserID, query.UserIDs)
+		if len(participants) > availability.MaxParticipants {
+			return nil, availability.ErrTooManyParticipants
+		}
+		grants, err = s.projector.Grants(ctx, query.UserID, participants, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(grants) > availability.MaxParticipants {
+		return nil, availability.ErrTooManyParticipants
+	}
+
+	result := &availability.BestTimes{}
+	var (
+		inputs []availability.ResolveInput
+		loc    *time.Location
+	)
+	for _, id := range participantOrder(query.UserID, grants) {
+		grant := grants[id]
+		if !grant.Availability {
+			result.Excluded = append(result.Excluded, id)
+			continue
+		}
+
+		a, err := s.GetAvailability(ctx, GetAvailabilityQuery{UserID: id})
+		if err != nil {
+			return nil, err
+		}
+		in, err := s.resolveInput(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.addQuietHours(ctx, &in); err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, in)
+
+		tz := in.Location.String()
+		view := grant.Apply(privacy.Subject{UserID: id, Timezone: &tz})
+		result.Participants = append(result.Participants, availability.Participant{UserID: id, Timezone: view.Timezone})
+
+		if id == query.UserID {
+			loc = in.Location
+		}
+	}
+
+	slots := availability.FindSlots(inputs, from, to, minDuration, loc)
+	if len(slots) > limit {
+		slots = slots[:limit]
+	}
+	result.Slots = slots
+
+	return result, nil
+}
+
+// participantIDs returns the requester followed by the other users, each
+// listed once.
+func participantIDs(userID uuid.UUID, others []uuid.UUID) []uuid.UUID {
+	ids := []uuid.UUID{userID}
+	seen := map[uuid.UUID]bool{userID: true}
+	for _, id := range others {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// addQuietHours treats the user's quiet hours as a daily do-not-disturb rule
+// that outranks all of their own rules.
+func (s *Service) addQuietHours(ctx context.Context, in *availability.ResolveInput) error {
+	userID := in.Availability.UserID
+	prefs, err := s.userRepo.GetPreferences(ctx, userID)
+	if errors.Is(err, user.ErrPreferencesNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !prefs.QuietHoursEnabled || prefs.QuietHoursStart == nil || prefs.QuietHoursEnd == nil {
+		return nil
+	}
+
+	rule := availability.NewAutoRule(userID, "Quiet hours", availability.Condition{
+		Type:      availability.ConditionTypeTimeRange,
+		StartTime: prefs.QuietHoursStart,
+		EndTime:   prefs.QuietHoursEnd,
+	}, availability.StatusDoNotDisturb, math.MaxInt)
+	in.Rules = append(in.Rules, rule)
+	return nil
+}
+
+// participantOrder lists the requester first, then everyone else in a stable
+// order.
+func participantOrder(requesterID uuid.UUID, grants map[uuid.UUID]privacy.Grant) []uuid.UUID {
+	ids := []uuid.UUID{requesterID}
+	for id := range grants {
+		if id != requesterID {
+			ids = append(ids, id)
+		}
+	}
+	slices.SortFunc(ids[1:], func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+	return ids
+}
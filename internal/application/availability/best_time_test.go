@@ -0,0 +1,119 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+func TestFindBestTimes(t *testing.T) {
+	ctx := context.Background()
+	alice, bob, carol, dave := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// Bob shares his availability and timezone, Carol only her availability
+	// and Dave nothing.
+	circleID := uuid.New()
+	share := func(userID uuid.UUID, timezone bool) *circle.SharingPreference {
+		pref := circle.NewSharingPreference(circleID, userID)
+		pref.PrivacyLevel = user.PrivacyLevelStatus
+		pref.ShareAvailability = true
+		pref.ShareTimezone = timezone
+		return pref
+	}
+	circles := &fakeCircles{
+		circleID: circleID,
+		members:  []uuid.UUID{alice, bob, carol, dave},
+		prefs: map[uuid.UUID]*circle.SharingPreference{
+			bob:   share(bob, true),
+			carol: share(carol, false),
+		},
+	}
+	svc, _, _ := newTestService(circles)
+
+	from := time.Now().Add(time.Hour)
+	to := from.Add(24 * time.Hour)
+
+	t.Run("respects what each participant shares", func(t *testing.T) {
+		got, err := svc.FindBestTimes(ctx, FindBestTimesQuery{UserID: alice, CircleID: &circleID, From: from, To: to})
+		if err != nil {
+			t.Fatalf("FindBestTimes: %v", err)
+		}
+		if !slices.Equal(got.Excluded, []uuid.UUID{dave}) {
+			t.Errorf("Excluded = %v, want only %v", got.Excluded, dave)
+		}
+
+		timezones := make(map[uuid.UUID]*string)
+		for _, p := range got.Participants {
+			timezones[p.UserID] = p.Timezone
+		}
+		if len(timezones) != 3 {
+			t.Fatalf("Participants = %+v, want alice, bob and carol", got.Participants)
+		}
+		if timezones[bob] == nil {
+			t.Errorf("bob's shared timezone is missing")
+		}
+		if timezones[carol] != nil {
+			t.Errorf("carol's timezone = %q, want it hidden", *timezones[carol])
+		}
+		if len(got.Slots) == 0 {
+			t.Errorf("found no slots for participants who are always free")
+		}
+	})
+
+	t.Run("lists each user once", func(t *testing.T) {
+		got, err := svc.FindBestTimes(ctx, FindBestTimesQuery{UserID: alice, UserIDs: []uuid.UUID{bob, bob, alice}, From: from, To: to})
+		if err != nil {
+			t.Fatalf("FindBestTimes: %v", err)
+		}
+		if len(got.Participants) != 2 {
+			t.Errorf("Participants = %+v, want alice and bob once each", got.Participants)
+		}
+	})
+
+	tooMany := make([]uuid.UUID, availability.MaxParticipants)
+	for i := range tooMany {
+		tooMany[i] = uuid.New()
+	}
+
+	errTests := []struct {
+		name    string
+		query   FindBestTimesQuery
+		wantErr error
+	}{
+		{
+			name:    "requires circle membership",
+			query:   FindBestTimesQuery{UserID: uuid.New(), CircleID: &circleID, From: from, To: to},
+			wantErr: circle.ErrNotCircleMember,
+		},
+		{
+			name:    "caps the participants",
+			query:   FindBestTimesQuery{UserID: alice, UserIDs: tooMany, From: from, To: to},
+			wantErr: availability.ErrTooManyParticipants,
+		},
+		{
+			name:    "rejects an empty range",
+			query:   FindBestTimesQuery{UserID: alice, UserIDs: []uuid.UUID{bob}, From: to, To: from},
+			wantErr: availability.ErrInvalidTimeRange,
+		},
+		{
+			name:    "rejects a range that is too long",
+			query:   FindBestTimesQuery{UserID: alice, UserIDs: []uuid.UUID{bob}, From: from, To: from.Add(availability.MaxSearchRange + time.Hour)},
+			wantErr: availability.ErrTimeRangeTooLong,
+		},
+	}
+
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.FindBestTimes(ctx, tt.query); !errors.Is(err, tt.wantErr) {
+				t.Errorf("FindBestTimes error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
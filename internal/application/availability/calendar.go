@@ -0,0 +1,171 @@
+package availability
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/google/uuid"
+)
+
+// calendarSyncInterval is how often calendar feeds are re-fetched.
+const calendarSyncInterval = time.Hour
+
+func (s *Service) ListCalendars(ctx context.Context, query ListCalendarsQuery) ([]*availability.Calendar, error) {
+	return s.repo.ListCalendarsByUser(ctx, query.UserID)
+}
+
+func (s *Service) CreateCalendar(ctx context.Context, cmd CreateCalendarCommand) (*availability.Calendar, error) {
+	if (cmd.URL == nil) == (len(cmd.Data) == 0) {
+		return nil, availability.ErrCalendarSourceRequired
+	}
+
+	var feedURL *string
+	if cmd.URL != nil {
+		normalized, err := availability.NormalizeCalendarURL(*cmd.URL)
+		if err != nil {
+			return nil, err
+		}
+		feedURL = &normalized
+	}
+
+	c := availability.NewCalendar(cmd.UserID, cmd.Name, feedURL)
+	events, err := s.calendarEvents(ctx, c, cmd.Data)
+	if err != nil {
+		return nil, err
+	}
+	c.SetEvents(events)
+
+	_, err = s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		return s.repo.CreateCalendar(ctx, c)
+	})
+	if err != nil {
+		s.logger.Error("failed to create calendar", "error", err, "user_id", cmd.UserID)
+		return nil, err
+	}
+
+	s.logger.Info("calendar created", "calendar_id", c.ID, "user_id", cmd.UserID, "events", len(events))
+	return c, nil
+}
+
+func (s *Service) UpdateCalendar(ctx context.Context, cmd UpdateCalendarCommand) (*availability.Calendar, error) {
+	var c *availability.Calendar
+	_, err := s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		var err error
+		c, err = s.calendarFor(ctx, cmd.CalendarID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Name != nil {
+			c.Update(*cmd.Name)
+		}
+		if cmd.IsActive != nil {
+			c.SetActive(*cmd.IsActive)
+		}
+		return s.repo.UpdateCalendar(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (s *Service) DeleteCalendar(ctx context.Context, cmd DeleteCalendarCommand) error {
+	_, err := s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		c, err := s.calendarFor(ctx, cmd.CalendarID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		return s.repo.DeleteCalendar(ctx, c.ID)
+	})
+	return err
+}
+
+// SyncCalendar re-fetches a feed straight away, or replaces the events of an
+// uploaded calendar with a new file.
+func (s *Service) SyncCalendar(ctx context.Context, cmd SyncCalendarCommand) (*availability.Calendar, error) {
+	c, err := s.calendarFor(ctx, cmd.CalendarID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if (c.URL == nil) == (len(cmd.Data) == 0) {
+		return nil, availability.ErrCalendarSourceRequired
+	}
+
+	if err := s.syncCalendar(ctx, c, cmd.Data); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ListDueCalendars returns feeds that have not been synced recently.
+func (s *Service) ListDueCalendars(ctx context.Context, limit int) ([]*availability.Calendar, error) {
+	return s.repo.ListCalendarsDueSync(ctx, time.Now().Add(-calendarSyncInterval), limit)
+}
+
+// ResyncCalendar re-fetches a feed. Failures are recorded on the calendar
+// rather than returned.
+func (s *Service) ResyncCalendar(ctx context.Context, c *availability.Calendar) {
+	if err := s.syncCalendar(ctx, c, nil); err != nil {
+		s.logger.Warn("failed to sync calendar", "error", err, "calendar_id", c.ID, "user_id", c.UserID)
+	}
+}
+
+// syncCalendar replaces the calendar's events and re-resolves the owner's
+// status. A failed fetch is recorded on the calendar and its previous events
+// are kept.
+func (s *Service) syncCalendar(ctx context.Context, c *availability.Calendar, data []byte) error {
+	events, err := s.calendarEvents(ctx, c, data)
+	if err != nil {
+		c.SetSyncError(err)
+		if updateErr := s.repo.UpdateCalendar(ctx, c); updateErr != nil {
+			s.logger.Error("failed to record calendar sync error", "error", updateErr, "calendar_id", c.ID)
+		}
+		return err
+	}
+
+	c.SetEvents(events)
+	_, err = s.update(ctx, c.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		return s.repo.UpdateCalendar(ctx, c)
+	})
+	return err
+}
+
+// calendarEvents parses data, or the calendar's feed when data is empty.
+func (s *Service) calendarEvents(ctx context.Context, c *availability.Calendar, data []byte) ([]availability.CalendarEvent, error) {
+	if len(data) == 0 && c.URL != nil {
+		var err error
+		data, err = s.fetcher.Fetch(ctx, *c.URL)
+		if err != nil {
+			s.logger.Warn("failed to fetch calendar", "error", err, "calendar_id", c.ID)
+			return nil, availability.ErrCalendarFetchFailed
+		}
+	}
+
+	if len(data) > availability.MaxCalendarSize {
+		return nil, availability.ErrCalendarTooLarge
+	}
+	events, skipped, err := availability.ParseICS(data)
+	if err != nil {
+		return nil, err
+	}
+	if skipped > 0 {
+		s.logger.Warn("skipped invalid calendar events", "count", skipped, "calendar_id", c.ID)
+	}
+	return events, nil
+}
+
+// calendarFor loads a calendar owned by userID. Calendars owned by someone
+// else are reported as missing.
+func (s *Service) calendarFor(ctx context.Context, calendarID, userID uuid.UUID) (*availability.Calendar, error) {
+	c, err := s.repo.GetCalendarByID(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserID != userID {
+		return nil, availability.ErrCalendarNotFound
+	}
+	return c, nil
+}
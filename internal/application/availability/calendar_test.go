@@ -0,0 +1,43 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/danielng/kin-core-svc/internal/infrastructure/calendar"
+	"github.com/google/uuid"
+)
+
+const feedURL = "https://calendar.example.com/feed.ics"
+
+const feed = "BEGIN:VCALENDAR\r\n" +
+	"BEGIN:VEVENT\r\nUID:broken\r\nDTSTART:tomorrow\r\nDURATION:PT1H\r\nEND:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\nUID:weekly\r\nDTSTART:20250106T090000Z\r\nDURATION:PT1H\r\nRRULE:FREQ=WEEKLY;COUNT=4\r\nEND:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestCalendarEvents(t *testing.T) {
+	s := &Service{
+		fetcher: calendar.NewFixtureFetcher(map[string][]byte{feedURL: []byte(feed)}),
+		logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	url := feedURL
+	c := availability.NewCalendar(uuid.New(), "Work", &url)
+	events, err := s.calendarEvents(context.Background(), c, nil)
+	if err != nil {
+		t.Fatalf("calendarEvents: %v", err)
+	}
+	if len(events) != 1 || events[0].UID != "weekly" {
+		t.Fatalf("events = %+v, want only the valid event", events)
+	}
+
+	missing := "https://calendar.example.com/missing.ics"
+	c = availability.NewCalendar(uuid.New(), "Gone", &missing)
+	if _, err := s.calendarEvents(context.Background(), c, nil); !errors.Is(err, availability.ErrCalendarFetchFailed) {
+		t.Errorf("calendarEvents error = %v, want %v", err, availability.ErrCalendarFetchFailed)
+	}
+}
@@ -0,0 +1,95 @@
+package availability
+
+import (
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/google/uuid"
+)
+
+type SetStatusCommand struct {
+	UserID   uuid.UUID
+	Status   availability.Status
+	Message  *string
+	Duration *time.Duration // Nil keeps the status until it is changed again
+}
+
+type RevertToAutoCommand struct {
+	UserID uuid.UUID
+}
+
+type CreateWindowCommand struct {
+	UserID    uuid.UUID
+	Name      string
+	Weekday   availability.Weekday
+	StartTime string
+	EndTime   string
+	Status    availability.Status
+}
+
+type UpdateWindowCommand struct {
+	UserID    uuid.UUID
+	WindowID  uuid.UUID
+	Name      *string
+	Weekday   *availability.Weekday
+	StartTime *string
+	EndTime   *string
+	Status    *availability.Status
+	IsActive  *bool
+}
+
+type DeleteWindowCommand struct {
+	UserID   uuid.UUID
+	WindowID uuid.UUID
+}
+
+type CreateAutoRuleCommand struct {
+	UserID       uuid.UUID
+	Name         string
+	Condition    availability.Condition
+	TargetStatus availability.Status
+	Priority     int
+}
+
+type UpdateAutoRuleCommand struct {
+	UserID       uuid.UUID
+	RuleID       uuid.UUID
+	Name         *string
+	Condition    *availability.Condition
+	TargetStatus *availability.Status
+	Priority     *int
+	IsActive     *bool
+}
+
+type DeleteAutoRuleCommand struct {
+	UserID uuid.UUID
+	RuleID uuid.UUID
+}
+
+// CreateCalendarCommand imports either a feed URL or an uploaded file.
+type CreateCalendarCommand struct {
+	UserID uuid.UUID
+	Name   string
+	URL    *string
+	Data   []byte
+}
+
+type UpdateCalendarCommand struct {
+	UserID     uuid.UUID
+	CalendarID uuid.UUID
+	Name       *string
+	IsActive   *bool
+}
+
+type DeleteCalendarCommand struct {
+	UserID     uuid.UUID
+	CalendarID uuid.UUID
+}
+
+// SyncCalendarCommand re-fetches a feed, or replaces an uploaded calendar
+// with Data.
+type SyncCalendarCommand struct {
+	UserID     uuid.UUID
+	CalendarID uuid.UUID
+	Data       []byte
+}
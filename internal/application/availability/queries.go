@@ -0,0 +1,39 @@
+package availability
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GetAvailabilityQuery struct {
+	UserID uuid.UUID
+}
+
+type ResolveStatusQuery struct {
+	UserID uuid.UUID
+}
+
+type ListWindowsQuery struct {
+	UserID uuid.UUID
+}
+
+type ListAutoRulesQuery struct {
+	UserID uuid.UUID
+}
+
+// FindBestTimesQuery searches either a circle or an explicit list of users.
+// The requesting user is always a participant.
+type FindBestTimesQuery struct {
+	UserID      uuid.UUID
+	CircleID    *uuid.UUID
+	UserIDs     []uuid.UUID
+	From        time.Time
+	To          time.Time
+	MinDuration time.Duration
+	Limit       int
+}
+
+type ListCalendarsQuery struct {
+	UserID uuid.UUID
+}
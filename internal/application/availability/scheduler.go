@@ -0,0 +1,120 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/lock"
+)
+
+const schedulerLockKey = "lock:availability-scheduler"
+
+// calendarsPerTick bounds how many feeds one tick fetches, since each fetch
+// is a network round trip made while holding the lock.
+const calendarsPerTick = 10
+
+// Scheduler applies status transitions as windows open and close and manual
+// statuses expire, and keeps calendar feeds in sync. Every replica runs one, but a shared lock ensures only one
+// of them processes a given tick.
+type Scheduler struct {
+	service   *Service
+	locker    lock.Locker
+	interval  time.Duration
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewScheduler(service *Service, locker lock.Locker, interval time.Duration, batchSize int, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		service:   service,
+		locker:    locker,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run processes due transitions every interval until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Tick(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Error("failed to process availability transitions", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick refreshes every user whose transition is due and syncs stale calendar
+// feeds. It does nothing when another replica holds the lock. The lease lasts
+// one interval and is extended before each batch, so a tick that overruns it
+// stops instead of racing the replica that takes over.
+func (s *Scheduler) Tick(ctx context.Context) error {
+	l, err := s.locker.TryAcquire(ctx, schedulerLockKey, s.interval)
+	if errors.Is(err, lock.ErrNotAcquired) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := l.Release(context.WithoutCancel(ctx)); err != nil {
+			s.logger.Warn("failed to release availability scheduler lock", "error", err)
+		}
+	}()
+
+	refreshErr := s.refreshDue(ctx, l)
+	if errors.Is(refreshErr, lock.ErrLost) {
+		return refreshErr
+	}
+	syncErr := s.syncDue(ctx, l)
+	return errors.Join(refreshErr, syncErr)
+}
+
+func (s *Scheduler) refreshDue(ctx context.Context, l lock.Lock) error {
+	for {
+		userIDs, err := s.service.ListDueTransitions(ctx, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		// A user that keeps failing would be listed again straight away, so
+		// stop at the first failure and leave the rest for the next tick.
+		for _, userID := range userIDs {
+			if err := s.service.Refresh(ctx, userID); err != nil {
+				return err
+			}
+		}
+
+		if len(userIDs) < s.batchSize {
+			return nil
+		}
+		if err := l.Extend(ctx, s.interval); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Scheduler) syncDue(ctx context.Context, l lock.Lock) error {
+	calendars, err := s.service.ListDueCalendars(ctx, calendarsPerTick)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range calendars {
+		if err := l.Extend(ctx, s.interval); err != nil {
+			return err
+		}
+		s.service.ResyncCalendar(ctx, c)
+	}
+	return nil
+}
@@ -0,0 +1,504 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/danielng/kin-core-svc/internal/domain/uow"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo      availability.Repository
+	userRepo  user.Repository
+	placeRepo location.Repository
+	publisher realtime.Publisher
+	fetcher   availability.CalendarFetcher
+	uow       uow.UnitOfWork
+	projector *privacy.Projector
+	logger    *slog.Logger
+}
+
+func NewService(
+	repo availability.Repository,
+	userRepo user.Repository,
+	placeRepo location.Repository,
+	publisher realtime.Publisher,
+	fetcher availability.CalendarFetcher,
+	uow uow.UnitOfWork,
+	projector *privacy.Projector,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		repo:      repo,
+		userRepo:  userRepo,
+		placeRepo: placeRepo,
+		publisher: publisher,
+		fetcher:   fetcher,
+		uow:       uow,
+		projector: projector,
+		logger:    logger,
+	}
+}
+
+// GetAvailability returns the user's stored availability, or the default for
+// users who have never set one.
+func (s *Service) GetAvailability(ctx context.Context, query GetAvailabilityQuery) (*availability.Availability, error) {
+	a, err := s.repo.GetByUserID(ctx, query.UserID)
+	if errors.Is(err, availability.ErrAvailabilityNotFound) {
+		return availability.NewAvailability(query.UserID), nil
+	}
+	return a, err
+}
+
+// ResolveStatus computes the user's effective status right now from their
+// manual status, auto-rules and windows in their own timezone.
+func (s *Service) ResolveStatus(ctx context.Context, query ResolveStatusQuery) (*availability.Resolution, error) {
+	a, err := s.GetAvailability(ctx, GetAvailabilityQuery{UserID: query.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return s.resolve(ctx, a, time.Now())
+}
+
+func (s *Service) SetStatus(ctx context.Context, cmd SetStatusCommand) (*availability.Availability, error) {
+	if !availability.IsValidStatus(cmd.Status) {
+		return nil, availability.ErrInvalidStatus
+	}
+	if cmd.Duration != nil && *cmd.Duration <= 0 {
+		return nil, availability.ErrInvalidDuration
+	}
+
+	a, err := s.update(ctx, cmd.UserID, func(ctx context.Context, a *availability.Availability) error {
+		a.SetStatus(cmd.Status, cmd.Message, cmd.Duration)
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to set availability status", "error", err, "user_id", cmd.UserID)
+		return nil, err
+	}
+
+	s.logger.Info("availability status set", "user_id", cmd.UserID, "status", cmd.Status)
+	return a, nil
+}
+
+func (s *Service) RevertToAuto(ctx context.Context, cmd RevertToAutoCommand) (*availability.Availability, error) {
+	a, err := s.update(ctx, cmd.UserID, func(ctx context.Context, a *availability.Availability) error {
+		a.SetAutoStatus()
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to revert availability to auto", "error", err, "user_id", cmd.UserID)
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// SetCurrentPlace records arrivals at and departures from the user's places so
+// that location rules switch their status. Updates that stay at the same place
+// are ignored.
+func (s *Service) SetCurrentPlace(ctx context.Context, userID uuid.UUID, placeID *uuid.UUID) error {
+	a, err := s.GetAvailability(ctx, GetAvailabilityQuery{UserID: userID})
+	if err != nil {
+		return err
+	}
+	if samePlace(a.CurrentPlaceID, placeID) {
+		return nil
+	}
+
+	_, err = s.update(ctx, userID, func(ctx context.Context, a *availability.Availability) error {
+		a.SetCurrentPlace(placeID)
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to set current place", "error", err, "user_id", userID)
+		return err
+	}
+
+	return nil
+}
+
+// Refresh re-resolves the user's status, for example when a window boundary
+// or a manual expiry has been reached.
+func (s *Service) Refresh(ctx context.Context, userID uuid.UUID) error {
+	_, err := s.update(ctx, userID, nil)
+	return err
+}
+
+// ListDueTransitions returns users whose status is due to be refreshed.
+func (s *Service) ListDueTransitions(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	return s.repo.ListDueTransitions(ctx, time.Now(), limit)
+}
+
+func (s *Service) ListWindows(ctx context.Context, query ListWindowsQuery) ([]*availability.Window, error) {
+	return s.repo.ListWindowsByUser(ctx, query.UserID)
+}
+
+func (s *Service) CreateWindow(ctx context.Context, cmd CreateWindowCommand) (*availability.Window, error) {
+	w := availability.NewWindow(cmd.UserID, cmd.Name, cmd.Weekday, cmd.StartTime, cmd.EndTime, cmd.Status)
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+
+	_, err := s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		if err := s.checkOverlap(ctx, w); err != nil {
+			return err
+		}
+		return s.repo.CreateWindow(ctx, w)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("availability window created", "window_id", w.ID, "user_id", cmd.UserID)
+	return w, nil
+}
+
+func (s *Service) UpdateWindow(ctx context.Context, cmd UpdateWindowCommand) (*availability.Window, error) {
+	var w *availability.Window
+	_, err := s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		var err error
+		w, err = s.windowFor(ctx, cmd.WindowID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		name, weekday, startTime, endTime, status := w.Name, w.Weekday, w.StartTime, w.EndTime, w.Status
+		if cmd.Name != nil {
+			name = *cmd.Name
+		}
+		if cmd.Weekday != nil {
+			weekday = *cmd.Weekday
+		}
+		if cmd.StartTime != nil {
+			startTime = *cmd.StartTime
+		}
+		if cmd.EndTime != nil {
+			endTime = *cmd.EndTime
+		}
+		if cmd.Status != nil {
+			status = *cmd.Status
+		}
+
+		w.Update(name, weekday, startTime, endTime, status)
+		if cmd.IsActive != nil {
+			w.SetActive(*cmd.IsActive)
+		}
+
+		if err := w.Validate(); err != nil {
+			return err
+		}
+		if err := s.checkOverlap(ctx, w); err != nil {
+			return err
+		}
+		return s.repo.UpdateWindow(ctx, w)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (s *Service) DeleteWindow(ctx context.Context, cmd DeleteWindowCommand) error {
+	_, err := s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		w, err := s.windowFor(ctx, cmd.WindowID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		return s.repo.DeleteWindow(ctx, w.ID)
+	})
+	return err
+}
+
+func (s *Service) ListAutoRules(ctx context.Context, query ListAutoRulesQuery) ([]*availability.AutoRule, error) {
+	return s.repo.ListAutoRulesByUser(ctx, query.UserID)
+}
+
+func (s *Service) CreateAutoRule(ctx context.Context, cmd CreateAutoRuleCommand) (*availability.AutoRule, error) {
+	rule := availability.NewAutoRule(cmd.UserID, cmd.Name, cmd.Condition, cmd.TargetStatus, cmd.Priority)
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkRuleReferences(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	_, err := s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		return s.repo.CreateAutoRule(ctx, rule)
+	})
+	if err != nil {
+		s.logger.Error("failed to create auto rule", "error", err, "user_id", cmd.UserID)
+		return nil, err
+	}
+
+	s.logger.Info("auto rule created", "rule_id", rule.ID, "user_id", cmd.UserID)
+	return rule, nil
+}
+
+func (s *Service) UpdateAutoRule(ctx context.Context, cmd UpdateAutoRuleCommand) (*availability.AutoRule, error) {
+	var rule *availability.AutoRule
+	_, err := s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		var err error
+		rule, err = s.autoRuleFor(ctx, cmd.RuleID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		name, condition, targetStatus, priority := rule.Name, rule.Condition, rule.TargetStatus, rule.Priority
+		if cmd.Name != nil {
+			name = *cmd.Name
+		}
+		if cmd.Condition != nil {
+			condition = *cmd.Condition
+		}
+		if cmd.TargetStatus != nil {
+			targetStatus = *cmd.TargetStatus
+		}
+		if cmd.Priority != nil {
+			priority = *cmd.Priority
+		}
+
+		rule.Update(name, condition, targetStatus, priority)
+		if cmd.IsActive != nil {
+			rule.SetActive(*cmd.IsActive)
+		}
+
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if err := s.checkRuleReferences(ctx, rule); err != nil {
+			return err
+		}
+		return s.repo.UpdateAutoRule(ctx, rule)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (s *Service) DeleteAutoRule(ctx context.Context, cmd DeleteAutoRuleCommand) error {
+	_, err := s.update(ctx, cmd.UserID, func(ctx context.Context, _ *availability.Availability) error {
+		rule, err := s.autoRuleFor(ctx, cmd.RuleID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		return s.repo.DeleteAutoRule(ctx, rule.ID)
+	})
+	return err
+}
+
+// update applies mutate and re-resolves the user's status in one transaction,
+// so the stored status and its next transition always reflect the windows and
+// rules that were just written. Circles are notified once it commits.
+func (s *Service) update(ctx context.Context, userID uuid.UUID, mutate func(ctx context.Context, a *availability.Availability) error) (*availability.Availability, error) {
+	var (
+		a       *availability.Availability
+		changed bool
+	)
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		a, err = s.GetAvailability(ctx, GetAvailabilityQuery{UserID: userID})
+		if err != nil {
+			return err
+		}
+		before := *a
+
+		if mutate != nil {
+			if err := mutate(ctx, a); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		r, err := s.resolve(ctx, a, now)
+		if err != nil {
+			return err
+		}
+		a.Apply(*r, now)
+
+		if err := s.repo.CreateOrUpdate(ctx, a); err != nil {
+			return err
+		}
+
+		changed = statusChanged(&before, a)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		s.publishChange(ctx, a)
+	}
+	return a, nil
+}
+
+func (s *Service) resolve(ctx context.Context, a *availability.Availability, now time.Time) (*availability.Resolution, error) {
+	in, err := s.resolveInput(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	r := availability.Resolve(in, now)
+	return &r, nil
+}
+
+func (s *Service) resolveInput(ctx context.Context, a *availability.Availability) (availability.ResolveInput, error) {
+	u, err := s.userRepo.GetByID(ctx, a.UserID)
+	if err != nil {
+		return availability.ResolveInput{}, err
+	}
+
+	windows, err := s.repo.ListActiveWindowsByUser(ctx, a.UserID)
+	if err != nil {
+		return availability.ResolveInput{}, err
+	}
+
+	rules, err := s.repo.ListActiveAutoRulesByUser(ctx, a.UserID)
+	if err != nil {
+		return availability.ResolveInput{}, err
+	}
+
+	calendars, err := s.repo.ListActiveCalendarsByUser(ctx, a.UserID)
+	if err != nil {
+		return availability.ResolveInput{}, err
+	}
+
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		s.logger.Warn("invalid user timezone, falling back to UTC", "error", err, "user_id", a.UserID, "timezone", u.Timezone)
+		loc = time.UTC
+	}
+
+	return availability.ResolveInput{
+		Availability: a,
+		Windows:      windows,
+		Rules:        rules,
+		Calendars:    calendars,
+		Location:     loc,
+	}, nil
+}
+
+// publishChange tells the user's other devices and everyone they share their
+// availability with about the new status.
+func (s *Service) publishChange(ctx context.Context, a *availability.Availability) {
+	grants, err := s.projector.CircleAudience(ctx, a.UserID)
+	if err != nil {
+		s.logger.Error("failed to resolve availability audience", "error", err, "user_id", a.UserID)
+		return
+	}
+
+	var (
+		audience []uuid.UUID
+		view     *availability.Availability
+	)
+	for id, g := range grants {
+		v := g.Apply(privacy.Subject{UserID: a.UserID, Availability: a})
+		if v.Availability == nil {
+			continue
+		}
+		audience = append(audience, id)
+		view = v.Availability
+	}
+	if len(audience) == 0 {
+		return
+	}
+
+	_ = s.publisher.PublishToUsers(ctx, audience, realtime.NewAvailabilityEvent(view))
+}
+
+// statusChanged reports whether the change is visible to others.
+func statusChanged(before, after *availability.Availability) bool {
+	if before.Status != after.Status {
+		return true
+	}
+	if (before.StatusMessage == nil) != (after.StatusMessage == nil) {
+		return true
+	}
+	return before.StatusMessage != nil && *before.StatusMessage != *after.StatusMessage
+}
+
+func samePlace(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// checkOverlap compares an active window against the user's other active
+// windows. Inactive windows never conflict.
+func (s *Service) checkOverlap(ctx context.Context, w *availability.Window) error {
+	if !w.IsActive {
+		return nil
+	}
+
+	existing, err := s.repo.ListActiveWindowsByUser(ctx, w.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range existing {
+		if other.ID != w.ID && w.Overlaps(other) {
+			return availability.ErrWindowOverlap
+		}
+	}
+	return nil
+}
+
+// windowFor loads a window owned by userID. Windows owned by someone else are
+// reported as missing.
+func (s *Service) windowFor(ctx context.Context, windowID, userID uuid.UUID) (*availability.Window, error) {
+	w, err := s.repo.GetWindowByID(ctx, windowID)
+	if err != nil {
+		return nil, err
+	}
+	if w.UserID != userID {
+		return nil, availability.ErrWindowNotFound
+	}
+	return w, nil
+}
+
+// checkRuleReferences ensures the calendar or place a rule's condition refers
+// to belongs to the rule's owner. Those owned by someone else are reported as
+// missing.
+func (s *Service) checkRuleReferences(ctx context.Context, rule *availability.AutoRule) error {
+	c := rule.Condition
+	switch {
+	case c.Type == availability.ConditionTypeCalendar && c.CalendarID != nil:
+		_, err := s.calendarFor(ctx, *c.CalendarID, rule.UserID)
+		return err
+	case c.Type == availability.ConditionTypeLocation && c.PlaceID != nil:
+		p, err := s.placeRepo.GetPlaceByID(ctx, *c.PlaceID)
+		if err != nil {
+			return err
+		}
+		if p.UserID != rule.UserID {
+			return location.ErrPlaceNotFound
+		}
+	}
+	return nil
+}
+
+func (s *Service) autoRuleFor(ctx context.Context, ruleID, userID uuid.UUID) (*availability.AutoRule, error) {
+	rule, err := s.repo.GetAutoRuleByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	if rule.UserID != userID {
+		return nil, availability.ErrAutoRuleNotFound
+	}
+	return rule, nil
+}
+
+var _ location.PlaceObserver = (*Service)(nil)
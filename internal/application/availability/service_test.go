@@ -0,0 +1,341 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type passUoW struct{}
+
+func (passUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeAvailability struct {
+	availability.Repository
+	stored  map[uuid.UUID]*availability.Availability
+	windows map[uuid.UUID]*availability.Window
+	rules   map[uuid.UUID]*availability.AutoRule
+}
+
+func newFakeAvailability() *fakeAvailability {
+	return &fakeAvailability{
+		stored:  make(map[uuid.UUID]*availability.Availability),
+		windows: make(map[uuid.UUID]*availability.Window),
+		rules:   make(map[uuid.UUID]*availability.AutoRule),
+	}
+}
+
+func (r *fakeAvailability) CreateOrUpdate(ctx context.Context, a *availability.Availability) error {
+	r.stored[a.UserID] = a
+	return nil
+}
+
+func (r *fakeAvailability) GetByUserID(ctx context.Context, userID uuid.UUID) (*availability.Availability, error) {
+	a, ok := r.stored[userID]
+	if !ok {
+		return nil, availability.ErrAvailabilityNotFound
+	}
+	c := *a
+	return &c, nil
+}
+
+func (r *fakeAvailability) CreateWindow(ctx context.Context, w *availability.Window) error {
+	r.windows[w.ID] = w
+	return nil
+}
+
+func (r *fakeAvailability) GetWindowByID(ctx context.Context, id uuid.UUID) (*availability.Window, error) {
+	w, ok := r.windows[id]
+	if !ok {
+		return nil, availability.ErrWindowNotFound
+	}
+	c := *w
+	return &c, nil
+}
+
+func (r *fakeAvailability) UpdateWindow(ctx context.Context, w *availability.Window) error {
+	r.windows[w.ID] = w
+	return nil
+}
+
+func (r *fakeAvailability) DeleteWindow(ctx context.Context, id uuid.UUID) error {
+	delete(r.windows, id)
+	return nil
+}
+
+func (r *fakeAvailability) ListActiveWindowsByUser(ctx context.Context, userID uuid.UUID) ([]*availability.Window, error) {
+	var windows []*availability.Window
+	for _, w := range r.windows {
+		if w.UserID == userID && w.IsActive {
+			windows = append(windows, w)
+		}
+	}
+	return windows, nil
+}
+
+func (r *fakeAvailability) CreateAutoRule(ctx context.Context, rule *availability.AutoRule) error {
+	r.rules[rule.ID] = rule
+	return nil
+}
+
+func (r *fakeAvailability) GetAutoRuleByID(ctx context.Context, id uuid.UUID) (*availability.AutoRule, error) {
+	rule, ok := r.rules[id]
+	if !ok {
+		return nil, availability.ErrAutoRuleNotFound
+	}
+	c := *rule
+	return &c, nil
+}
+
+func (r *fakeAvailability) UpdateAutoRule(ctx context.Context, rule *availability.AutoRule) error {
+	r.rules[rule.ID] = rule
+	return nil
+}
+
+func (r *fakeAvailability) DeleteAutoRule(ctx context.Context, id uuid.UUID) error {
+	delete(r.rules, id)
+	return nil
+}
+
+func (r *fakeAvailability) ListActiveAutoRulesByUser(ctx context.Context, userID uuid.UUID) ([]*availability.AutoRule, error) {
+	return nil, nil
+}
+
+func (r *fakeAvailability) ListActiveCalendarsByUser(ctx context.Context, userID uuid.UUID) ([]*availability.Calendar, error) {
+	return nil, nil
+}
+
+type fakeUsers struct {
+	user.Repository
+}
+
+func (fakeUsers) GetByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return &user.User{ID: id, Timezone: "UTC"}, nil
+}
+
+func (fakeUsers) GetPreferences(ctx context.Context, userID uuid.UUID) (*user.Preferences, error) {
+	return nil, user.ErrPreferencesNotFound
+}
+
+// fakeCircles puts every user in one circle, sharing with it as prefs says.
+type fakeCircles struct {
+	circle.Repository
+	circleID uuid.UUID
+	members  []uuid.UUID
+	prefs    map[uuid.UUID]*circle.SharingPreference
+}
+
+func (r *fakeCircles) ListByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*circle.Circle, error) {
+	if offset > 0 || !slices.Contains(r.members, userID) {
+		return nil, nil
+	}
+	return []*circle.Circle{{ID: r.circleID}}, nil
+}
+
+func (r *fakeCircles) ListMembers(ctx context.Context, circleID uuid.UUID) ([]*circle.Member, error) {
+	var members []*circle.Member
+	for _, id := range r.members {
+		members = append(members, &circle.Member{CircleID: circleID, UserID: id})
+	}
+	return members, nil
+}
+
+func (r *fakeCircles) ListSharingPreferences(ctx context.Context, userID uuid.UUID) ([]*circle.SharingPreference, error) {
+	if pref, ok := r.prefs[userID]; ok {
+		return []*circle.SharingPreference{pref}, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeCircles) IsMember(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
+	return circleID == r.circleID && slices.Contains(r.members, userID), nil
+}
+
+func (r *fakeCircles) GetSharingPreference(ctx context.Context, circleID, userID uuid.UUID) (*circle.SharingPreference, error) {
+	pref, ok := r.prefs[userID]
+	if !ok || pref.CircleID != circleID {
+		return nil, circle.ErrSharingPreferenceNotFound
+	}
+	return pref, nil
+}
+
+type fakeBlocks struct {
+	contact.Repository
+}
+
+func (fakeBlocks) ListBlockRelations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+type fakePublisher struct {
+	realtime.Publisher
+	recipients []uuid.UUID
+}
+
+func (p *fakePublisher) PublishToUsers(ctx context.Context, userIDs []uuid.UUID, event *realtime.Event) error {
+	p.recipients = append(p.recipients, userIDs...)
+	return nil
+}
+
+func newTestService(circles *fakeCircles) (*Service, *fakeAvailability, *fakePublisher) {
+	repo := newFakeAvailability()
+	pub := &fakePublisher{}
+	projector := privacy.NewProjector(circles, fakeUsers{}, contact.NewBlockPolicy(fakeBlocks{}))
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewService(repo, fakeUsers{}, nil, pub, nil, passUoW{}, projector, logger), repo, pub
+}
+
+func TestWindowOwnership(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	svc, repo, _ := newTestService(&fakeCircles{})
+
+	w, err := svc.CreateWindow(ctx, CreateWindowCommand{
+		UserID: alice, Name: "Work", Weekday: availability.Monday,
+		StartTime: "09:00", EndTime: "17:00", Status: availability.StatusBusy,
+	})
+	if err != nil {
+		t.Fatalf("CreateWindow: %v", err)
+	}
+
+	name := "Taken"
+	if _, err := svc.UpdateWindow(ctx, UpdateWindowCommand{UserID: bob, WindowID: w.ID, Name: &name}); !errors.Is(err, availability.ErrWindowNotFound) {
+		t.Errorf("UpdateWindow by another user: error = %v, want %v", err, availability.ErrWindowNotFound)
+	}
+	if err := svc.DeleteWindow(ctx, DeleteWindowCommand{UserID: bob, WindowID: w.ID}); !errors.Is(err, availability.ErrWindowNotFound) {
+		t.Errorf("DeleteWindow by another user: error = %v, want %v", err, availability.ErrWindowNotFound)
+	}
+	if got := repo.windows[w.ID]; got == nil || got.Name != "Work" {
+		t.Fatalf("window = %+v, want it untouched", got)
+	}
+
+	if err := svc.DeleteWindow(ctx, DeleteWindowCommand{UserID: alice, WindowID: w.ID}); err != nil {
+		t.Fatalf("DeleteWindow by the owner: %v", err)
+	}
+	if _, ok := repo.windows[w.ID]; ok {
+		t.Errorf("window was not deleted")
+	}
+}
+
+func TestCreateWindowOverlap(t *testing.T) {
+	tests := []struct {
+		name       string
+		weekday    availability.Weekday
+		start, end string
+		wantErr    error
+	}{
+		{name: "overlapping", weekday: availability.Monday, start: "16:00", end: "18:00", wantErr: availability.ErrWindowOverlap},
+		{name: "adjacent", weekday: availability.Monday, start: "17:00", end: "18:00"},
+		{name: "another day", weekday: availability.Tuesday, start: "09:00", end: "17:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			userID := uuid.New()
+			svc, repo, _ := newTestService(&fakeCircles{})
+			existing := availability.NewWindow(userID, "Work", availability.Monday, "09:00", "17:00", availability.StatusBusy)
+			repo.CreateWindow(ctx, existing)
+
+			_, err := svc.CreateWindow(ctx, CreateWindowCommand{
+				UserID: userID, Name: "New", Weekday: tt.weekday,
+				StartTime: tt.start, EndTime: tt.end, Status: availability.StatusBusy,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateWindow error = %v, want %v", err, tt.wantErr)
+			}
+
+			// Another user's windows never conflict.
+			_, err = svc.CreateWindow(ctx, CreateWindowCommand{
+				UserID: uuid.New(), Name: "Other", Weekday: tt.weekday,
+				StartTime: tt.start, EndTime: tt.end, Status: availability.StatusBusy,
+			})
+			if err != nil {
+				t.Errorf("CreateWindow for another user: %v", err)
+			}
+		})
+	}
+}
+
+func TestAutoRuleOwnership(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	svc, repo, _ := newTestService(&fakeCircles{})
+
+	start, end := "22:00", "23:00"
+	rule, err := svc.CreateAutoRule(ctx, CreateAutoRuleCommand{
+		UserID: alice, Name: "Evening",
+		Condition:    availability.Condition{Type: availability.ConditionTypeTimeRange, StartTime: &start, EndTime: &end},
+		TargetStatus: availability.StatusDoNotDisturb, Priority: 1,
+	})
+	if err != nil {
+		t.Fatalf("CreateAutoRule: %v", err)
+	}
+
+	priority := 99
+	if _, err := svc.UpdateAutoRule(ctx, UpdateAutoRuleCommand{UserID: bob, RuleID: rule.ID, Priority: &priority}); !errors.Is(err, availability.ErrAutoRuleNotFound) {
+		t.Errorf("UpdateAutoRule by another user: error = %v, want %v", err, availability.ErrAutoRuleNotFound)
+	}
+	if err := svc.DeleteAutoRule(ctx, DeleteAutoRuleCommand{UserID: bob, RuleID: rule.ID}); !errors.Is(err, availability.ErrAutoRuleNotFound) {
+		t.Errorf("DeleteAutoRule by another user: error = %v, want %v", err, availability.ErrAutoRuleNotFound)
+	}
+	if got := repo.rules[rule.ID]; got == nil || got.Priority != 1 {
+		t.Fatalf("rule = %+v, want it untouched", got)
+	}
+
+	if _, err := svc.UpdateAutoRule(ctx, UpdateAutoRuleCommand{UserID: alice, RuleID: rule.ID, Priority: &priority}); err != nil {
+		t.Fatalf("UpdateAutoRule by the owner: %v", err)
+	}
+	if got := repo.rules[rule.ID].Priority; got != priority {
+		t.Errorf("priority = %d, want %d", got, priority)
+	}
+}
+
+func TestSetStatusPublishesToSharingCircle(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+
+	circleID := uuid.New()
+	pref := circle.NewSharingPreference(circleID, alice)
+	pref.PrivacyLevel = user.PrivacyLevelStatus
+	pref.ShareAvailability = true
+	circles := &fakeCircles{
+		circleID: circleID,
+		members:  []uuid.UUID{alice, bob},
+		prefs:    map[uuid.UUID]*circle.SharingPreference{alice: pref},
+	}
+	svc, _, pub := newTestService(circles)
+
+	if _, err := svc.SetStatus(ctx, SetStatusCommand{UserID: alice, Status: availability.StatusBusy}); err != nil {
+		t.Fatalf("SetStatus: %v", err)
+	}
+	slices.SortFunc(pub.recipients, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	want := []uuid.UUID{alice, bob}
+	slices.SortFunc(want, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	if !slices.Equal(pub.recipients, want) {
+		t.Errorf("published to %v, want %v", pub.recipients, want)
+	}
+
+	// Without the preference the circle no longer hears about changes.
+	pref.ShareAvailability = false
+	pub.recipients = nil
+	if _, err := svc.SetStatus(ctx, SetStatusCommand{UserID: alice, Status: availability.StatusAway}); err != nil {
+		t.Fatalf("SetStatus: %v", err)
+	}
+	if !slices.Equal(pub.recipients, []uuid.UUID{alice}) {
+		t.Errorf("published to %v, want only %v", pub.recipients, alice)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
 	"github.com/danielng/kin-core-svc/internal/domain/user"
 	"github.com/google/uuid"
 )
@@ -58,7 +59,7 @@ type UpdateSharingPreferenceCommand struct {
 	ShareTimezone     *bool
 	ShareAvailability *bool
 	ShareLocation     *bool
-	LocationPrecision *circle.LocationPrecision
+	LocationPrecision *location.Precision
 	ShareActivity     *bool
 }
 
@@ -2,44 +2,63 @@ package circle
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"time"
 
 	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/danielng/kin-core-svc/internal/domain/uow"
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	repo   circle.Repository
-	logger *slog.Logger
+	repo             circle.Repository
+	conversationRepo conversation.Repository
+	publisher        realtime.Publisher
+	uow              uow.UnitOfWork
+	blockPolicy      *contact.BlockPolicy
+	logger           *slog.Logger
 }
 
-func NewService(repo circle.Repository, logger *slog.Logger) *Service {
+func NewService(
+	repo circle.Repository,
+	conversationRepo conversation.Repository,
+	publisher realtime.Publisher,
+	uow uow.UnitOfWork,
+	blockPolicy *contact.BlockPolicy,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
-		repo:   repo,
-		logger: logger,
+		repo:             repo,
+		conversationRepo: conversationRepo,
+		publisher:        publisher,
+		uow:              uow,
+		blockPolicy:      blockPolicy,
+		logger:           logger,
 	}
 }
 
 func (s *Service) CreateCircle(ctx context.Context, cmd CreateCircleCommand) (*circle.Circle, error) {
-	c := circle.NewCircle(cmd.Name, cmd.Description, cmd.CreatedBy)
-
-	if err := s.repo.Create(ctx, c); err != nil {
+	var c *circle.Circle
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		c = circle.NewCircle(cmd.Name, cmd.Description, cmd.CreatedBy)
+		if err := s.repo.Create(ctx, c); err != nil {
+			return err
+		}
+		if err := s.addMember(ctx, circle.NewMember(c.ID, cmd.CreatedBy, circle.MemberRoleAdmin)); err != nil {
+			return err
+		}
+		_, err := s.ensureConversation(ctx, c)
+		return err
+	})
+	if err != nil {
 		s.logger.Error("failed to create circle", "error", err)
 		return nil, err
 	}
 
-	member := circle.NewMember(c.ID, cmd.CreatedBy, circle.MemberRoleAdmin)
-	if err := s.repo.AddMember(ctx, member); err != nil {
-		s.logger.Error("failed to add creator as member", "error", err)
-		return nil, err
-	}
-
-	pref := circle.NewSharingPreference(c.ID, cmd.CreatedBy)
-	if err := s.repo.CreateSharingPreference(ctx, pref); err != nil {
-		s.logger.Error("failed to create sharing preferences", "error", err)
-	}
-
 	s.logger.Info("circle created", "circle_id", c.ID, "created_by", cmd.CreatedBy)
 	return c, nil
 }
@@ -91,7 +110,16 @@ func (s *Service) UpdateCircle(ctx context.Context, cmd UpdateCircleCommand) (*c
 
 	c.Update(cmd.Name, cmd.Description)
 
-	if err := s.repo.Update(ctx, c); err != nil {
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, c); err != nil {
+			return err
+		}
+		if cmd.Name != nil {
+			return s.renameConversation(ctx, c)
+		}
+		return nil
+	})
+	if err != nil {
 		s.logger.Error("failed to update circle", "error", err, "circle_id", cmd.CircleID)
 		return nil, err
 	}
@@ -134,17 +162,25 @@ func (s *Service) AddMember(ctx context.Context, cmd AddMemberCommand) (*circle.
 		return nil, circle.ErrAlreadyMember
 	}
 
-	member := circle.NewMember(cmd.CircleID, cmd.MemberID, cmd.Role)
-	if err := s.repo.AddMember(ctx, member); err != nil {
-		s.logger.Error("failed to add member", "error", err)
+	if err := s.blockPolicy.Check(ctx, cmd.UserID, cmd.MemberID); err != nil {
 		return nil, err
 	}
 
-	pref := circle.NewSharingPreference(cmd.CircleID, cmd.MemberID)
-	if err := s.repo.CreateSharingPreference(ctx, pref); err != nil {
-		s.logger.Error("failed to create sharing preferences", "error", err)
+	var member *circle.Member
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		member = circle.NewMember(cmd.CircleID, cmd.MemberID, cmd.Role)
+		if err := s.addMember(ctx, member); err != nil {
+			return err
+		}
+		return s.joinConversation(ctx, cmd.CircleID, cmd.MemberID)
+	})
+	if err != nil {
+		s.logger.Error("failed to add member", "error", err)
+		return nil, err
 	}
 
+	_ = s.publisher.PublishToCircle(ctx, cmd.CircleID, realtime.NewMemberEvent(realtime.EventTypeMemberJoined, member, cmd.UserID))
+
 	s.logger.Info("member added to circle", "circle_id", cmd.CircleID, "member_id", cmd.MemberID)
 	return member, nil
 }
@@ -158,11 +194,12 @@ func (s *Service) RemoveMember(ctx context.Context, cmd RemoveMemberCommand) err
 		return circle.ErrNotCircleAdmin
 	}
 
+	member, err := s.repo.GetMember(ctx, cmd.CircleID, cmd.MemberID)
+	if err != nil {
+		return err
+	}
+
 	if cmd.MemberID != cmd.UserID {
-		member, err := s.repo.GetMember(ctx, cmd.CircleID, cmd.MemberID)
-		if err != nil {
-			return err
-		}
 		if member.IsAdmin() {
 			members, err := s.repo.ListMembers(ctx, cmd.CircleID)
 			if err != nil {
@@ -180,11 +217,13 @@ func (s *Service) RemoveMember(ctx context.Context, cmd RemoveMemberCommand) err
 		}
 	}
 
-	if err := s.repo.RemoveMember(ctx, cmd.CircleID, cmd.MemberID); err != nil {
+	if err := s.removeMember(ctx, cmd.CircleID, cmd.MemberID); err != nil {
 		s.logger.Error("failed to remove member", "error", err)
 		return err
 	}
 
+	s.publishMemberLeft(ctx, member, cmd.UserID)
+
 	s.logger.Info("member removed from circle", "circle_id", cmd.CircleID, "member_id", cmd.MemberID)
 	return nil
 }
@@ -263,11 +302,13 @@ func (s *Service) LeaveCircle(ctx context.Context, cmd LeaveCircleCommand) error
 		}
 	}
 
-	if err := s.repo.RemoveMember(ctx, cmd.CircleID, cmd.UserID); err != nil {
+	if err := s.removeMember(ctx, cmd.CircleID, cmd.UserID); err != nil {
 		s.logger.Error("failed to leave circle", "error", err)
 		return err
 	}
 
+	s.publishMemberLeft(ctx, member, cmd.UserID)
+
 	s.logger.Info("user left circle", "circle_id", cmd.CircleID, "user_id", cmd.UserID)
 	return nil
 }
@@ -330,6 +371,9 @@ func (s *Service) CreateInvitation(ctx context.Context, cmd CreateInvitationComm
 
 	var inv *circle.Invitation
 	if cmd.Type == circle.InvitationTypeDirect && cmd.InviteeID != nil {
+		if err := s.blockPolicy.Check(ctx, cmd.InviterID, *cmd.InviteeID); err != nil {
+			return nil, err
+		}
 		inv = circle.NewDirectInvitation(cmd.CircleID, cmd.InviterID, *cmd.InviteeID, expiresAt)
 	} else {
 		inv = circle.NewLinkInvitation(cmd.CircleID, cmd.InviterID, cmd.MaxUses, expiresAt)
@@ -345,46 +389,63 @@ func (s *Service) CreateInvitation(ctx context.Context, cmd CreateInvitationComm
 }
 
 func (s *Service) AcceptInvitation(ctx context.Context, cmd AcceptInvitationCommand) (*circle.Circle, error) {
-	inv, err := s.repo.GetInvitationByCode(ctx, cmd.Code)
-	if err != nil {
-		return nil, err
-	}
+	var (
+		inv    *circle.Invitation
+		member *circle.Member
+	)
+	// Reading and consuming the invitation in one serializable transaction
+	// keeps concurrent accepts from overshooting MaxUses.
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		inv, err = s.repo.GetInvitationByCode(ctx, cmd.Code)
+		if err != nil {
+			return err
+		}
 
-	if !inv.IsValid() {
-		if inv.IsExpired() {
-			return nil, circle.ErrInvitationExpired
+		if !inv.IsValid() {
+			if inv.IsExpired() {
+				return circle.ErrInvitationExpired
+			}
+			return circle.ErrInvitationInvalid
 		}
-		return nil, circle.ErrInvitationInvalid
-	}
 
-	if inv.Type == circle.InvitationTypeDirect && inv.InviteeID != nil && *inv.InviteeID != cmd.UserID {
-		return nil, circle.ErrInvitationInvalid
-	}
+		if inv.Type == circle.InvitationTypeDirect && inv.InviteeID != nil && *inv.InviteeID != cmd.UserID {
+			return circle.ErrInvitationInvalid
+		}
 
-	isMember, err := s.repo.IsMember(ctx, inv.CircleID, cmd.UserID)
-	if err != nil {
-		return nil, err
-	}
-	if isMember {
-		return nil, circle.ErrAlreadyMember
-	}
+		// A shared link must not let anyone in who is blocked with the
+		// inviter, just as a direct invitation would not.
+		if err := s.blockPolicy.Check(ctx, inv.InviterID, cmd.UserID); err != nil {
+			return err
+		}
 
-	member := circle.NewMember(inv.CircleID, cmd.UserID, circle.MemberRoleMember)
-	if err := s.repo.AddMember(ctx, member); err != nil {
-		s.logger.Error("failed to add member via invitation", "error", err)
-		return nil, err
-	}
+		isMember, err := s.repo.IsMember(ctx, inv.CircleID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		if isMember {
+			return circle.ErrAlreadyMember
+		}
 
-	pref := circle.NewSharingPreference(inv.CircleID, cmd.UserID)
-	if err := s.repo.CreateSharingPreference(ctx, pref); err != nil {
-		s.logger.Error("failed to create sharing preferences", "error", err)
-	}
+		member = circle.NewMember(inv.CircleID, cmd.UserID, circle.MemberRoleMember)
+		if err := s.addMember(ctx, member); err != nil {
+			s.logger.Error("failed to add member via invitation", "error", err)
+			return err
+		}
 
-	inv.Accept()
-	if err := s.repo.UpdateInvitation(ctx, inv); err != nil {
-		s.logger.Error("failed to update invitation", "error", err)
+		inv.Accept(cmd.UserID)
+		if err := s.repo.UpdateInvitation(ctx, inv); err != nil {
+			s.logger.Error("failed to update invitation", "error", err)
+			return err
+		}
+		return s.joinConversation(ctx, inv.CircleID, cmd.UserID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	_ = s.publisher.PublishToCircle(ctx, inv.CircleID, realtime.NewMemberEvent(realtime.EventTypeMemberJoined, member, cmd.UserID))
+
 	s.logger.Info("invitation accepted", "invitation_id", inv.ID, "user_id", cmd.UserID)
 
 	return s.repo.GetByID(ctx, inv.CircleID)
@@ -425,3 +486,119 @@ func (s *Service) GetInvitationByCode(ctx context.Context, query GetInvitationBy
 func (s *Service) IsMember(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
 	return s.repo.IsMember(ctx, circleID, userID)
 }
+
+// ensureConversation returns the circle's group conversation, creating it and
+// enrolling the current members if it does not exist yet. This also repairs
+// circles created before conversations were provisioned automatically.
+func (s *Service) ensureConversation(ctx context.Context, c *circle.Circle) (*conversation.Conversation, error) {
+	conv, err := s.conversationRepo.GetByCircleID(ctx, c.ID)
+	if err == nil {
+		return conv, nil
+	}
+	if !errors.Is(err, conversation.ErrConversationNotFound) {
+		return nil, err
+	}
+
+	name := c.Name
+	conv, err = s.conversationRepo.GetOrCreateCircleConversation(ctx, conversation.NewCircleConversation(c.ID, &name))
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.ListMembers(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		if err := s.addParticipant(ctx, conv.ID, m.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	return conv, nil
+}
+
+// addMember persists a new member together with their default sharing
+// preferences.
+func (s *Service) addMember(ctx context.Context, member *circle.Member) error {
+	if err := s.repo.AddMember(ctx, member); err != nil {
+		return err
+	}
+	return s.repo.CreateSharingPreference(ctx, circle.NewSharingPreference(member.CircleID, member.UserID))
+}
+
+// removeMember takes the user out of the circle and its conversation
+// together, so that nobody keeps reading a circle's chat after leaving it.
+func (s *Service) removeMember(ctx context.Context, circleID, userID uuid.UUID) error {
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.RemoveMember(ctx, circleID, userID); err != nil {
+			return err
+		}
+		return s.leaveConversation(ctx, circleID, userID)
+	})
+}
+
+func (s *Service) joinConversation(ctx context.Context, circleID, userID uuid.UUID) error {
+	c, err := s.repo.GetByID(ctx, circleID)
+	if err != nil {
+		return err
+	}
+
+	conv, err := s.ensureConversation(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	return s.addParticipant(ctx, conv.ID, userID)
+}
+
+func (s *Service) leaveConversation(ctx context.Context, circleID, userID uuid.UUID) error {
+	conv, err := s.conversationRepo.GetByCircleID(ctx, circleID)
+	if errors.Is(err, conversation.ErrConversationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	participant, err := s.conversationRepo.GetParticipant(ctx, conv.ID, userID)
+	if errors.Is(err, conversation.ErrParticipantNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !participant.IsActive() {
+		return nil
+	}
+
+	participant.Leave()
+	return s.conversationRepo.UpdateParticipant(ctx, participant)
+}
+
+func (s *Service) renameConversation(ctx context.Context, c *circle.Circle) error {
+	conv, err := s.ensureConversation(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	name := c.Name
+	conv.SetName(&name)
+	return s.conversationRepo.Update(ctx, conv)
+}
+
+func (s *Service) addParticipant(ctx context.Context, conversationID, userID uuid.UUID) error {
+	err := s.conversationRepo.AddParticipant(ctx, conversation.NewParticipant(conversationID, userID))
+	if errors.Is(err, conversation.ErrAlreadyParticipant) {
+		return nil
+	}
+	return err
+}
+
+// publishMemberLeft notifies the remaining members as well as the member who
+// is no longer part of the circle.
+func (s *Service) publishMemberLeft(ctx context.Context, member *circle.Member, actorID uuid.UUID) {
+	event := realtime.NewMemberEvent(realtime.EventTypeMemberLeft, member, actorID)
+	_ = s.publisher.PublishToCircle(ctx, member.CircleID, event)
+	_ = s.publisher.PublishToUsers(ctx, []uuid.UUID{member.UserID}, event)
+}
@@ -0,0 +1,466 @@
+package circle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"testing"
+
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/google/uuid"
+)
+
+var errInjected = errors.New("injected failure")
+
+// store holds the state behind the fake repositories. fakeUoW snapshots it
+// before each unit of work and restores it when the work fails, as a rolled
+// back transaction would.
+type store struct {
+	circles       map[uuid.UUID]circle.Circle
+	members       map[[2]uuid.UUID]circle.Member // By circle and user
+	conversations map[uuid.UUID]conversation.Conversation
+	participants  map[[2]uuid.UUID]conversation.Participant // By conversation and user
+	invitations   map[string]circle.Invitation              // By code
+
+	// failConversations makes every conversation write fail.
+	failConversations bool
+}
+
+func newStore() *store {
+	return &store{
+		circles:       make(map[uuid.UUID]circle.Circle),
+		members:       make(map[[2]uuid.UUID]circle.Member),
+		conversations: make(map[uuid.UUID]conversation.Conversation),
+		participants:  make(map[[2]uuid.UUID]conversation.Participant),
+		invitations:   make(map[string]circle.Invitation),
+	}
+}
+
+func (s *store) clone() *store {
+	return &store{
+		circles:           maps.Clone(s.circles),
+		members:           maps.Clone(s.members),
+		conversations:     maps.Clone(s.conversations),
+		participants:      maps.Clone(s.participants),
+		invitations:       maps.Clone(s.invitations),
+		failConversations: s.failConversations,
+	}
+}
+
+func (s *store) circleConversation(circleID uuid.UUID) (conversation.Conversation, bool) {
+	for _, c := range s.conversations {
+		if c.CircleID != nil && *c.CircleID == circleID {
+			return c, true
+		}
+	}
+	return conversation.Conversation{}, false
+}
+
+// activeParticipant reports whether the user takes part in the circle's
+// conversation.
+func (s *store) activeParticipant(circleID, userID uuid.UUID) bool {
+	conv, ok := s.circleConversation(circleID)
+	if !ok {
+		return false
+	}
+	p, ok := s.participants[[2]uuid.UUID{conv.ID, userID}]
+	return ok && p.IsActive()
+}
+
+type fakeUoW struct {
+	st *store
+}
+
+func (u *fakeUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	snapshot := u.st.clone()
+	if err := fn(ctx); err != nil {
+		*u.st = *snapshot
+		return err
+	}
+	return nil
+}
+
+type fakeCircles struct {
+	circle.Repository
+	st *store
+}
+
+func (r *fakeCircles) Create(ctx context.Context, c *circle.Circle) error {
+	r.st.circles[c.ID] = *c
+	return nil
+}
+
+func (r *fakeCircles) GetByID(ctx context.Context, id uuid.UUID) (*circle.Circle, error) {
+	c, ok := r.st.circles[id]
+	if !ok {
+		return nil, circle.ErrCircleNotFound
+	}
+	return &c, nil
+}
+
+func (r *fakeCircles) Update(ctx context.Context, c *circle.Circle) error {
+	r.st.circles[c.ID] = *c
+	return nil
+}
+
+func (r *fakeCircles) AddMember(ctx context.Context, m *circle.Member) error {
+	r.st.members[[2]uuid.UUID{m.CircleID, m.UserID}] = *m
+	return nil
+}
+
+func (r *fakeCircles) GetMember(ctx context.Context, circleID, userID uuid.UUID) (*circle.Member, error) {
+	m, ok := r.st.members[[2]uuid.UUID{circleID, userID}]
+	if !ok {
+		return nil, circle.ErrMemberNotFound
+	}
+	return &m, nil
+}
+
+func (r *fakeCircles) RemoveMember(ctx context.Context, circleID, userID uuid.UUID) error {
+	delete(r.st.members, [2]uuid.UUID{circleID, userID})
+	return nil
+}
+
+func (r *fakeCircles) ListMembers(ctx context.Context, circleID uuid.UUID) ([]*circle.Member, error) {
+	var members []*circle.Member
+	for k, m := range r.st.members {
+		if k[0] == circleID {
+			members = append(members, &m)
+		}
+	}
+	return members, nil
+}
+
+func (r *fakeCircles) IsMember(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
+	_, ok := r.st.members[[2]uuid.UUID{circleID, userID}]
+	return ok, nil
+}
+
+func (r *fakeCircles) IsAdmin(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
+	m, ok := r.st.members[[2]uuid.UUID{circleID, userID}]
+	return ok && m.IsAdmin(), nil
+}
+
+func (r *fakeCircles) CreateSharingPreference(ctx context.Context, pref *circle.SharingPreference) error {
+	return nil
+}
+
+func (r *fakeCircles) CreateInvitation(ctx context.Context, inv *circle.Invitation) error {
+	r.st.invitations[inv.Code] = *inv
+	return nil
+}
+
+func (r *fakeCircles) GetInvitationByCode(ctx context.Context, code string) (*circle.Invitation, error) {
+	inv, ok := r.st.invitations[code]
+	if !ok {
+		return nil, circle.ErrInvitationNotFound
+	}
+	return &inv, nil
+}
+
+func (r *fakeCircles) UpdateInvitation(ctx context.Context, inv *circle.Invitation) error {
+	r.st.invitations[inv.Code] = *inv
+	return nil
+}
+
+type fakeConversations struct {
+	conversation.Repository
+	st *store
+}
+
+func (r *fakeConversations) GetByCircleID(ctx context.Context, circleID uuid.UUID) (*conversation.Conversation, error) {
+	c, ok := r.st.circleConversation(circleID)
+	if !ok {
+		return nil, conversation.ErrConversationNotFound
+	}
+	return &c, nil
+}
+
+func (r *fakeConversations) GetOrCreateCircleConversation(ctx context.Context, c *conversation.Conversation) (*conversation.Conversation, error) {
+	if r.st.failConversations {
+		return nil, errInjected
+	}
+	if existing, ok := r.st.circleConversation(*c.CircleID); ok {
+		return &existing, nil
+	}
+	r.st.conversations[c.ID] = *c
+	return c, nil
+}
+
+func (r *fakeConversations) Update(ctx context.Context, c *conversation.Conversation) error {
+	if r.st.failConversations {
+		return errInjected
+	}
+	r.st.conversations[c.ID] = *c
+	return nil
+}
+
+func (r *fakeConversations) AddParticipant(ctx context.Context, p *conversation.Participant) error {
+	if r.st.failConversations {
+		return errInjected
+	}
+	key := [2]uuid.UUID{p.ConversationID, p.UserID}
+	if existing, ok := r.st.participants[key]; ok && existing.IsActive() {
+		return conversation.ErrAlreadyParticipant
+	}
+	r.st.participants[key] = *p
+	return nil
+}
+
+func (r *fakeConversations) GetParticipant(ctx context.Context, conversationID, userID uuid.UUID) (*conversation.Participant, error) {
+	p, ok := r.st.participants[[2]uuid.UUID{conversationID, userID}]
+	if !ok {
+		return nil, conversation.ErrParticipantNotFound
+	}
+	return &p, nil
+}
+
+func (r *fakeConversations) UpdateParticipant(ctx context.Context, p *conversation.Participant) error {
+	if r.st.failConversations {
+		return errInjected
+	}
+	r.st.participants[[2]uuid.UUID{p.ConversationID, p.UserID}] = *p
+	return nil
+}
+
+type fakeContacts struct {
+	contact.Repository
+}
+
+func (fakeContacts) IsBlocked(ctx context.Context, userID, targetID uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+type fakePublisher struct {
+	realtime.Publisher
+}
+
+func (fakePublisher) PublishToUsers(ctx context.Context, userIDs []uuid.UUID, event *realtime.Event) error {
+	return nil
+}
+
+func (fakePublisher) PublishToCircle(ctx context.Context, circleID uuid.UUID, event *realtime.Event) error {
+	return nil
+}
+
+func newTestService(st *store) *Service {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewService(
+		&fakeCircles{st: st},
+		&fakeConversations{st: st},
+		fakePublisher{},
+		&fakeUoW{st: st},
+		contact.NewBlockPolicy(fakeContacts{}),
+		logger,
+	)
+}
+
+// circleWith creates a circle owned by admin with the given members, all in
+// the circle's conversation.
+func circleWith(t *testing.T, svc *Service, admin uuid.UUID, members ...uuid.UUID) *circle.Circle {
+	t.Helper()
+
+	ctx := context.Background()
+	c, err := svc.CreateCircle(ctx, CreateCircleCommand{Name: "Family", CreatedBy: admin})
+	if err != nil {
+		t.Fatalf("CreateCircle: %v", err)
+	}
+	for _, id := range members {
+		if _, err := svc.AddMember(ctx, AddMemberCommand{CircleID: c.ID, UserID: admin, MemberID: id, Role: circle.MemberRoleMember}); err != nil {
+			t.Fatalf("AddMember: %v", err)
+		}
+	}
+	return c
+}
+
+func TestRemoveMember(t *testing.T) {
+	admin, member, other := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name              string
+		actor             uuid.UUID
+		failConversations bool
+		wantErr           error
+		wantRemoved       bool
+	}{
+		{name: "admin removes a member", actor: admin, wantRemoved: true},
+		{name: "members cannot remove others", actor: other, wantErr: circle.ErrNotCircleAdmin},
+		{name: "failing to leave the conversation keeps the member", actor: admin, failConversations: true, wantErr: errInjected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newStore()
+			svc := newTestService(st)
+			c := circleWith(t, svc, admin, member, other)
+
+			st.failConversations = tt.failConversations
+			err := svc.RemoveMember(context.Background(), RemoveMemberCommand{CircleID: c.ID, UserID: tt.actor, MemberID: member})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RemoveMember error = %v, want %v", err, tt.wantErr)
+			}
+
+			_, isMember := st.members[[2]uuid.UUID{c.ID, member}]
+			inConversation := st.activeParticipant(c.ID, member)
+			if isMember == tt.wantRemoved || inConversation == tt.wantRemoved {
+				t.Errorf("member = %v, in conversation = %v, want both %v", isMember, inConversation, !tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestLeaveCircle(t *testing.T) {
+	admin, member := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name              string
+		user              uuid.UUID
+		failConversations bool
+		wantErr           error
+		wantLeft          bool
+	}{
+		{name: "member leaves", user: member, wantLeft: true},
+		{name: "last admin cannot leave", user: admin, wantErr: circle.ErrCannotLeaveAsLastAdmin},
+		{name: "failing to leave the conversation keeps the member", user: member, failConversations: true, wantErr: errInjected},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newStore()
+			svc := newTestService(st)
+			c := circleWith(t, svc, admin, member)
+
+			st.failConversations = tt.failConversations
+			err := svc.LeaveCircle(context.Background(), LeaveCircleCommand{CircleID: c.ID, UserID: tt.user})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LeaveCircle error = %v, want %v", err, tt.wantErr)
+			}
+
+			_, isMember := st.members[[2]uuid.UUID{c.ID, tt.user}]
+			inConversation := st.activeParticipant(c.ID, tt.user)
+			if isMember == tt.wantLeft || inConversation == tt.wantLeft {
+				t.Errorf("member = %v, in conversation = %v, want both %v", isMember, inConversation, !tt.wantLeft)
+			}
+		})
+	}
+}
+
+func TestCreateCircle(t *testing.T) {
+	admin := uuid.New()
+
+	t.Run("creates the conversation with the admin in it", func(t *testing.T) {
+		st := newStore()
+		c := circleWith(t, newTestService(st), admin)
+		if !st.activeParticipant(c.ID, admin) {
+			t.Errorf("admin is not in the circle conversation")
+		}
+	})
+
+	t.Run("failing to create the conversation creates no circle", func(t *testing.T) {
+		st := newStore()
+		st.failConversations = true
+		_, err := newTestService(st).CreateCircle(context.Background(), CreateCircleCommand{Name: "Family", CreatedBy: admin})
+		if !errors.Is(err, errInjected) {
+			t.Fatalf("CreateCircle error = %v, want %v", err, errInjected)
+		}
+		if len(st.circles) != 0 || len(st.members) != 0 {
+			t.Errorf("left %d circles and %d members behind", len(st.circles), len(st.members))
+		}
+	})
+}
+
+func TestUpdateCircleRenamesConversation(t *testing.T) {
+	admin := uuid.New()
+
+	tests := []struct {
+		name              string
+		failConversations bool
+		wantErr           error
+		wantName          string
+	}{
+		{name: "renames both", wantName: "Friends"},
+		{name: "failing to rename the conversation keeps the old name", failConversations: true, wantErr: errInjected, wantName: "Family"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newStore()
+			svc := newTestService(st)
+			c := circleWith(t, svc, admin)
+
+			st.failConversations = tt.failConversations
+			name := "Friends"
+			_, err := svc.UpdateCircle(context.Background(), UpdateCircleCommand{CircleID: c.ID, UserID: admin, Name: &name})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateCircle error = %v, want %v", err, tt.wantErr)
+			}
+
+			conv, _ := st.circleConversation(c.ID)
+			if got := st.circles[c.ID].Name; got != tt.wantName {
+				t.Errorf("circle name = %q, want %q", got, tt.wantName)
+			}
+			if conv.Name == nil || *conv.Name != tt.wantName {
+				t.Errorf("conversation name = %v, want %q", conv.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestJoinAddsConversationParticipant(t *testing.T) {
+	admin, joiner := uuid.New(), uuid.New()
+
+	join := map[string]func(ctx context.Context, svc *Service, circleID uuid.UUID) error{
+		"add member": func(ctx context.Context, svc *Service, circleID uuid.UUID) error {
+			_, err := svc.AddMember(ctx, AddMemberCommand{CircleID: circleID, UserID: admin, MemberID: joiner, Role: circle.MemberRoleMember})
+			return err
+		},
+		"accept invitation": func(ctx context.Context, svc *Service, circleID uuid.UUID) error {
+			inv, err := svc.CreateInvitation(ctx, CreateInvitationCommand{CircleID: circleID, InviterID: admin, Type: circle.InvitationTypeLink})
+			if err != nil {
+				return err
+			}
+			_, err = svc.AcceptInvitation(ctx, AcceptInvitationCommand{Code: inv.Code, UserID: joiner})
+			return err
+		},
+	}
+
+	for name, do := range join {
+		for _, fail := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s, conversation fails: %v", name, fail), func(t *testing.T) {
+				st := newStore()
+				svc := newTestService(st)
+				c := circleWith(t, svc, admin)
+
+				st.failConversations = fail
+				err := do(context.Background(), svc, c.ID)
+				if fail != errors.Is(err, errInjected) || (!fail && err != nil) {
+					t.Fatalf("join error = %v", err)
+				}
+
+				_, isMember := st.members[[2]uuid.UUID{c.ID, joiner}]
+				inConversation := st.activeParticipant(c.ID, joiner)
+				if isMember == fail || inConversation == fail {
+					t.Errorf("member = %v, in conversation = %v, want both %v", isMember, inConversation, !fail)
+				}
+				for _, inv := range st.invitations {
+					if wantUses := btoi(!fail); inv.UseCount != wantUses {
+						t.Errorf("invitation used %d times, want %d", inv.UseCount, wantUses)
+					}
+				}
+			})
+		}
+	}
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
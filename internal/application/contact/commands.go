@@ -0,0 +1,46 @@
+package contact
+
+import "github.com/google/uuid"
+
+type SendContactRequestCommand struct {
+	UserID   uuid.UUID
+	ToUserID uuid.UUID
+	Message  *string
+}
+
+type AcceptContactRequestCommand struct {
+	UserID    uuid.UUID
+	RequestID uuid.UUID
+}
+
+type RejectContactRequestCommand struct {
+	UserID    uuid.UUID
+	RequestID uuid.UUID
+}
+
+type RemoveContactCommand struct {
+	UserID        uuid.UUID
+	ContactUserID uuid.UUID
+}
+
+type SetNicknameCommand struct {
+	UserID        uuid.UUID
+	ContactUserID uuid.UUID
+	Nickname      *string
+}
+
+type SetFavoriteCommand struct {
+	UserID        uuid.UUID
+	ContactUserID uuid.UUID
+	Favorite      bool
+}
+
+type BlockUserCommand struct {
+	UserID       uuid.UUID
+	TargetUserID uuid.UUID
+}
+
+type UnblockUserCommand struct {
+	UserID       uuid.UUID
+	TargetUserID uuid.UUID
+}
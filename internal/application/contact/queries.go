@@ -0,0 +1,22 @@
+package contact
+
+import "github.com/google/uuid"
+
+type ListContactsQuery struct {
+	UserID        uuid.UUID
+	FavoritesOnly bool
+	Limit         int
+	Offset        int
+}
+
+type ListBlockedUsersQuery struct {
+	UserID uuid.UUID
+}
+
+type ListIncomingRequestsQuery struct {
+	UserID uuid.UUID
+}
+
+type ListOutgoingRequestsQuery struct {
+	UserID uuid.UUID
+}
@@ -0,0 +1,370 @@
+package contact
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/uow"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo        contact.Repository
+	userRepo    user.Repository
+	uow         uow.UnitOfWork
+	blockPolicy *contact.BlockPolicy
+	logger      *slog.Logger
+}
+
+func NewService(
+	repo contact.Repository,
+	userRepo user.Repository,
+	uow uow.UnitOfWork,
+	blockPolicy *contact.BlockPolicy,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		repo:        repo,
+		userRepo:    userRepo,
+		uow:         uow,
+		blockPolicy: blockPolicy,
+		logger:      logger,
+	}
+}
+
+func (s *Service) SendContactRequest(ctx context.Context, cmd SendContactRequestCommand) (*contact.ContactRequest, error) {
+	if cmd.UserID == cmd.ToUserID {
+		return nil, contact.ErrCannotAddSelf
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, cmd.ToUserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.blockPolicy.Check(ctx, cmd.UserID, cmd.ToUserID); err != nil {
+		return nil, err
+	}
+
+	// The repository also rejects a second pending request between the pair,
+	// which covers requests racing past these checks.
+	req := contact.NewContactRequest(cmd.UserID, cmd.ToUserID, cmd.Message)
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		existing, err := s.findContact(ctx, cmd.UserID, cmd.ToUserID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return contact.ErrContactAlreadyExists
+		}
+
+		for _, pair := range [][2]uuid.UUID{{cmd.UserID, cmd.ToUserID}, {cmd.ToUserID, cmd.UserID}} {
+			_, err := s.repo.GetPendingRequest(ctx, pair[0], pair[1])
+			if err == nil {
+				return contact.ErrContactRequestAlreadyExists
+			}
+			if !errors.Is(err, contact.ErrContactRequestNotFound) {
+				return err
+			}
+		}
+
+		return s.repo.CreateRequest(ctx, req)
+	})
+	if err != nil {
+		if !errors.Is(err, contact.ErrContactAlreadyExists) && !errors.Is(err, contact.ErrContactRequestAlreadyExists) {
+			s.logger.Error("failed to create contact request", "error", err)
+		}
+		return nil, err
+	}
+
+	s.logger.Info("contact request sent", "request_id", req.ID, "from_user_id", cmd.UserID)
+	return req, nil
+}
+
+func (s *Service) ListIncomingRequests(ctx context.Context, query ListIncomingRequestsQuery) ([]*contact.ContactRequest, error) {
+	return s.repo.ListPendingRequestsForUser(ctx, query.UserID)
+}
+
+func (s *Service) ListOutgoingRequests(ctx context.Context, query ListOutgoingRequestsQuery) ([]*contact.ContactRequest, error) {
+	return s.repo.ListSentRequests(ctx, query.UserID)
+}
+
+// AcceptContactRequest creates the contact on both sides in the same
+// transaction that settles the request.
+func (s *Service) AcceptContactRequest(ctx context.Context, cmd AcceptContactRequestCommand) (*contact.Contact, error) {
+	var c *contact.Contact
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		req, err := s.pendingRequestFor(ctx, cmd.RequestID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		req.Accept()
+		if err := s.repo.UpdateRequest(ctx, req); err != nil {
+			return err
+		}
+
+		c, err = s.ensureContact(ctx, req.ToUserID, req.FromUserID)
+		if err != nil {
+			return err
+		}
+		_, err = s.ensureContact(ctx, req.FromUserID, req.ToUserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("contact request accepted", "request_id", cmd.RequestID, "user_id", cmd.UserID)
+	return c, nil
+}
+
+func (s *Service) RejectContactRequest(ctx context.Context, cmd RejectContactRequestCommand) (*contact.ContactRequest, error) {
+	var req *contact.ContactRequest
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		req, err = s.pendingRequestFor(ctx, cmd.RequestID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		req.Reject()
+		return s.repo.UpdateRequest(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (s *Service) ListContacts(ctx context.Context, query ListContactsQuery) ([]*contact.Contact, int64, error) {
+	if query.FavoritesOnly {
+		favorites, err := s.repo.ListFavorites(ctx, query.UserID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return favorites, int64(len(favorites)), nil
+	}
+
+	limit := query.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	contacts, err := s.repo.ListByUser(ctx, query.UserID, limit, query.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.CountByUser(ctx, query.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return contacts, total, nil
+}
+
+func (s *Service) ListBlockedUsers(ctx context.Context, query ListBlockedUsersQuery) ([]*contact.Contact, error) {
+	return s.repo.ListBlocked(ctx, query.UserID)
+}
+
+// RemoveContact removes the contact from both users' lists. A block recorded
+// by the other user is left in place.
+func (s *Service) RemoveContact(ctx context.Context, cmd RemoveContactCommand) error {
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		mine, err := s.activeContact(ctx, cmd.UserID, cmd.ContactUserID)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.Delete(ctx, mine.ID); err != nil {
+			return err
+		}
+
+		theirs, err := s.findContact(ctx, cmd.ContactUserID, cmd.UserID)
+		if err != nil || theirs == nil || theirs.IsBlocked {
+			return err
+		}
+		return s.repo.Delete(ctx, theirs.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("contact removed", "user_id", cmd.UserID, "contact_user_id", cmd.ContactUserID)
+	return nil
+}
+
+func (s *Service) SetNickname(ctx context.Context, cmd SetNicknameCommand) (*contact.Contact, error) {
+	return s.updateContact(ctx, cmd.UserID, cmd.ContactUserID, func(c *contact.Contact) {
+		c.SetNickname(cmd.Nickname)
+	})
+}
+
+func (s *Service) SetFavorite(ctx context.Context, cmd SetFavoriteCommand) (*contact.Contact, error) {
+	return s.updateContact(ctx, cmd.UserID, cmd.ContactUserID, func(c *contact.Contact) {
+		c.SetFavorite(cmd.Favorite)
+	})
+}
+
+// BlockUser works whether or not the target is a contact; for non-contacts a
+// contact row is created solely to hold the block. Pending requests between
+// the two users are rejected.
+func (s *Service) BlockUser(ctx context.Context, cmd BlockUserCommand) error {
+	if cmd.UserID == cmd.TargetUserID {
+		return contact.ErrCannotBlockSelf
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, cmd.TargetUserID); err != nil {
+		return err
+	}
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.rejectPendingRequests(ctx, cmd.UserID, cmd.TargetUserID); err != nil {
+			return err
+		}
+
+		c, err := s.findContact(ctx, cmd.UserID, cmd.TargetUserID)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			c = contact.NewContact(cmd.UserID, cmd.TargetUserID)
+			c.Block()
+			return s.repo.Create(ctx, c)
+		}
+		if c.IsBlocked {
+			return nil
+		}
+		c.Block()
+		return s.repo.Update(ctx, c)
+	})
+	if err != nil {
+		s.logger.Error("failed to block user", "error", err)
+		return err
+	}
+
+	s.logger.Info("user blocked", "user_id", cmd.UserID, "target_user_id", cmd.TargetUserID)
+	return nil
+}
+
+func (s *Service) UnblockUser(ctx context.Context, cmd UnblockUserCommand) error {
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		c, err := s.findContact(ctx, cmd.UserID, cmd.TargetUserID)
+		if err != nil {
+			return err
+		}
+		if c == nil || !c.IsBlocked {
+			return contact.ErrContactNotFound
+		}
+
+		// Without the reciprocal row the two were never contacts, so the row
+		// only existed to hold the block.
+		reverse, err := s.findContact(ctx, cmd.TargetUserID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		if reverse == nil {
+			return s.repo.Delete(ctx, c.ID)
+		}
+
+		c.Unblock()
+		return s.repo.Update(ctx, c)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("user unblocked", "user_id", cmd.UserID, "target_user_id", cmd.TargetUserID)
+	return nil
+}
+
+func (s *Service) rejectPendingRequests(ctx context.Context, userID1, userID2 uuid.UUID) error {
+	for _, pair := range [][2]uuid.UUID{{userID1, userID2}, {userID2, userID1}} {
+		req, err := s.repo.GetPendingRequest(ctx, pair[0], pair[1])
+		if errors.Is(err, contact.ErrContactRequestNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		req.Reject()
+		if err := s.repo.UpdateRequest(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) updateContact(ctx context.Context, userID, contactUserID uuid.UUID, apply func(*contact.Contact)) (*contact.Contact, error) {
+	c, err := s.activeContact(ctx, userID, contactUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	apply(c)
+
+	if err := s.repo.Update(ctx, c); err != nil {
+		s.logger.Error("failed to update contact", "error", err, "contact_id", c.ID)
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// pendingRequestFor loads a request addressed to userID. Requests addressed to
+// someone else are reported as missing.
+func (s *Service) pendingRequestFor(ctx context.Context, requestID, userID uuid.UUID) (*contact.ContactRequest, error) {
+	req, err := s.repo.GetRequestByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if req.ToUserID != userID {
+		return nil, contact.ErrContactRequestNotFound
+	}
+	if !req.IsPending() {
+		return nil, contact.ErrContactRequestNotPending
+	}
+	return req, nil
+}
+
+// activeContact returns the user's contact entry for contactUserID, treating
+// block-only entries as absent.
+func (s *Service) activeContact(ctx context.Context, userID, contactUserID uuid.UUID) (*contact.Contact, error) {
+	c, err := s.repo.GetByUserAndContact(ctx, userID, contactUserID)
+	if err != nil {
+		return nil, err
+	}
+	if c.IsBlocked {
+		return nil, contact.ErrContactNotFound
+	}
+	return c, nil
+}
+
+func (s *Service) ensureContact(ctx context.Context, userID, contactUserID uuid.UUID) (*contact.Contact, error) {
+	c, err := s.findContact(ctx, userID, contactUserID)
+	if err != nil || c != nil {
+		return c, err
+	}
+
+	c = contact.NewContact(userID, contactUserID)
+	if err := s.repo.Create(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// findContact returns nil without an error when no entry exists.
+func (s *Service) findContact(ctx context.Context, userID, contactUserID uuid.UUID) (*contact.Contact, error) {
+	c, err := s.repo.GetByUserAndContact(ctx, userID, contactUserID)
+	if errors.Is(err, contact.ErrContactNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
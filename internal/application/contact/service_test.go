@@ -0,0 +1,280 @@
+package contact
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type passUoW struct{}
+
+func (passUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeUsers struct {
+	user.Repository
+}
+
+func (fakeUsers) GetByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	return &user.User{ID: id}, nil
+}
+
+type fakeContacts struct {
+	contact.Repository
+	contacts map[[2]uuid.UUID]*contact.Contact // By user and contact
+	requests map[uuid.UUID]*contact.ContactRequest
+}
+
+func newFakeContacts() *fakeContacts {
+	return &fakeContacts{
+		contacts: make(map[[2]uuid.UUID]*contact.Contact),
+		requests: make(map[uuid.UUID]*contact.ContactRequest),
+	}
+}
+
+func (r *fakeContacts) Create(ctx context.Context, c *contact.Contact) error {
+	r.contacts[[2]uuid.UUID{c.UserID, c.ContactID}] = c
+	return nil
+}
+
+func (r *fakeContacts) GetByUserAndContact(ctx context.Context, userID, contactID uuid.UUID) (*contact.Contact, error) {
+	c, ok := r.contacts[[2]uuid.UUID{userID, contactID}]
+	if !ok {
+		return nil, contact.ErrContactNotFound
+	}
+	return c, nil
+}
+
+func (r *fakeContacts) Update(ctx context.Context, c *contact.Contact) error {
+	r.contacts[[2]uuid.UUID{c.UserID, c.ContactID}] = c
+	return nil
+}
+
+func (r *fakeContacts) Delete(ctx context.Context, id uuid.UUID) error {
+	for k, c := range r.contacts {
+		if c.ID == id {
+			delete(r.contacts, k)
+		}
+	}
+	return nil
+}
+
+func (r *fakeContacts) IsBlocked(ctx context.Context, userID, targetID uuid.UUID) (bool, error) {
+	c, ok := r.contacts[[2]uuid.UUID{userID, targetID}]
+	return ok && c.IsBlocked, nil
+}
+
+func (r *fakeContacts) CreateRequest(ctx context.Context, req *contact.ContactRequest) error {
+	r.requests[req.ID] = req
+	return nil
+}
+
+func (r *fakeContacts) GetRequestByID(ctx context.Context, id uuid.UUID) (*contact.ContactRequest, error) {
+	req, ok := r.requests[id]
+	if !ok {
+		return nil, contact.ErrContactRequestNotFound
+	}
+	return req, nil
+}
+
+func (r *fakeContacts) GetPendingRequest(ctx context.Context, fromUserID, toUserID uuid.UUID) (*contact.ContactRequest, error) {
+	for _, req := range r.requests {
+		if req.FromUserID == fromUserID && req.ToUserID == toUserID && req.IsPending() {
+			return req, nil
+		}
+	}
+	return nil, contact.ErrContactRequestNotFound
+}
+
+func (r *fakeContacts) UpdateRequest(ctx context.Context, req *contact.ContactRequest) error {
+	r.requests[req.ID] = req
+	return nil
+}
+
+func newTestService() (*Service, *fakeContacts) {
+	repo := newFakeContacts()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewService(repo, fakeUsers{}, passUoW{}, contact.NewBlockPolicy(repo), logger), repo
+}
+
+func TestSendContactRequest(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		setup   func(repo *fakeContacts)
+		from    uuid.UUID
+		to      uuid.UUID
+		wantErr error
+	}{
+		{name: "sends a request", from: alice, to: bob},
+		{name: "rejects a request to oneself", from: alice, to: alice, wantErr: contact.ErrCannotAddSelf},
+		{
+			name: "tells the blocker about their block",
+			setup: func(repo *fakeContacts) {
+				c := contact.NewContact(alice, bob)
+				c.Block()
+				repo.Create(context.Background(), c)
+			},
+			from: alice, to: bob, wantErr: contact.ErrContactBlocked,
+		},
+		{
+			name: "hides the block from the blocked user",
+			setup: func(repo *fakeContacts) {
+				c := contact.NewContact(bob, alice)
+				c.Block()
+				repo.Create(context.Background(), c)
+			},
+			from: alice, to: bob, wantErr: contact.ErrUserUnavailable,
+		},
+		{
+			name: "rejects existing contacts",
+			setup: func(repo *fakeContacts) {
+				repo.Create(context.Background(), contact.NewContact(alice, bob))
+			},
+			from: alice, to: bob, wantErr: contact.ErrContactAlreadyExists,
+		},
+		{
+			name: "rejects a request while the other side's is pending",
+			setup: func(repo *fakeContacts) {
+				repo.CreateRequest(context.Background(), contact.NewContactRequest(bob, alice, nil))
+			},
+			from: alice, to: bob, wantErr: contact.ErrContactRequestAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo := newTestService()
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			before := len(repo.requests)
+
+			_, err := svc.SendContactRequest(context.Background(), SendContactRequestCommand{UserID: tt.from, ToUserID: tt.to})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SendContactRequest error = %v, want %v", err, tt.wantErr)
+			}
+
+			want := before
+			if tt.wantErr == nil {
+				want++
+			}
+			if len(repo.requests) != want {
+				t.Errorf("stored %d requests, want %d", len(repo.requests), want)
+			}
+		})
+	}
+}
+
+func TestSettleContactRequest(t *testing.T) {
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		settled bool
+		wantErr error
+	}{
+		{name: "the recipient settles it", userID: bob},
+		{name: "the sender cannot", userID: alice, wantErr: contact.ErrContactRequestNotFound},
+		{name: "nor can anyone else", userID: carol, wantErr: contact.ErrContactRequestNotFound},
+		{name: "a settled request stays settled", userID: bob, settled: true, wantErr: contact.ErrContactRequestNotPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" (accept)", func(t *testing.T) {
+			svc, repo := newTestService()
+			req := contact.NewContactRequest(alice, bob, nil)
+			if tt.settled {
+				req.Reject()
+			}
+			repo.CreateRequest(context.Background(), req)
+
+			_, err := svc.AcceptContactRequest(context.Background(), AcceptContactRequestCommand{UserID: tt.userID, RequestID: req.ID})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AcceptContactRequest error = %v, want %v", err, tt.wantErr)
+			}
+
+			wantContacts := 0
+			if tt.wantErr == nil {
+				wantContacts = 2
+			}
+			if len(repo.contacts) != wantContacts {
+				t.Errorf("created %d contacts, want %d", len(repo.contacts), wantContacts)
+			}
+			for _, pair := range [][2]uuid.UUID{{alice, bob}, {bob, alice}} {
+				if _, ok := repo.contacts[pair]; ok != (tt.wantErr == nil) {
+					t.Errorf("contact %v exists = %v", pair, ok)
+				}
+			}
+		})
+
+		t.Run(tt.name+" (reject)", func(t *testing.T) {
+			svc, repo := newTestService()
+			req := contact.NewContactRequest(alice, bob, nil)
+			if tt.settled {
+				req.Accept()
+			}
+			repo.CreateRequest(context.Background(), req)
+
+			_, err := svc.RejectContactRequest(context.Background(), RejectContactRequestCommand{UserID: tt.userID, RequestID: req.ID})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RejectContactRequest error = %v, want %v", err, tt.wantErr)
+			}
+			if !tt.settled && req.IsPending() == (tt.wantErr == nil) {
+				t.Errorf("request pending = %v after rejection error %v", req.IsPending(), err)
+			}
+		})
+	}
+}
+
+func TestBlockUser(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	svc, repo := newTestService()
+
+	sent := contact.NewContactRequest(alice, bob, nil)
+	received := contact.NewContactRequest(bob, alice, nil)
+	repo.CreateRequest(ctx, sent)
+	repo.CreateRequest(ctx, received)
+
+	if err := svc.BlockUser(ctx, BlockUserCommand{UserID: alice, TargetUserID: alice}); !errors.Is(err, contact.ErrCannotBlockSelf) {
+		t.Fatalf("blocking oneself: error = %v, want %v", err, contact.ErrCannotBlockSelf)
+	}
+
+	if err := svc.BlockUser(ctx, BlockUserCommand{UserID: alice, TargetUserID: bob}); err != nil {
+		t.Fatalf("BlockUser: %v", err)
+	}
+	if sent.IsPending() || received.IsPending() {
+		t.Errorf("pending requests between the users were left open")
+	}
+	if blocked, _ := repo.IsBlocked(ctx, alice, bob); !blocked {
+		t.Fatalf("block was not recorded")
+	}
+
+	// The row holding the block is not a contact.
+	if _, err := svc.SetFavorite(ctx, SetFavoriteCommand{UserID: alice, ContactUserID: bob, Favorite: true}); !errors.Is(err, contact.ErrContactNotFound) {
+		t.Errorf("favoriting a blocked user: error = %v, want %v", err, contact.ErrContactNotFound)
+	}
+	if err := svc.RemoveContact(ctx, RemoveContactCommand{UserID: alice, ContactUserID: bob}); !errors.Is(err, contact.ErrContactNotFound) {
+		t.Errorf("removing a blocked user: error = %v, want %v", err, contact.ErrContactNotFound)
+	}
+
+	if err := svc.UnblockUser(ctx, UnblockUserCommand{UserID: bob, TargetUserID: alice}); !errors.Is(err, contact.ErrContactNotFound) {
+		t.Errorf("unblocking by the blocked user: error = %v, want %v", err, contact.ErrContactNotFound)
+	}
+	if err := svc.UnblockUser(ctx, UnblockUserCommand{UserID: alice, TargetUserID: bob}); err != nil {
+		t.Fatalf("UnblockUser: %v", err)
+	}
+	if len(repo.contacts) != 0 {
+		t.Errorf("unblocking a non-contact left %d contact rows", len(repo.contacts))
+	}
+}
@@ -0,0 +1,33 @@
+package conversation
+
+import "github.com/google/uuid"
+
+type StartDirectConversationCommand struct {
+	UserID      uuid.UUID
+	OtherUserID uuid.UUID
+}
+
+type MuteConversationCommand struct {
+	ConversationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+type UnmuteConversationCommand struct {
+	ConversationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+type ArchiveConversationCommand struct {
+	ConversationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+type UnarchiveConversationCommand struct {
+	ConversationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+type LeaveConversationCommand struct {
+	ConversationID uuid.UUID
+	UserID         uuid.UUID
+}
@@ -0,0 +1,15 @@
+package conversation
+
+import "github.com/google/uuid"
+
+type GetConversationQuery struct {
+	ConversationID uuid.UUID
+	UserID         uuid.UUID // For permission check
+}
+
+type ListConversationsQuery struct {
+	UserID          uuid.UUID
+	IncludeArchived bool
+	Limit           int
+	Offset          int
+}
@@ -0,0 +1,255 @@
+package conversation
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// Summary is a conversation as seen from one participant's inbox.
+type Summary struct {
+	Conversation *conversation.Conversation
+	Participant  *conversation.Participant
+	LastMessage  *messaging.Message
+	UnreadCount  int64
+}
+
+type Service struct {
+	conversationRepo conversation.Repository
+	messageRepo      messaging.Repository
+	userRepo         user.Repository
+	blockPolicy      *contact.BlockPolicy
+	logger           *slog.Logger
+}
+
+func NewService(
+	conversationRepo conversation.Repository,
+	messageRepo messaging.Repository,
+	userRepo user.Repository,
+	blockPolicy *contact.BlockPolicy,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		conversationRepo: conversationRepo,
+		messageRepo:      messageRepo,
+		userRepo:         userRepo,
+		blockPolicy:      blockPolicy,
+		logger:           logger,
+	}
+}
+
+func (s *Service) StartDirectConversation(ctx context.Context, cmd StartDirectConversationCommand) (*Summary, error) {
+	if cmd.UserID == cmd.OtherUserID {
+		return nil, conversation.ErrCannotConverseWithSelf
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, cmd.OtherUserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.blockPolicy.Check(ctx, cmd.UserID, cmd.OtherUserID); err != nil {
+		return nil, err
+	}
+
+	conv, err := s.conversationRepo.GetOrCreateDirectConversation(ctx, cmd.UserID, cmd.OtherUserID)
+	if err != nil {
+		s.logger.Error("failed to start direct conversation", "error", err)
+		return nil, err
+	}
+
+	participant, err := s.conversationRepo.GetParticipant(ctx, conv.ID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reopening a chat brings it back into the inbox.
+	if participant.IsArchived {
+		participant.Unarchive()
+		if err := s.conversationRepo.UpdateParticipant(ctx, participant); err != nil {
+			s.logger.Error("failed to unarchive conversation", "error", err, "conversation_id", conv.ID)
+			return nil, err
+		}
+	}
+
+	return s.summarize(ctx, conv, participant)
+}
+
+func (s *Service) GetConversation(ctx context.Context, query GetConversationQuery) (*Summary, []*conversation.Participant, error) {
+	participant, err := s.activeParticipant(ctx, query.ConversationID, query.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conv, err := s.conversationRepo.GetByID(ctx, query.ConversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summary, err := s.summarize(ctx, conv, participant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	participants, err := s.conversationRepo.ListActiveParticipants(ctx, query.ConversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return summary, participants, nil
+}
+
+func (s *Service) ListConversations(ctx context.Context, query ListConversationsQuery) ([]*Summary, int64, error) {
+	limit := query.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	conversations, err := s.conversationRepo.ListByUser(ctx, query.UserID, query.IncludeArchived, limit, query.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.conversationRepo.CountByUser(ctx, query.UserID, query.IncludeArchived)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(conversations) == 0 {
+		return []*Summary{}, total, nil
+	}
+
+	ids := make([]uuid.UUID, len(conversations))
+	for i, conv := range conversations {
+		ids[i] = conv.ID
+	}
+	participants, err := s.conversationRepo.ListParticipantsByUser(ctx, query.UserID, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	byConversation := make(map[uuid.UUID]*conversation.Participant, len(participants))
+	for _, p := range participants {
+		byConversation[p.ConversationID] = p
+	}
+
+	summaries, err := s.summarizeAll(ctx, query.UserID, conversations, byConversation)
+	if err != nil {
+		return nil, 0, err
+	}
+	return summaries, total, nil
+}
+
+func (s *Service) MuteConversation(ctx context.Context, cmd MuteConversationCommand) (*conversation.Participant, error) {
+	return s.updateParticipant(ctx, cmd.ConversationID, cmd.UserID, (*conversation.Participant).Mute)
+}
+
+func (s *Service) UnmuteConversation(ctx context.Context, cmd UnmuteConversationCommand) (*conversation.Participant, error) {
+	return s.updateParticipant(ctx, cmd.ConversationID, cmd.UserID, (*conversation.Participant).Unmute)
+}
+
+func (s *Service) ArchiveConversation(ctx context.Context, cmd ArchiveConversationCommand) (*conversation.Participant, error) {
+	return s.updateParticipant(ctx, cmd.ConversationID, cmd.UserID, (*conversation.Participant).Archive)
+}
+
+func (s *Service) UnarchiveConversation(ctx context.Context, cmd UnarchiveConversationCommand) (*conversation.Participant, error) {
+	return s.updateParticipant(ctx, cmd.ConversationID, cmd.UserID, (*conversation.Participant).Unarchive)
+}
+
+func (s *Service) LeaveConversation(ctx context.Context, cmd LeaveConversationCommand) error {
+	conv, err := s.conversationRepo.GetByID(ctx, cmd.ConversationID)
+	if err != nil {
+		return err
+	}
+	if conv.IsDirect() {
+		return conversation.ErrCannotRemoveFromDirect
+	}
+
+	if _, err := s.updateParticipant(ctx, cmd.ConversationID, cmd.UserID, (*conversation.Participant).Leave); err != nil {
+		return err
+	}
+
+	s.logger.Info("participant left conversation", "conversation_id", cmd.ConversationID, "user_id", cmd.UserID)
+	return nil
+}
+
+func (s *Service) updateParticipant(ctx context.Context, conversationID, userID uuid.UUID, apply func(*conversation.Participant)) (*conversation.Participant, error) {
+	participant, err := s.activeParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	apply(participant)
+
+	if err := s.conversationRepo.UpdateParticipant(ctx, participant); err != nil {
+		s.logger.Error("failed to update conversation participant", "error", err, "conversation_id", conversationID)
+		return nil, err
+	}
+
+	return participant, nil
+}
+
+func (s *Service) activeParticipant(ctx context.Context, conversationID, userID uuid.UUID) (*conversation.Participant, error) {
+	participant, err := s.conversationRepo.GetParticipant(ctx, conversationID, userID)
+	if errors.Is(err, conversation.ErrParticipantNotFound) {
+		return nil, conversation.ErrNotParticipant
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !participant.IsActive() {
+		return nil, conversation.ErrNotParticipant
+	}
+	return participant, nil
+}
+
+func (s *Service) summarize(ctx context.Context, conv *conversation.Conversation, participant *conversation.Participant) (*Summary, error) {
+	summaries, err := s.summarizeAll(ctx, participant.UserID, []*conversation.Conversation{conv},
+		map[uuid.UUID]*conversation.Participant{conv.ID: participant})
+	if err != nil {
+		return nil, err
+	}
+	return summaries[0], nil
+}
+
+// summarizeAll loads the last message and the user's unread count of every
+// conversation at once.
+func (s *Service) summarizeAll(ctx context.Context, userID uuid.UUID, conversations []*conversation.Conversation, participants map[uuid.UUID]*conversation.Participant) ([]*Summary, error) {
+	var withMessages []uuid.UUID
+	for _, conv := range conversations {
+		if conv.LastMessageID != nil {
+			withMessages = append(withMessages, conv.ID)
+		}
+	}
+
+	lastMessages := make(map[uuid.UUID]*messaging.Message, len(withMessages))
+	var unread map[uuid.UUID]int64
+	if len(withMessages) > 0 {
+		latest, err := s.messageRepo.ListLatestByConversations(ctx, userID, withMessages)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range latest {
+			lastMessages[m.ConversationID] = m
+		}
+
+		unread, err = s.messageRepo.CountUnreadByConversations(ctx, userID, withMessages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	summaries := make([]*Summary, len(conversations))
+	for i, conv := range conversations {
+		summaries[i] = &Summary{
+			Conversation: conv,
+			Participant:  participants[conv.ID],
+			LastMessage:  lastMessages[conv.ID],
+			UnreadCount:  unread[conv.ID],
+		}
+	}
+	return summaries, nil
+}
@@ -0,0 +1,80 @@
+package location
+
+import (
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/google/uuid"
+)
+
+// Point is a single position reported by a device. A zero RecordedAt means
+// the point was taken when it was received.
+type Point struct {
+	Latitude   float64
+	Longitude  float64
+	Accuracy   *float64
+	Altitude   *float64
+	Speed      *float64
+	Heading    *float64
+	RecordedAt time.Time
+}
+
+type UpdateLocationCommand struct {
+	UserID uuid.UUID
+	Point  Point
+}
+
+type BatchUpdateLocationCommand struct {
+	UserID uuid.UUID
+	Points []Point
+}
+
+type CreatePlaceCommand struct {
+	UserID    uuid.UUID
+	Name      string
+	Type      location.PlaceType
+	Latitude  float64
+	Longitude float64
+	Radius    float64
+	Address   *string
+	Icon      *string
+}
+
+// UpdatePlaceCommand changes only the fields that are set.
+type UpdatePlaceCommand struct {
+	UserID    uuid.UUID
+	PlaceID   uuid.UUID
+	Name      *string
+	Type      *location.PlaceType
+	Latitude  *float64
+	Longitude *float64
+	Radius    *float64
+	Address   *string
+	Icon      *string
+}
+
+type DeletePlaceCommand struct {
+	UserID  uuid.UUID
+	PlaceID uuid.UUID
+}
+
+// DeleteHistoryCommand deletes the points recorded before Before, or all of
+// them when it is zero.
+type DeleteHistoryCommand struct {
+	UserID uuid.UUID
+	Before time.Time
+}
+
+// StartLiveSessionCommand shares the user's exact location with exactly one
+// of CircleID and ConversationID for Duration.
+type StartLiveSessionCommand struct {
+	UserID         uuid.UUID
+	CircleID       *uuid.UUID
+	ConversationID *uuid.UUID
+	Duration       time.Duration
+}
+
+type StopLiveSessionCommand struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+}
@@ -0,0 +1,66 @@
+package location
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/google/uuid"
+)
+
+type enrichment struct {
+	userID uuid.UUID
+	lat    float64
+	lng    float64
+}
+
+// Enricher reverse geocodes current locations in the background so that
+// location updates never wait on the geocoder.
+type Enricher struct {
+	repo     location.Repository
+	geocoder location.Geocoder
+	queue    chan enrichment
+	logger   *slog.Logger
+}
+
+func NewEnricher(repo location.Repository, geocoder location.Geocoder, queueSize int, logger *slog.Logger) *Enricher {
+	return &Enricher{
+		repo:     repo,
+		geocoder: geocoder,
+		queue:    make(chan enrichment, queueSize),
+		logger:   logger,
+	}
+}
+
+// Enqueue schedules l to be geocoded. When the queue is full l is skipped and
+// stays without geocoded info until the user's next update.
+func (e *Enricher) Enqueue(l *location.Location) {
+	select {
+	case e.queue <- enrichment{userID: l.UserID, lat: l.Latitude, lng: l.Longitude}:
+	default:
+		e.logger.Warn("geocoding queue is full, skipping location", "user_id", l.UserID)
+	}
+}
+
+// Run geocodes queued locations until ctx is done.
+func (e *Enricher) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-e.queue:
+			e.enrich(ctx, job)
+		}
+	}
+}
+
+func (e *Enricher) enrich(ctx context.Context, job enrichment) {
+	info, err := e.geocoder.ReverseGeocode(ctx, job.lat, job.lng)
+	if err != nil {
+		e.logger.Warn("failed to reverse geocode location", "error", err, "user_id", job.userID)
+		return
+	}
+	if err := e.repo.SetGeocodedInfo(ctx, job.userID, job.lat, job.lng, info); err != nil {
+		e.logger.Error("failed to store geocoded info", "error", err, "user_id", job.userID)
+	}
+}
@@ -0,0 +1,86 @@
+package location
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+)
+
+// GetHistory returns the user's trail over the period, with the points
+// simplified to the tolerance. Periods with more than MaxTrailPoints points
+// keep the latest ones.
+func (s *Service) GetHistory(ctx context.Context, query GetHistoryQuery) (*location.Trail, error) {
+	to := query.To
+	if to.IsZero() {
+		to = time.Now()
+	}
+	from := query.From
+	if from.IsZero() {
+		from = to.Add(-24 * time.Hour)
+	}
+	if !from.Before(to) {
+		return nil, location.ErrInvalidTimeRange
+	}
+	tolerance := query.Tolerance
+	if tolerance <= 0 {
+		tolerance = location.DefaultSimplifyTolerance
+	}
+	tolerance = min(tolerance, location.MaxSimplifyTolerance)
+
+	points, err := s.repo.ListHistoryByUser(ctx, query.UserID, from, to, location.MaxTrailPoints+1)
+	if err != nil {
+		return nil, err
+	}
+
+	trail := &location.Trail{}
+	if len(points) > location.MaxTrailPoints {
+		points = points[len(points)-location.MaxTrailPoints:]
+		trail.Truncated = true
+	}
+	trail.Segments = location.Segments(points)
+	trail.Points = location.Simplify(points, tolerance)
+	return trail, nil
+}
+
+func (s *Service) DeleteHistory(ctx context.Context, cmd DeleteHistoryCommand) error {
+	before := cmd.Before
+	if before.IsZero() {
+		before = time.Now()
+	}
+
+	if err := s.repo.DeleteHistoryOlderThan(ctx, cmd.UserID, before); err != nil {
+		s.logger.Error("failed to delete location history", "error", err, "user_id", cmd.UserID)
+		return err
+	}
+	return nil
+}
+
+// DeleteExpiredHistory deletes up to limit points that are past their
+// owner's retention, and reports how many it deleted.
+func (s *Service) DeleteExpiredHistory(ctx context.Context, limit int) (int64, error) {
+	now := time.Now()
+
+	var deleted int64
+	for _, r := range location.Retentions {
+		if deleted >= int64(limit) {
+			break
+		}
+
+		var (
+			n   int64
+			err error
+		)
+		before := now.Add(-r.Duration())
+		if r == location.DefaultRetention {
+			n, err = s.repo.DeleteHistoryBefore(ctx, before, limit-int(deleted))
+		} else {
+			n, err = s.repo.DeleteHistoryByRetention(ctx, r, before, limit-int(deleted))
+		}
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+	return deleted, nil
+}
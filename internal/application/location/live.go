@@ -0,0 +1,306 @@
+package location
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/google/uuid"
+)
+
+// StartLiveSession shares the user's exact location with a circle they are
+// in, or a direct conversation they take part in, until the session expires.
+// A session the user already has with the same scope is replaced.
+func (s *Service) StartLiveSession(ctx context.Context, cmd StartLiveSessionCommand) (*location.LiveSession, error) {
+	if (cmd.CircleID == nil) == (cmd.ConversationID == nil) {
+		return nil, location.ErrInvalidLiveScope
+	}
+	if !location.IsValidLiveDuration(cmd.Duration) {
+		return nil, location.ErrInvalidLiveDuration
+	}
+
+	session := location.NewLiveSession(cmd.UserID, cmd.CircleID, cmd.ConversationID, cmd.Duration)
+	members, err := s.liveScope(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(members, cmd.UserID) {
+		return nil, notInLiveScope(session)
+	}
+
+	existing, err := s.liveRepo.ListByUser(ctx, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range existing {
+		if e.SameScope(session) {
+			if err := s.liveRepo.Delete(ctx, e); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := s.liveRepo.Create(ctx, session); err != nil {
+		s.logger.Error("failed to create live session", "error", err, "user_id", cmd.UserID)
+		return nil, err
+	}
+
+	audience, err := s.liveAudience(ctx, session, members)
+	if err != nil {
+		s.logger.Error("failed to resolve live session audience", "error", err, "session_id", session.ID)
+		return session, nil
+	}
+	_ = s.publisher.PublishToUsers(ctx, append(audience, cmd.UserID), realtime.NewLiveSessionEvent(realtime.EventTypeLiveSessionStarted, session))
+
+	current, err := s.repo.GetByUserID(ctx, cmd.UserID)
+	if err == nil && len(audience) > 0 {
+		_ = s.publisher.PublishToUsers(ctx, audience, realtime.NewLocationEvent(current.Share(location.PrecisionExact), session))
+	}
+
+	return session, nil
+}
+
+func (s *Service) StopLiveSession(ctx context.Context, cmd StopLiveSessionCommand) error {
+	session, err := s.liveRepo.Get(ctx, cmd.SessionID)
+	if err != nil {
+		return err
+	}
+	if session.UserID != cmd.UserID {
+		return location.ErrLiveSessionNotFound
+	}
+
+	if err := s.liveRepo.Delete(ctx, session); err != nil {
+		s.logger.Error("failed to delete live session", "error", err, "session_id", session.ID)
+		return err
+	}
+
+	s.publishLiveEnded(ctx, session)
+	return nil
+}
+
+// ListLiveSessions returns the active sessions shared with a circle or
+// conversation the user is in, with each sharer's exact location, or the
+// user's own sessions when no scope is given.
+func (s *Service) ListLiveSessions(ctx context.Context, query ListLiveSessionsQuery) ([]*location.LiveLocation, error) {
+	if query.CircleID != nil && query.ConversationID != nil {
+		return nil, location.ErrInvalidLiveScope
+	}
+	if query.CircleID == nil && query.ConversationID == nil {
+		sessions, err := s.liveRepo.ListByUser(ctx, query.UserID)
+		if err != nil {
+			return nil, err
+		}
+		live := make([]*location.LiveLocation, len(sessions))
+		for i, session := range sessions {
+			live[i] = &location.LiveLocation{Session: session}
+		}
+		return live, nil
+	}
+
+	sessions, err := s.scopedLiveSessions(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var sharerIDs []uuid.UUID
+	for _, session := range sessions {
+		grants, err := s.projector.LiveAudience(ctx, session.UserID, []uuid.UUID{query.UserID}, session.CircleID)
+		if err != nil {
+			return nil, err
+		}
+		if grants[query.UserID].Location {
+			sharerIDs = append(sharerIDs, session.UserID)
+		}
+	}
+	if len(sharerIDs) == 0 {
+		return []*location.LiveLocation{}, nil
+	}
+
+	locations, err := s.repo.GetByUserIDs(ctx, sharerIDs)
+	if err != nil {
+		return nil, err
+	}
+	byUser := make(map[uuid.UUID]*location.Location, len(locations))
+	for _, l := range locations {
+		byUser[l.UserID] = l
+	}
+
+	live := make([]*location.LiveLocation, 0, len(sharerIDs))
+	for _, session := range sessions {
+		if !slices.Contains(sharerIDs, session.UserID) {
+			continue
+		}
+		l := &location.LiveLocation{Session: session}
+		if current, ok := byUser[session.UserID]; ok {
+			l.Location = current.Share(location.PrecisionExact)
+		}
+		live = append(live, l)
+	}
+	return live, nil
+}
+
+// scopedLiveSessions returns the sessions shared with the query's circle or
+// conversation, which the user must be in.
+func (s *Service) scopedLiveSessions(ctx context.Context, query ListLiveSessionsQuery) ([]*location.LiveSession, error) {
+	if query.CircleID != nil {
+		isMember, err := s.circleRepo.IsMember(ctx, *query.CircleID, query.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, circle.ErrNotCircleMember
+		}
+		return s.liveRepo.ListByCircle(ctx, *query.CircleID)
+	}
+
+	isParticipant, err := s.conversationRepo.IsParticipant(ctx, *query.ConversationID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !isParticipant {
+		return nil, conversation.ErrNotParticipant
+	}
+	return s.liveRepo.ListByConversation(ctx, *query.ConversationID)
+}
+
+// liveGrants upgrades the grants of the circle members who have a live
+// session with the circle to their exact location.
+func (s *Service) liveGrants(ctx context.Context, viewerID, circleID uuid.UUID, grants map[uuid.UUID]privacy.Grant) error {
+	sessions, err := s.liveRepo.ListByCircle(ctx, circleID)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if _, ok := grants[session.UserID]; !ok || session.UserID == viewerID {
+			continue
+		}
+		live, err := s.projector.LiveAudience(ctx, session.UserID, []uuid.UUID{viewerID}, &circleID)
+		if err != nil {
+			return err
+		}
+		grants[session.UserID] = live[viewerID]
+	}
+	return nil
+}
+
+// streamLive sends the user's new location to the viewers of each of their
+// live sessions. A session whose scope the user has since left ends.
+func (s *Service) streamLive(ctx context.Context, l *location.Location) {
+	sessions, err := s.liveRepo.ListByUser(ctx, l.UserID)
+	if err != nil {
+		s.logger.Error("failed to list live sessions", "error", err, "user_id", l.UserID)
+		return
+	}
+
+	for _, session := range sessions {
+		members, err := s.liveScope(ctx, session)
+		if err != nil {
+			s.logger.Error("failed to resolve live session scope", "error", err, "session_id", session.ID)
+			continue
+		}
+		if !slices.Contains(members, l.UserID) {
+			if err := s.liveRepo.Delete(ctx, session); err != nil {
+				s.logger.Error("failed to delete live session", "error", err, "session_id", session.ID)
+				continue
+			}
+			s.publishLiveEnded(ctx, session)
+			continue
+		}
+
+		audience, err := s.liveAudience(ctx, session, members)
+		if err != nil {
+			s.logger.Error("failed to resolve live session audience", "error", err, "session_id", session.ID)
+			continue
+		}
+		if len(audience) == 0 {
+			continue
+		}
+		_ = s.publisher.PublishToUsers(ctx, audience, realtime.NewLocationEvent(l.Share(location.PrecisionExact), session))
+	}
+}
+
+// EndExpiredLiveSessions tells the viewers of up to limit sessions that have
+// expired that they ended, and reports how many it handled.
+func (s *Service) EndExpiredLiveSessions(ctx context.Context, limit int) (int, error) {
+	sessions, err := s.liveRepo.PopExpired(ctx, time.Now(), limit)
+	for _, session := range sessions {
+		s.publishLiveEnded(ctx, session)
+	}
+	return len(sessions), err
+}
+
+// publishLiveEnded tells the sharer's devices and the session's viewers that
+// it ended, whether stopped early or expired.
+func (s *Service) publishLiveEnded(ctx context.Context, session *location.LiveSession) {
+	var audience []uuid.UUID
+	members, err := s.liveScope(ctx, session)
+	if err == nil {
+		audience, err = s.liveAudience(ctx, session, members)
+	}
+	if err != nil {
+		s.logger.Error("failed to resolve live session audience", "error", err, "session_id", session.ID)
+	}
+	_ = s.publisher.PublishToUsers(ctx, append(audience, session.UserID), realtime.NewLiveSessionEvent(realtime.EventTypeLiveSessionEnded, session))
+}
+
+// liveScope returns everyone the session could reach: the members of its
+// circle or the active participants of its direct conversation.
+func (s *Service) liveScope(ctx context.Context, session *location.LiveSession) ([]uuid.UUID, error) {
+	if session.CircleID != nil {
+		members, err := s.circleRepo.ListMembers(ctx, *session.CircleID)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]uuid.UUID, len(members))
+		for i, m := range members {
+			ids[i] = m.UserID
+		}
+		return ids, nil
+	}
+
+	conv, err := s.conversationRepo.GetByID(ctx, *session.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if !conv.IsDirect() {
+		return nil, location.ErrInvalidLiveScope
+	}
+	participants, err := s.conversationRepo.ListActiveParticipants(ctx, conv.ID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(participants))
+	for i, p := range participants {
+		ids[i] = p.UserID
+	}
+	return ids, nil
+}
+
+// liveAudience returns the members other than the sharer who may see the
+// session, which leaves out anyone on either side of a block.
+func (s *Service) liveAudience(ctx context.Context, session *location.LiveSession, members []uuid.UUID) ([]uuid.UUID, error) {
+	grants, err := s.projector.LiveAudience(ctx, session.UserID, members, session.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	var audience []uuid.UUID
+	for _, id := range members {
+		if id != session.UserID && grants[id].Location {
+			audience = append(audience, id)
+		}
+	}
+	return audience, nil
+}
+
+func notInLiveScope(session *location.LiveSession) error {
+	if session.CircleID != nil {
+		return circle.ErrNotCircleMember
+	}
+	return conversation.ErrNotParticipant
+}
@@ -0,0 +1,86 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/lock"
+)
+
+const (
+	liveExpiryLockKey   = "lock:location-live-expiry"
+	liveExpiryBatchSize = 100
+)
+
+// LiveExpiryJob tells viewers when live sessions expire, since nothing else
+// notices a session running out. Every replica runs one, but a shared lock
+// ensures only one of them processes a given tick.
+type LiveExpiryJob struct {
+	service  *Service
+	locker   lock.Locker
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewLiveExpiryJob(service *Service, locker lock.Locker, interval time.Duration, logger *slog.Logger) *LiveExpiryJob {
+	return &LiveExpiryJob{
+		service:  service,
+		locker:   locker,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run ends expired sessions every interval until ctx is cancelled.
+func (j *LiveExpiryJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Tick(ctx); err != nil && ctx.Err() == nil {
+			j.logger.Error("failed to end expired live sessions", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick ends expired sessions in batches until none is left. It does nothing
+// when another replica holds the lock.
+func (j *LiveExpiryJob) Tick(ctx context.Context) error {
+	l, err := j.locker.TryAcquire(ctx, liveExpiryLockKey, j.interval)
+	if errors.Is(err, lock.ErrNotAcquired) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := l.Release(context.WithoutCancel(ctx)); err != nil {
+			j.logger.Warn("failed to release live session expiry lock", "error", err)
+		}
+	}()
+
+	var total int
+	for {
+		ended, err := j.service.EndExpiredLiveSessions(ctx, liveExpiryBatchSize)
+		total += ended
+		if err != nil {
+			return err
+		}
+		if ended < liveExpiryBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		j.logger.Info("ended expired live sessions", "sessions", total)
+	}
+	return nil
+}
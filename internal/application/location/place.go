@@ -0,0 +1,149 @@
+package location
+
+import (
+	"context"
+	"errors"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/google/uuid"
+)
+
+func (s *Service) ListPlaces(ctx context.Context, query ListPlacesQuery) ([]*location.Place, error) {
+	return s.repo.ListPlacesByUser(ctx, query.UserID)
+}
+
+func (s *Service) CreatePlace(ctx context.Context, cmd CreatePlaceCommand) (*location.Place, error) {
+	if err := validatePlace(cmd.Type, cmd.Latitude, cmd.Longitude, cmd.Radius); err != nil {
+		return nil, err
+	}
+
+	p := location.NewPlace(cmd.UserID, cmd.Name, cmd.Type, cmd.Latitude, cmd.Longitude, cmd.Radius)
+	p.Address = cmd.Address
+	p.Icon = cmd.Icon
+
+	if err := s.repo.CreatePlace(ctx, p); err != nil {
+		s.logger.Error("failed to create place", "error", err, "user_id", cmd.UserID)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (s *Service) UpdatePlace(ctx context.Context, cmd UpdatePlaceCommand) (*location.Place, error) {
+	p, err := s.placeFor(ctx, cmd.PlaceID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, placeType, lat, lng, radius, address := p.Name, p.Type, p.Latitude, p.Longitude, p.Radius, p.Address
+	if cmd.Name != nil {
+		name = *cmd.Name
+	}
+	if cmd.Type != nil {
+		placeType = *cmd.Type
+	}
+	if cmd.Latitude != nil {
+		lat = *cmd.Latitude
+	}
+	if cmd.Longitude != nil {
+		lng = *cmd.Longitude
+	}
+	if cmd.Radius != nil {
+		radius = *cmd.Radius
+	}
+	if cmd.Address != nil {
+		address = cmd.Address
+	}
+	if err := validatePlace(placeType, lat, lng, radius); err != nil {
+		return nil, err
+	}
+
+	p.Update(name, placeType, lat, lng, radius, address)
+	if cmd.Icon != nil {
+		p.Icon = cmd.Icon
+	}
+
+	if err := s.repo.UpdatePlace(ctx, p); err != nil {
+		s.logger.Error("failed to update place", "error", err, "place_id", p.ID)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// DeletePlace removes the place and its check-ins. If the user is currently
+// there, they are no longer at any place.
+func (s *Service) DeletePlace(ctx context.Context, cmd DeletePlaceCommand) error {
+	var left bool
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		p, err := s.placeFor(ctx, cmd.PlaceID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.DeletePlace(ctx, p.ID); err != nil {
+			return err
+		}
+
+		current, err := s.repo.GetByUserID(ctx, cmd.UserID)
+		if errors.Is(err, location.ErrLocationNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if current.PlaceID == nil || *current.PlaceID != p.ID {
+			return nil
+		}
+
+		updatedAt := current.UpdatedAt
+		current.SetPlace(nil)
+		current.UpdatedAt = updatedAt
+		left = true
+		return s.repo.CreateOrUpdate(ctx, current)
+	})
+	if err != nil {
+		s.logger.Error("failed to delete place", "error", err, "place_id", cmd.PlaceID)
+		return err
+	}
+
+	if left {
+		if err := s.observer.SetCurrentPlace(ctx, cmd.UserID, nil); err != nil {
+			s.logger.Warn("failed to report current place", "error", err, "user_id", cmd.UserID)
+		}
+	}
+
+	return nil
+}
+
+// ListCheckIns returns the user's arrivals and departures, newest first.
+func (s *Service) ListCheckIns(ctx context.Context, query ListCheckInsQuery) ([]*location.CheckIn, error) {
+	limit := query.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	return s.repo.ListCheckInsByUser(ctx, query.UserID, limit)
+}
+
+func (s *Service) placeFor(ctx context.Context, placeID, userID uuid.UUID) (*location.Place, error) {
+	p, err := s.repo.GetPlaceByID(ctx, placeID)
+	if err != nil {
+		return nil, err
+	}
+	if p.UserID != userID {
+		return nil, location.ErrPlaceNotFound
+	}
+	return p, nil
+}
+
+func validatePlace(placeType location.PlaceType, lat, lng, radius float64) error {
+	if !location.IsValidPlaceType(placeType) {
+		return location.ErrInvalidPlaceType
+	}
+	if !location.IsValidCoordinates(lat, lng) {
+		return location.ErrInvalidCoordinates
+	}
+	if !location.IsValidPlaceRadius(radius) {
+		return location.ErrInvalidPlaceRadius
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package location
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ListCircleLocationsQuery struct {
+	UserID   uuid.UUID
+	CircleID uuid.UUID
+}
+
+type ListPlacesQuery struct {
+	UserID uuid.UUID
+}
+
+type ListCheckInsQuery struct {
+	UserID uuid.UUID
+	Limit  int
+}
+
+// ListNearbyMembersQuery looks for members of CircleID, or of any of the
+// user's circles when it is nil, within Radius meters of the user.
+type ListNearbyMembersQuery struct {
+	UserID   uuid.UUID
+	CircleID *uuid.UUID
+	Radius   float64
+}
+
+// GetHistoryQuery covers [From, To). Zero times default to the last day and
+// now; Tolerance is in meters.
+type GetHistoryQuery struct {
+	UserID    uuid.UUID
+	From      time.Time
+	To        time.Time
+	Tolerance float64
+}
+
+// ListLiveSessionsQuery lists the sessions shared with CircleID or
+// ConversationID, or the user's own when both are nil.
+type ListLiveSessionsQuery struct {
+	UserID         uuid.UUID
+	CircleID       *uuid.UUID
+	ConversationID *uuid.UUID
+}
@@ -0,0 +1,85 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/lock"
+)
+
+const retentionLockKey = "lock:location-retention"
+
+// RetentionJob deletes location history past each user's retention. Every
+// replica runs one, but a shared lock ensures only one of them processes a
+// given tick.
+type RetentionJob struct {
+	service   *Service
+	locker    lock.Locker
+	interval  time.Duration
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewRetentionJob(service *Service, locker lock.Locker, interval time.Duration, batchSize int, logger *slog.Logger) *RetentionJob {
+	return &RetentionJob{
+		service:   service,
+		locker:    locker,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run deletes expired history every interval until ctx is cancelled.
+func (j *RetentionJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Tick(ctx); err != nil && ctx.Err() == nil {
+			j.logger.Error("failed to delete expired location history", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick deletes expired history in batches until none is left. It does
+// nothing when another replica holds the lock.
+func (j *RetentionJob) Tick(ctx context.Context) error {
+	l, err := j.locker.TryAcquire(ctx, retentionLockKey, j.interval)
+	if errors.Is(err, lock.ErrNotAcquired) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := l.Release(context.WithoutCancel(ctx)); err != nil {
+			j.logger.Warn("failed to release location retention lock", "error", err)
+		}
+	}()
+
+	var total int64
+	for {
+		deleted, err := j.service.DeleteExpiredHistory(ctx, j.batchSize)
+		total += deleted
+		if err != nil {
+			return err
+		}
+		if deleted < int64(j.batchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		j.logger.Info("deleted expired location history", "points", total)
+	}
+	return nil
+}
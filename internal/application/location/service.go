@@ -0,0 +1,352 @@
+package location
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/notification"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/danielng/kin-core-svc/internal/domain/uow"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	repo             location.Repository
+	liveRepo         location.LiveSessionRepository
+	userRepo         user.Repository
+	circleRepo       circle.Repository
+	conversationRepo conversation.Repository
+	notificationRepo notification.Repository
+	publisher        realtime.Publisher
+	observer         location.PlaceObserver
+	enricher         *Enricher
+	uow              uow.UnitOfWork
+	projector        *privacy.Projector
+	logger           *slog.Logger
+}
+
+func NewService(
+	repo location.Repository,
+	liveRepo location.LiveSessionRepository,
+	userRepo user.Repository,
+	circleRepo circle.Repository,
+	conversationRepo conversation.Repository,
+	notificationRepo notification.Repository,
+	publisher realtime.Publisher,
+	observer location.PlaceObserver,
+	enricher *Enricher,
+	uow uow.UnitOfWork,
+	projector *privacy.Projector,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		repo:             repo,
+		liveRepo:         liveRepo,
+		userRepo:         userRepo,
+		circleRepo:       circleRepo,
+		conversationRepo: conversationRepo,
+		notificationRepo: notificationRepo,
+		publisher:        publisher,
+		observer:         observer,
+		enricher:         enricher,
+		uow:              uow,
+		projector:        projector,
+		logger:           logger,
+	}
+}
+
+func (s *Service) UpdateLocation(ctx context.Context, cmd UpdateLocationCommand) (*location.Location, error) {
+	return s.ingest(ctx, cmd.UserID, []Point{cmd.Point})
+}
+
+// BatchUpdateLocation ingests points a device buffered while it was offline.
+func (s *Service) BatchUpdateLocation(ctx context.Context, cmd BatchUpdateLocationCommand) (*location.Location, error) {
+	if len(cmd.Points) == 0 {
+		return nil, location.ErrEmptyBatch
+	}
+	if len(cmd.Points) > location.MaxBatchSize {
+		return nil, location.ErrBatchTooLarge
+	}
+	return s.ingest(ctx, cmd.UserID, cmd.Points)
+}
+
+// ListCircleLocations returns the last known location of the other members of
+// the circle who share their location with it, coarsened to the precision
+// each of them chose, or exact for members with a live session in the circle.
+// The requester must be a member.
+func (s *Service) ListCircleLocations(ctx context.Context, query ListCircleLocationsQuery) ([]*location.SharedLocation, error) {
+	grants, err := s.projector.CircleGrants(ctx, query.UserID, query.CircleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.liveGrants(ctx, query.UserID, query.CircleID, grants); err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(grants))
+	for id, g := range grants {
+		if id != query.UserID && g.Location {
+			userIDs = append(userIDs, id)
+		}
+	}
+	if len(userIDs) == 0 {
+		return []*location.SharedLocation{}, nil
+	}
+
+	locations, err := s.repo.GetByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	shared := make([]*location.SharedLocation, 0, len(locations))
+	for _, l := range locations {
+		view := grants[l.UserID].Apply(privacy.Subject{UserID: l.UserID, Location: l})
+		shared = append(shared, view.Location)
+	}
+	return shared, nil
+}
+
+// ListNearbyMembers returns the members sharing their location with the user
+// who are within the radius of the user's last known location, nearest first.
+// Members sharing a coarse location are matched on the coarse coordinates, so
+// the search reveals no more than ListCircleLocations does.
+func (s *Service) ListNearbyMembers(ctx context.Context, query ListNearbyMembersQuery) ([]*location.Nearby, error) {
+	radius := query.Radius
+	if radius <= 0 {
+		radius = location.DefaultNearbyRadius
+	}
+	radius = min(radius, location.MaxNearbyRadius)
+
+	var (
+		grants map[uuid.UUID]privacy.Grant
+		err    error
+	)
+	if query.CircleID != nil {
+		grants, err = s.projector.CircleGrants(ctx, query.UserID, *query.CircleID)
+	} else {
+		grants, err = s.projector.MemberGrants(ctx, query.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	origin, err := s.repo.GetByUserID(ctx, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	byPrecision := make(map[location.Precision][]uuid.UUID)
+	for id, g := range grants {
+		if id != query.UserID && g.Location {
+			byPrecision[g.Precision] = append(byPrecision[g.Precision], id)
+		}
+	}
+
+	nearby := []*location.Nearby{}
+	for precision, userIDs := range byPrecision {
+		// Widen the search by how far coarsening may move a member, then
+		// measure from where they appear to be.
+		candidates, err := s.repo.ListNearby(ctx, userIDs, origin.Latitude, origin.Longitude, radius+precision.MaxError())
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range candidates {
+			view := grants[l.UserID].Apply(privacy.Subject{UserID: l.UserID, Location: l})
+			if d := origin.DistanceTo(view.Location.Location); d <= radius {
+				nearby = append(nearby, &location.Nearby{Location: view.Location, Distance: d})
+			}
+		}
+	}
+
+	slices.SortFunc(nearby, func(a, b *location.Nearby) int { return cmp.Compare(a.Distance, b.Distance) })
+	return nearby, nil
+}
+
+// ingest appends every point to the user's history and moves their current
+// location to the most recent one. Points older than the current location,
+// such as a batch uploaded late, only go to history. Arriving at or leaving
+// one of the user's places records a check-in. A new current location is
+// geocoded in the background and streamed to the viewers of the user's live
+// sessions.
+func (s *Service) ingest(ctx context.Context, userID uuid.UUID, points []Point) (*location.Location, error) {
+	now := time.Now()
+	points = slices.Clone(points)
+	for i, p := range points {
+		if !location.IsValidCoordinates(p.Latitude, p.Longitude) {
+			return nil, location.ErrInvalidCoordinates
+		}
+		if p.RecordedAt.IsZero() || p.RecordedAt.After(now) {
+			points[i].RecordedAt = now
+		}
+	}
+	slices.SortStableFunc(points, func(a, b Point) int { return a.RecordedAt.Compare(b.RecordedAt) })
+
+	places, err := s.repo.ListPlacesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		current  *location.Location
+		moved    bool
+		checkIns []*location.CheckIn
+	)
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		current, err = s.repo.GetByUserID(ctx, userID)
+		if errors.Is(err, location.ErrLocationNotFound) {
+			current = nil
+		} else if err != nil {
+			return err
+		}
+
+		for _, p := range points {
+			stale := current != nil && !p.RecordedAt.After(current.UpdatedAt)
+
+			var place *location.Place
+			if stale {
+				place = location.MatchPlace(places, p.Latitude, p.Longitude)
+			} else {
+				var currentPlaceID *uuid.UUID
+				if current != nil {
+					currentPlaceID = current.PlaceID
+				}
+				place = location.ResolvePlace(places, currentPlaceID, p.Latitude, p.Longitude)
+
+				transitions := placeTransitions(userID, places, currentPlaceID, place, p)
+				for _, c := range transitions {
+					if err := s.repo.CreateCheckIn(ctx, c); err != nil {
+						return err
+					}
+				}
+				checkIns = append(checkIns, transitions...)
+			}
+
+			var placeID *uuid.UUID
+			if place != nil {
+				placeID = &place.ID
+			}
+
+			h := location.NewLocationHistory(userID, p.Latitude, p.Longitude, p.Accuracy, placeID)
+			h.CreatedAt = p.RecordedAt
+			if err := s.repo.CreateHistory(ctx, h); err != nil {
+				return err
+			}
+
+			if stale {
+				continue
+			}
+			if current == nil {
+				current = location.NewLocation(userID, p.Latitude, p.Longitude)
+			}
+			current.Update(p.Latitude, p.Longitude, p.Accuracy, p.Altitude, p.Speed, p.Heading)
+			current.SetPlace(placeID)
+			current.UpdatedAt = p.RecordedAt
+			moved = true
+		}
+
+		if !moved {
+			return nil
+		}
+		return s.repo.CreateOrUpdate(ctx, current)
+	})
+	if err != nil {
+		s.logger.Error("failed to update location", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	if moved {
+		if err := s.observer.SetCurrentPlace(ctx, userID, current.PlaceID); err != nil {
+			s.logger.Warn("failed to report current place", "error", err, "user_id", userID)
+		}
+		s.enricher.Enqueue(current)
+		s.streamLive(ctx, current)
+	}
+
+	// A late batch may hold several transitions; only the latest is news.
+	if len(checkIns) > 0 {
+		s.notifyCheckIn(ctx, checkIns[len(checkIns)-1], places)
+	}
+
+	return current, nil
+}
+
+// placeTransitions returns the automatic check-ins for moving from the place
+// with fromID to the place to: a departure, an arrival or both. A place that
+// no longer exists is left without a departure.
+func placeTransitions(userID uuid.UUID, places []*location.Place, fromID *uuid.UUID, to *location.Place, p Point) []*location.CheckIn {
+	var checkIns []*location.CheckIn
+	if fromID != nil && (to == nil || to.ID != *fromID) {
+		if slices.ContainsFunc(places, func(place *location.Place) bool { return place.ID == *fromID }) {
+			c := location.NewCheckIn(userID, *fromID, location.CheckInTypeDeparture, p.Latitude, p.Longitude, true)
+			c.CreatedAt = p.RecordedAt
+			checkIns = append(checkIns, c)
+		}
+	}
+	if to != nil && (fromID == nil || to.ID != *fromID) {
+		c := location.NewCheckIn(userID, to.ID, location.CheckInTypeArrival, p.Latitude, p.Longitude, true)
+		c.CreatedAt = p.RecordedAt
+		checkIns = append(checkIns, c)
+	}
+	return checkIns
+}
+
+// notifyCheckIn tells the circle members who see the user's exact location,
+// and have check-in notifications on, where the user arrived or left.
+func (s *Service) notifyCheckIn(ctx context.Context, c *location.CheckIn, places []*location.Place) {
+	i := slices.IndexFunc(places, func(p *location.Place) bool { return p.ID == c.PlaceID })
+	if i < 0 {
+		return
+	}
+	place := places[i]
+
+	u, err := s.userRepo.GetByID(ctx, c.UserID)
+	if err != nil {
+		s.logger.Error("failed to load user for check-in notification", "error", err, "user_id", c.UserID)
+		return
+	}
+
+	grants, err := s.projector.CircleAudience(ctx, c.UserID)
+	if err != nil {
+		s.logger.Error("failed to resolve check-in audience", "error", err, "user_id", c.UserID)
+		return
+	}
+
+	for viewerID, g := range grants {
+		if viewerID == c.UserID || !g.Location || g.Precision != location.PrecisionExact {
+			continue
+		}
+
+		prefs, err := s.notificationRepo.GetPreferences(ctx, viewerID)
+		if errors.Is(err, notification.ErrPreferencesNotFound) {
+			prefs = notification.NewNotificationPreferences(viewerID)
+		} else if err != nil {
+			s.logger.Error("failed to load notification preferences", "error", err, "user_id", viewerID)
+			continue
+		}
+		if !prefs.IsNotificationEnabled(notification.NotificationTypeCheckIn, true) {
+			continue
+		}
+
+		var n *notification.Notification
+		if c.IsArrival() {
+			n = notification.NewCheckInNotification(viewerID, u.DisplayName, place.Name, c.ID)
+		} else {
+			n = notification.NewDepartureNotification(viewerID, u.DisplayName, place.Name, c.ID)
+		}
+		if err := s.notificationRepo.Create(ctx, n); err != nil {
+			s.logger.Error("failed to create check-in notification", "error", err, "user_id", viewerID)
+			continue
+		}
+		_ = s.publisher.PublishToUsers(ctx, []uuid.UUID{viewerID}, realtime.NewNotificationEvent(n, c.UserID))
+	}
+}
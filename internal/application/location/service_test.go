@@ -0,0 +1,254 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type passUoW struct{}
+
+func (passUoW) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeLocations struct {
+	location.Repository
+	current map[uuid.UUID]*location.Location
+	history []*location.LocationHistory
+}
+
+func newFakeLocations() *fakeLocations {
+	return &fakeLocations{current: make(map[uuid.UUID]*location.Location)}
+}
+
+func (r *fakeLocations) GetByUserID(ctx context.Context, userID uuid.UUID) (*location.Location, error) {
+	l, ok := r.current[userID]
+	if !ok {
+		return nil, location.ErrLocationNotFound
+	}
+	c := *l
+	return &c, nil
+}
+
+func (r *fakeLocations) GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*location.Location, error) {
+	var locations []*location.Location
+	for _, id := range userIDs {
+		if l, ok := r.current[id]; ok {
+			c := *l
+			locations = append(locations, &c)
+		}
+	}
+	return locations, nil
+}
+
+func (r *fakeLocations) CreateOrUpdate(ctx context.Context, l *location.Location) error {
+	r.current[l.UserID] = l
+	return nil
+}
+
+func (r *fakeLocations) CreateHistory(ctx context.Context, h *location.LocationHistory) error {
+	r.history = append(r.history, h)
+	return nil
+}
+
+func (r *fakeLocations) ListPlacesByUser(ctx context.Context, userID uuid.UUID) ([]*location.Place, error) {
+	return nil, nil
+}
+
+type fakeLiveSessions struct {
+	location.LiveSessionRepository
+}
+
+func (fakeLiveSessions) ListByUser(ctx context.Context, userID uuid.UUID) ([]*location.LiveSession, error) {
+	return nil, nil
+}
+
+func (fakeLiveSessions) ListByCircle(ctx context.Context, circleID uuid.UUID) ([]*location.LiveSession, error) {
+	return nil, nil
+}
+
+type fakeObserver struct{}
+
+func (fakeObserver) SetCurrentPlace(ctx context.Context, userID uuid.UUID, placeID *uuid.UUID) error {
+	return nil
+}
+
+type fakeUsers struct {
+	user.Repository
+}
+
+func (fakeUsers) GetPreferences(ctx context.Context, userID uuid.UUID) (*user.Preferences, error) {
+	return nil, user.ErrPreferencesNotFound
+}
+
+// fakeCircles holds one circle, whose members share with it as prefs says.
+type fakeCircles struct {
+	circle.Repository
+	circleID uuid.UUID
+	members  []uuid.UUID
+	prefs    map[uuid.UUID]*circle.SharingPreference
+}
+
+func (r *fakeCircles) IsMember(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
+	return circleID == r.circleID && slices.Contains(r.members, userID), nil
+}
+
+func (r *fakeCircles) ListMembers(ctx context.Context, circleID uuid.UUID) ([]*circle.Member, error) {
+	var members []*circle.Member
+	for _, id := range r.members {
+		members = append(members, &circle.Member{CircleID: circleID, UserID: id})
+	}
+	return members, nil
+}
+
+func (r *fakeCircles) GetSharingPreference(ctx context.Context, circleID, userID uuid.UUID) (*circle.SharingPreference, error) {
+	pref, ok := r.prefs[userID]
+	if !ok || pref.CircleID != circleID {
+		return nil, circle.ErrSharingPreferenceNotFound
+	}
+	return pref, nil
+}
+
+type fakeBlocks struct {
+	contact.Repository
+}
+
+func (fakeBlocks) ListBlockRelations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+func newTestService(circles *fakeCircles) (*Service, *fakeLocations) {
+	repo := newFakeLocations()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	projector := privacy.NewProjector(circles, fakeUsers{}, contact.NewBlockPolicy(fakeBlocks{}))
+	enricher := NewEnricher(repo, nil, 10, logger)
+	return NewService(repo, fakeLiveSessions{}, fakeUsers{}, circles, nil, nil, nil, fakeObserver{}, enricher, passUoW{}, projector, logger), repo
+}
+
+func TestBatchUpdateLocation(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	now := time.Now().Truncate(time.Second)
+
+	errTests := []struct {
+		name    string
+		points  []Point
+		wantErr error
+	}{
+		{name: "empty", wantErr: location.ErrEmptyBatch},
+		{name: "too large", points: make([]Point, location.MaxBatchSize+1), wantErr: location.ErrBatchTooLarge},
+		{
+			name:    "invalid coordinates",
+			points:  []Point{{Latitude: 1, Longitude: 2, RecordedAt: now}, {Latitude: 91, Longitude: 0, RecordedAt: now}},
+			wantErr: location.ErrInvalidCoordinates,
+		},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo := newTestService(&fakeCircles{})
+			_, err := svc.BatchUpdateLocation(ctx, BatchUpdateLocationCommand{UserID: userID, Points: tt.points})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("BatchUpdateLocation error = %v, want %v", err, tt.wantErr)
+			}
+			if len(repo.history) != 0 || len(repo.current) != 0 {
+				t.Errorf("a rejected batch stored %d points", len(repo.history))
+			}
+		})
+	}
+
+	t.Run("moves to the latest point and keeps the rest as history", func(t *testing.T) {
+		svc, repo := newTestService(&fakeCircles{})
+		points := []Point{
+			{Latitude: 10, Longitude: 10, RecordedAt: now.Add(-time.Minute)},
+			{Latitude: 30, Longitude: 30, RecordedAt: now},
+			{Latitude: 20, Longitude: 20, RecordedAt: now.Add(-2 * time.Minute)},
+		}
+		got, err := svc.BatchUpdateLocation(ctx, BatchUpdateLocationCommand{UserID: userID, Points: points})
+		if err != nil {
+			t.Fatalf("BatchUpdateLocation: %v", err)
+		}
+		if got.Latitude != 30 || !got.UpdatedAt.Equal(now) {
+			t.Errorf("current = %v at %v, want 30 at %v", got.Latitude, got.UpdatedAt, now)
+		}
+		if len(repo.history) != len(points) {
+			t.Errorf("recorded %d history points, want %d", len(repo.history), len(points))
+		}
+
+		// A point older than the current location only goes to history.
+		late := Point{Latitude: 40, Longitude: 40, RecordedAt: now.Add(-time.Hour)}
+		got, err = svc.UpdateLocation(ctx, UpdateLocationCommand{UserID: userID, Point: late})
+		if err != nil {
+			t.Fatalf("UpdateLocation: %v", err)
+		}
+		if got.Latitude != 30 {
+			t.Errorf("a late point moved the current location to %v", got.Latitude)
+		}
+		if len(repo.history) != len(points)+1 {
+			t.Errorf("recorded %d history points, want %d", len(repo.history), len(points)+1)
+		}
+	})
+}
+
+func TestListCircleLocations(t *testing.T) {
+	ctx := context.Background()
+	alice, bob, carol, dave := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// Bob shares his city and Carol her exact location; Dave shares nothing.
+	circleID := uuid.New()
+	share := func(userID uuid.UUID, precision location.Precision) *circle.SharingPreference {
+		pref := circle.NewSharingPreference(circleID, userID)
+		pref.PrivacyLevel = user.PrivacyLevelLocation
+		pref.ShareLocation = true
+		pref.LocationPrecision = precision
+		return pref
+	}
+	circles := &fakeCircles{
+		circleID: circleID,
+		members:  []uuid.UUID{alice, bob, carol, dave},
+		prefs: map[uuid.UUID]*circle.SharingPreference{
+			bob:   share(bob, location.PrecisionCity),
+			carol: share(carol, location.PrecisionExact),
+		},
+	}
+	svc, repo := newTestService(circles)
+	for _, id := range circles.members {
+		repo.current[id] = location.NewLocation(id, 52.520008, 13.404954)
+	}
+
+	if _, err := svc.ListCircleLocations(ctx, ListCircleLocationsQuery{UserID: uuid.New(), CircleID: circleID}); !errors.Is(err, circle.ErrNotCircleMember) {
+		t.Fatalf("ListCircleLocations by a non-member: error = %v, want %v", err, circle.ErrNotCircleMember)
+	}
+
+	shared, err := svc.ListCircleLocations(ctx, ListCircleLocationsQuery{UserID: alice, CircleID: circleID})
+	if err != nil {
+		t.Fatalf("ListCircleLocations: %v", err)
+	}
+
+	got := make(map[uuid.UUID]*location.SharedLocation)
+	for _, s := range shared {
+		got[s.Location.UserID] = s
+	}
+	if len(got) != 2 || got[bob] == nil || got[carol] == nil {
+		t.Fatalf("shared locations of %v, want bob and carol only", got)
+	}
+
+	wantLat, wantLng := location.FuzzLocation(52.520008, 13.404954, location.PrecisionCity)
+	if b := got[bob]; b.Precision != location.PrecisionCity || b.Location.Latitude != wantLat || b.Location.Longitude != wantLng {
+		t.Errorf("bob = %v (%v, %v), want city (%v, %v)", b.Precision, b.Location.Latitude, b.Location.Longitude, wantLat, wantLng)
+	}
+	if c := got[carol]; c.Precision != location.PrecisionExact || c.Location.Latitude != 52.520008 {
+		t.Errorf("carol = %v (%v), want her exact location", c.Precision, c.Location.Latitude)
+	}
+}
@@ -3,6 +3,7 @@ package messaging
 import (
 	"time"
 
+	"github.com/danielng/kin-core-svc/internal/domain/messaging"
 	"github.com/google/uuid"
 )
 
@@ -14,7 +15,7 @@ type GetMessageQuery struct {
 type ListMessagesQuery struct {
 	ConversationID uuid.UUID
 	UserID         uuid.UUID // For permission check
-	Cursor         *time.Time
+	Cursor         *messaging.Cursor
 	Limit          int
 }
 
@@ -4,14 +4,18 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
 	"github.com/danielng/kin-core-svc/internal/domain/conversation"
 	"github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
 	"github.com/google/uuid"
 )
 
 type Service struct {
 	messageRepo      messaging.Repository
 	conversationRepo conversation.Repository
+	publisher        realtime.Publisher
+	blockPolicy      *contact.BlockPolicy
 	logger           *slog.Logger
 	editWindowMins   int
 }
@@ -19,11 +23,15 @@ type Service struct {
 func NewService(
 	messageRepo messaging.Repository,
 	conversationRepo conversation.Repository,
+	publisher realtime.Publisher,
+	blockPolicy *contact.BlockPolicy,
 	logger *slog.Logger,
 ) *Service {
 	return &Service{
 		messageRepo:      messageRepo,
 		conversationRepo: conversationRepo,
+		publisher:        publisher,
+		blockPolicy:      blockPolicy,
 		logger:           logger,
 		editWindowMins:   15, // 15 minute edit window
 	}
@@ -42,6 +50,24 @@ func (s *Service) SendMessage(ctx context.Context, cmd SendMessageCommand) (*mes
 		return nil, messaging.ErrInvalidContentType
 	}
 
+	conv, err := s.conversationRepo.GetByID(ctx, cmd.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := s.conversationRepo.ListActiveParticipants(ctx, cmd.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if conv.IsDirect() {
+		for _, p := range participants {
+			if err := s.blockPolicy.Check(ctx, cmd.SenderID, p.UserID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	msg := messaging.NewMessage(cmd.ConversationID, cmd.SenderID, cmd.Content)
 	if cmd.ReplyToID != nil {
 		msg.SetReplyTo(*cmd.ReplyToID)
@@ -52,25 +78,22 @@ func (s *Service) SendMessage(ctx context.Context, cmd SendMessageCommand) (*mes
 		return nil, err
 	}
 
-	conv, err := s.conversationRepo.GetByID(ctx, cmd.ConversationID)
-	if err == nil {
-		conv.UpdateLastMessage(msg.ID)
-		if err := s.conversationRepo.Update(ctx, conv); err != nil {
-			s.logger.Error("failed to update conversation last message", "error", err)
-		}
+	conv.UpdateLastMessage(msg.ID)
+	if err := s.conversationRepo.Update(ctx, conv); err != nil {
+		s.logger.Error("failed to update conversation last message", "error", err)
 	}
 
-	participants, err := s.conversationRepo.ListActiveParticipants(ctx, cmd.ConversationID)
-	if err == nil {
-		for _, p := range participants {
-			if p.UserID != cmd.SenderID {
-				receipt := messaging.NewReceipt(msg.ID, p.UserID)
-				if err := s.messageRepo.CreateReceipt(ctx, receipt); err != nil {
-					s.logger.Error("failed to create receipt", "error", err, "user_id", p.UserID)
-				}
+	recipients := make([]uuid.UUID, 0, len(participants))
+	for _, p := range participants {
+		recipients = append(recipients, p.UserID)
+		if p.UserID != cmd.SenderID {
+			receipt := messaging.NewReceipt(msg.ID, p.UserID)
+			if err := s.messageRepo.CreateReceipt(ctx, receipt); err != nil {
+				s.logger.Error("failed to create receipt", "error", err, "user_id", p.UserID)
 			}
 		}
 	}
+	_ = s.publisher.PublishToUsers(ctx, recipients, realtime.NewMessageEvent(realtime.EventTypeMessageCreated, msg, cmd.SenderID))
 
 	s.logger.Info("message sent", "message_id", msg.ID, "conversation_id", cmd.ConversationID)
 	return msg, nil
@@ -107,7 +130,7 @@ func (s *Service) ListMessages(ctx context.Context, query ListMessagesQuery) ([]
 		limit = 50
 	}
 
-	return s.messageRepo.ListByConversation(ctx, query.ConversationID, query.Cursor, limit)
+	return s.messageRepo.ListByConversation(ctx, query.ConversationID, query.UserID, query.Cursor, limit)
 }
 
 func (s *Service) EditMessage(ctx context.Context, cmd EditMessageCommand) (*messaging.Message, error) {
@@ -131,6 +154,8 @@ func (s *Service) EditMessage(ctx context.Context, cmd EditMessageCommand) (*mes
 		return nil, err
 	}
 
+	s.publish(ctx, msg.ConversationID, realtime.NewMessageEvent(realtime.EventTypeMessageUpdated, msg, cmd.UserID))
+
 	return msg, nil
 }
 
@@ -149,12 +174,22 @@ func (s *Service) DeleteMessage(ctx context.Context, cmd DeleteMessageCommand) e
 			s.logger.Error("failed to delete message for everyone", "error", err)
 			return err
 		}
+		s.publish(ctx, msg.ConversationID, realtime.NewMessageDeletedEvent(msg, cmd.UserID))
 	} else {
+		isParticipant, err := s.conversationRepo.IsParticipant(ctx, msg.ConversationID, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		if !isParticipant {
+			return conversation.ErrNotParticipant
+		}
+
 		deletion := messaging.NewMessageDeletion(cmd.MessageID, cmd.UserID)
 		if err := s.messageRepo.CreateDeletion(ctx, deletion); err != nil {
 			s.logger.Error("failed to delete message for user", "error", err)
 			return err
 		}
+		_ = s.publisher.PublishToUsers(ctx, []uuid.UUID{cmd.UserID}, realtime.NewMessageDeletedEvent(msg, cmd.UserID))
 	}
 
 	return nil
@@ -185,14 +220,31 @@ func (s *Service) AddReaction(ctx context.Context, cmd AddReactionCommand) (*mes
 		return nil, err
 	}
 
+	s.publish(ctx, msg.ConversationID, realtime.NewReactionEvent(realtime.EventTypeReactionAdded, reaction, msg.ConversationID))
 	return reaction, nil
 }
 
 func (s *Service) RemoveReaction(ctx context.Context, cmd RemoveReactionCommand) error {
+	msg, err := s.messageRepo.GetByID(ctx, cmd.MessageID)
+	if err != nil {
+		return err
+	}
+
+	isParticipant, err := s.conversationRepo.IsParticipant(ctx, msg.ConversationID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+	if !isParticipant {
+		return conversation.ErrNotParticipant
+	}
+
 	if err := s.messageRepo.DeleteUserReaction(ctx, cmd.MessageID, cmd.UserID, cmd.Emoji); err != nil {
 		s.logger.Error("failed to remove reaction", "error", err)
 		return err
 	}
+
+	reaction := &messaging.Reaction{MessageID: cmd.MessageID, UserID: cmd.UserID, Emoji: cmd.Emoji}
+	s.publish(ctx, msg.ConversationID, realtime.NewReactionEvent(realtime.EventTypeReactionRemoved, reaction, msg.ConversationID))
 	return nil
 }
 
@@ -218,13 +270,28 @@ func (s *Service) MarkAsRead(ctx context.Context, cmd MarkAsReadCommand) error {
 	if err != nil {
 		return err
 	}
+	if msg.ConversationID != cmd.ConversationID {
+		return messaging.ErrMessageNotFound
+	}
+
+	isParticipant, err := s.conversationRepo.IsParticipant(ctx, cmd.ConversationID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+	if !isParticipant {
+		return conversation.ErrNotParticipant
+	}
 
-	messages, err := s.messageRepo.ListByConversation(ctx, cmd.ConversationID, &msg.CreatedAt, 1000)
+	messages, err := s.messageRepo.ListByConversation(ctx, cmd.ConversationID, cmd.UserID, &messaging.Cursor{CreatedAt: msg.CreatedAt, ID: msg.ID}, 1000)
 	if err != nil {
 		return err
 	}
 
-	messageIDs := make([]uuid.UUID, 0, len(messages))
+	// The cursor is exclusive, so the boundary message itself is added explicitly.
+	messageIDs := make([]uuid.UUID, 0, len(messages)+1)
+	if msg.SenderID != cmd.UserID {
+		messageIDs = append(messageIDs, msg.ID)
+	}
 	for _, m := range messages {
 		if m.SenderID != cmd.UserID {
 			messageIDs = append(messageIDs, m.ID)
@@ -236,6 +303,19 @@ func (s *Service) MarkAsRead(ctx context.Context, cmd MarkAsReadCommand) error {
 			s.logger.Error("failed to mark messages as read", "error", err)
 			return err
 		}
+		s.publish(ctx, cmd.ConversationID, realtime.NewReceiptEvent(realtime.EventTypeReceiptRead, cmd.UserID, cmd.ConversationID, messageIDs))
+	}
+
+	participant, err := s.conversationRepo.GetParticipant(ctx, cmd.ConversationID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+	if participant.LastReadAt == nil || participant.LastReadAt.Before(msg.CreatedAt) {
+		participant.MarkAsRead(msg.CreatedAt)
+		if err := s.conversationRepo.UpdateParticipant(ctx, participant); err != nil {
+			s.logger.Error("failed to update participant read position", "error", err)
+			return err
+		}
 	}
 
 	return nil
@@ -246,11 +326,47 @@ func (s *Service) MarkAsDelivered(ctx context.Context, cmd MarkAsDeliveredComman
 		return nil
 	}
 
-	if err := s.messageRepo.BulkUpdateReceiptsDelivered(ctx, cmd.MessageIDs, cmd.UserID); err != nil {
+	messages, err := s.messageRepo.GetByIDs(ctx, cmd.MessageIDs)
+	if err != nil {
+		return err
+	}
+
+	byConversation := make(map[uuid.UUID][]uuid.UUID)
+	for _, m := range messages {
+		byConversation[m.ConversationID] = append(byConversation[m.ConversationID], m.ID)
+	}
+	conversationIDs := make([]uuid.UUID, 0, len(byConversation))
+	for id := range byConversation {
+		conversationIDs = append(conversationIDs, id)
+	}
+
+	// Messages from conversations the user is not in are dropped, so that no
+	// one can send receipts into them.
+	participants, err := s.conversationRepo.ListParticipantsByUser(ctx, cmd.UserID, conversationIDs)
+	if err != nil {
+		return err
+	}
+	var active []uuid.UUID
+	var messageIDs []uuid.UUID
+	for _, p := range participants {
+		if p.IsActive() {
+			active = append(active, p.ConversationID)
+			messageIDs = append(messageIDs, byConversation[p.ConversationID]...)
+		}
+	}
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	if err := s.messageRepo.BulkUpdateReceiptsDelivered(ctx, messageIDs, cmd.UserID); err != nil {
 		s.logger.Error("failed to mark messages as delivered", "error", err)
 		return err
 	}
 
+	for _, conversationID := range active {
+		s.publish(ctx, conversationID, realtime.NewReceiptEvent(realtime.EventTypeReceiptDelivered, cmd.UserID, conversationID, byConversation[conversationID]))
+	}
+
 	return nil
 }
 
@@ -274,3 +390,11 @@ func (s *Service) SearchMessages(ctx context.Context, query SearchMessagesQuery)
 func (s *Service) GetUnreadCount(ctx context.Context, query GetUnreadCountQuery) (int64, error) {
 	return s.messageRepo.CountUnreadByUser(ctx, query.ConversationID, query.UserID, query.Since)
 }
+
+// publish delivers a realtime event on a best-effort basis; the change itself
+// has already been persisted.
+func (s *Service) publish(ctx context.Context, conversationID uuid.UUID, event *realtime.Event) {
+	if err := s.publisher.PublishToConversation(ctx, conversationID, event); err != nil {
+		s.logger.Warn("failed to publish realtime event", "error", err, "event_type", event.Type)
+	}
+}
@@ -0,0 +1,194 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/google/uuid"
+)
+
+// fakeMessages stores messages in memory. Methods the tests do not need are
+// left to the embedded interface and panic if called.
+type fakeMessages struct {
+	messaging.Repository
+	messages         map[uuid.UUID]*messaging.Message
+	deletions        []*messaging.MessageDeletion
+	reactions        []*messaging.Reaction
+	removedReactions int
+}
+
+func (r *fakeMessages) GetByID(ctx context.Context, id uuid.UUID) (*messaging.Message, error) {
+	msg, ok := r.messages[id]
+	if !ok {
+		return nil, messaging.ErrMessageNotFound
+	}
+	return msg, nil
+}
+
+func (r *fakeMessages) Update(ctx context.Context, msg *messaging.Message) error {
+	r.messages[msg.ID] = msg
+	return nil
+}
+
+func (r *fakeMessages) CreateDeletion(ctx context.Context, d *messaging.MessageDeletion) error {
+	r.deletions = append(r.deletions, d)
+	return nil
+}
+
+func (r *fakeMessages) GetReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) (*messaging.Reaction, error) {
+	return nil, messaging.ErrMessageNotFound
+}
+
+func (r *fakeMessages) CreateReaction(ctx context.Context, reaction *messaging.Reaction) error {
+	r.reactions = append(r.reactions, reaction)
+	return nil
+}
+
+func (r *fakeMessages) DeleteUserReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) error {
+	r.removedReactions++
+	return nil
+}
+
+type fakeConversations struct {
+	conversation.Repository
+	participants map[uuid.UUID][]uuid.UUID
+}
+
+func (r *fakeConversations) IsParticipant(ctx context.Context, conversationID, userID uuid.UUID) (bool, error) {
+	for _, id := range r.participants[conversationID] {
+		if id == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fakePublisher records the events published.
+type fakePublisher struct {
+	events []*realtime.Event
+}
+
+func (p *fakePublisher) PublishToUsers(ctx context.Context, userIDs []uuid.UUID, event *realtime.Event) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *fakePublisher) PublishToConversation(ctx context.Context, conversationID uuid.UUID, event *realtime.Event) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *fakePublisher) PublishToCircle(ctx context.Context, circleID uuid.UUID, event *realtime.Event) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+type fixture struct {
+	service       *Service
+	messages      *fakeMessages
+	publisher     *fakePublisher
+	msg           *messaging.Message
+	sender, other uuid.UUID
+	outsider      uuid.UUID
+}
+
+// newFixture returns a service with one message sent by sender in a
+// conversation with other; outsider is in no conversation.
+func newFixture() *fixture {
+	f := &fixture{sender: uuid.New(), other: uuid.New(), outsider: uuid.New()}
+	conversationID := uuid.New()
+	f.msg = messaging.NewMessage(conversationID, f.sender, messaging.NewTextContent("hi"))
+	f.messages = &fakeMessages{messages: map[uuid.UUID]*messaging.Message{f.msg.ID: f.msg}}
+	f.publisher = &fakePublisher{}
+	conversations := &fakeConversations{participants: map[uuid.UUID][]uuid.UUID{
+		conversationID: {f.sender, f.other},
+	}}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f.service = NewService(f.messages, conversations, f.publisher, nil, logger)
+	return f
+}
+
+func TestDeleteMessage(t *testing.T) {
+	tests := []struct {
+		name          string
+		user          func(f *fixture) uuid.UUID
+		forEveryone   bool
+		wantErr       error
+		wantDeletions int
+	}{
+		{name: "participant deletes for themselves", user: func(f *fixture) uuid.UUID { return f.other }, wantDeletions: 1},
+		{name: "outsider cannot delete for themselves", user: func(f *fixture) uuid.UUID { return f.outsider }, wantErr: conversation.ErrNotParticipant},
+		{name: "sender deletes for everyone", user: func(f *fixture) uuid.UUID { return f.sender }, forEveryone: true},
+		{name: "others cannot delete for everyone", user: func(f *fixture) uuid.UUID { return f.other }, forEveryone: true, wantErr: messaging.ErrNotMessageSender},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			err := f.service.DeleteMessage(context.Background(), DeleteMessageCommand{
+				MessageID:   f.msg.ID,
+				UserID:      tt.user(f),
+				ForEveryone: tt.forEveryone,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteMessage error = %v, want %v", err, tt.wantErr)
+			}
+			if len(f.messages.deletions) != tt.wantDeletions {
+				t.Errorf("got %d deletions, want %d", len(f.messages.deletions), tt.wantDeletions)
+			}
+			if wantEvents := btoi(tt.wantErr == nil); len(f.publisher.events) != wantEvents {
+				t.Errorf("published %d events, want %d", len(f.publisher.events), wantEvents)
+			}
+		})
+	}
+}
+
+func TestReactionsRequireParticipant(t *testing.T) {
+	t.Run("add", func(t *testing.T) {
+		f := newFixture()
+		_, err := f.service.AddReaction(context.Background(), AddReactionCommand{MessageID: f.msg.ID, UserID: f.outsider, Emoji: "👍"})
+		if !errors.Is(err, conversation.ErrNotParticipant) {
+			t.Fatalf("AddReaction error = %v, want %v", err, conversation.ErrNotParticipant)
+		}
+		if len(f.messages.reactions) != 0 || len(f.publisher.events) != 0 {
+			t.Errorf("outsider's reaction was stored or published")
+		}
+	})
+
+	tests := []struct {
+		name    string
+		user    func(f *fixture) uuid.UUID
+		wantErr error
+	}{
+		{name: "participant removes their reaction", user: func(f *fixture) uuid.UUID { return f.other }},
+		{name: "outsider cannot remove a reaction", user: func(f *fixture) uuid.UUID { return f.outsider }, wantErr: conversation.ErrNotParticipant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture()
+			err := f.service.RemoveReaction(context.Background(), RemoveReactionCommand{MessageID: f.msg.ID, UserID: tt.user(f), Emoji: "👍"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RemoveReaction error = %v, want %v", err, tt.wantErr)
+			}
+			ok := btoi(tt.wantErr == nil)
+			if f.messages.removedReactions != ok || len(f.publisher.events) != ok {
+				t.Errorf("removed %d reactions and published %d events, want %d of each",
+					f.messages.removedReactions, len(f.publisher.events), ok)
+			}
+		})
+	}
+}
+
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+
+	"github.com/danielng/kin-core-svc/internal/domain/event"
+)
+
+// MemorySink keeps published events in memory. It is intended for tests and
+// local runs without Redis.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []*event.Event
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (s *MemorySink) Name() string {
+	return "memory"
+}
+
+func (s *MemorySink) Publish(_ context.Context, events []*event.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, events...)
+	return nil
+}
+
+// Events returns a copy of everything published so far.
+func (s *MemorySink) Events() []*event.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*event.Event(nil), s.events...)
+}
+
+func (s *MemorySink) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = nil
+}
+
+var _ event.Sink = (*MemorySink)(nil)
@@ -0,0 +1,110 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/event"
+)
+
+// pruneInterval is how often published events older than the retention are
+// deleted.
+const pruneInterval = 10 * time.Minute
+
+// Relay moves committed events from the outbox to the configured sinks.
+// Events are only marked published once every sink has accepted them, so a
+// failing sink causes redelivery to all sinks on the next poll. Published
+// events are kept for the retention period and then deleted.
+type Relay struct {
+	repo         event.Repository
+	sinks        []event.Sink
+	pollInterval time.Duration
+	batchSize    int
+	retention    time.Duration
+	logger       *slog.Logger
+}
+
+func NewRelay(
+	repo event.Repository,
+	sinks []event.Sink,
+	pollInterval time.Duration,
+	batchSize int,
+	retention time.Duration,
+	logger *slog.Logger,
+) *Relay {
+	return &Relay{
+		repo:         repo,
+		sinks:        sinks,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		retention:    retention,
+		logger:       logger,
+	}
+}
+
+// Run polls the outbox until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	pruneTicker := time.NewTicker(pruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		if err := r.Drain(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Error("failed to relay outbox events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-pruneTicker.C:
+			if err := r.Prune(ctx); err != nil && ctx.Err() == nil {
+				r.logger.Error("failed to prune outbox events", "error", err)
+			}
+		}
+	}
+}
+
+// Prune deletes published events older than the retention period, a batch at
+// a time so that no single statement holds locks for long.
+func (r *Relay) Prune(ctx context.Context) error {
+	before := time.Now().Add(-r.retention)
+	for {
+		n, err := r.repo.DeletePublished(ctx, before, r.batchSize)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			r.logger.Debug("pruned outbox events", "count", n)
+		}
+		if n < r.batchSize {
+			return nil
+		}
+	}
+}
+
+// Drain publishes batches until the outbox is empty.
+func (r *Relay) Drain(ctx context.Context) error {
+	for {
+		n, err := r.repo.Process(ctx, r.batchSize, r.publish)
+		if err != nil {
+			return err
+		}
+		if n < r.batchSize {
+			return nil
+		}
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, events []*event.Event) error {
+	for _, sink := range r.sinks {
+		if err := sink.Publish(ctx, events); err != nil {
+			return fmt.Errorf("sink %s: %w", sink.Name(), err)
+		}
+	}
+	r.logger.Debug("relayed outbox events", "count", len(events))
+	return nil
+}
@@ -0,0 +1,31 @@
+package presence
+
+import (
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"github.com/google/uuid"
+)
+
+// HeartbeatCommand keeps the device online, or away when Away is set.
+// Devices are told apart by their ID, or by their type when they send none.
+type HeartbeatCommand struct {
+	UserID     uuid.UUID
+	DeviceType presence.DeviceType
+	DeviceID   *string
+	Away       bool
+}
+
+type GoOfflineCommand struct {
+	UserID     uuid.UUID
+	DeviceType presence.DeviceType
+	DeviceID   *string
+}
+
+type SetTypingCommand struct {
+	UserID         uuid.UUID
+	ConversationID uuid.UUID
+}
+
+type ClearTypingCommand struct {
+	UserID         uuid.UUID
+	ConversationID uuid.UUID
+}
@@ -0,0 +1,84 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/google/uuid"
+)
+
+// Publisher tells everyone a user shares a circle with when the user's status
+// changes, each as far as they may see it. Both the presence API and the
+// event stream change presence, so they share one to agree on when and to
+// whom presence is published.
+type Publisher struct {
+	presenceRepo presence.Repository
+	broker       realtime.Broker
+	projector    *privacy.Projector
+	logger       *slog.Logger
+}
+
+func NewPublisher(presenceRepo presence.Repository, broker realtime.Broker, projector *privacy.Projector, logger *slog.Logger) *Publisher {
+	return &Publisher{
+		presenceRepo: presenceRepo,
+		broker:       broker,
+		projector:    projector,
+		logger:       logger,
+	}
+}
+
+// Update applies change to the user's devices, given their presence before
+// it, and publishes the presence after it if the user's status changed.
+func (p *Publisher) Update(ctx context.Context, userID uuid.UUID, change func(before *presence.Presence) error) (*presence.Presence, error) {
+	before, err := p.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(before); err != nil {
+		return nil, err
+	}
+
+	after, err := p.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if after.Status != before.Status {
+		p.publish(ctx, after)
+	}
+	return after, nil
+}
+
+// Get returns the user's presence, offline without devices if they have
+// never been seen or were last seen too long ago.
+func (p *Publisher) Get(ctx context.Context, userID uuid.UUID) (*presence.Presence, error) {
+	pr, err := p.presenceRepo.Get(ctx, userID)
+	if errors.Is(err, presence.ErrPresenceNotFound) {
+		return &presence.Presence{UserID: userID, Status: presence.OnlineStatusOffline}, nil
+	}
+	return pr, err
+}
+
+// publish sends the presence to its audience, the user included so that
+// their other devices stay in sync.
+func (p *Publisher) publish(ctx context.Context, pr *presence.Presence) {
+	grants, err := p.projector.CircleAudience(ctx, pr.UserID)
+	if err != nil {
+		p.logger.Error("failed to resolve presence audience", "error", err, "user_id", pr.UserID)
+		return
+	}
+
+	// Viewers may see different amounts, so each gets their own view.
+	for id, g := range grants {
+		view := g.Apply(privacy.Subject{UserID: pr.UserID, Presence: pr})
+		if view.Presence == nil {
+			continue
+		}
+		if err := p.broker.Publish(ctx, []uuid.UUID{id}, realtime.NewPresenceEvent(view.Presence)); err != nil {
+			p.logger.Warn("failed to publish presence", "error", err, "user_id", pr.UserID)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package presence
+
+import "github.com/google/uuid"
+
+type GetPresenceQuery struct {
+	UserID uuid.UUID
+}
+
+type ListCirclePresencesQuery struct {
+	UserID   uuid.UUID
+	CircleID uuid.UUID
+}
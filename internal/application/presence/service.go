@@ -0,0 +1,181 @@
+package presence
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	presenceRepo     presence.Repository
+	conversationRepo conversation.Repository
+	publisher        realtime.Publisher
+	presences        *Publisher
+	projector        *privacy.Projector
+	ttl              time.Duration
+	logger           *slog.Logger
+}
+
+func NewService(
+	presenceRepo presence.Repository,
+	conversationRepo conversation.Repository,
+	publisher realtime.Publisher,
+	presences *Publisher,
+	projector *privacy.Projector,
+	ttl time.Duration,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		presenceRepo:     presenceRepo,
+		conversationRepo: conversationRepo,
+		publisher:        publisher,
+		presences:        presences,
+		projector:        projector,
+		ttl:              ttl,
+		logger:           logger,
+	}
+}
+
+// Heartbeat keeps the device online, or away if the command says so, for
+// another TTL. A device that was offline, or whose presence had expired,
+// comes back, and the user's circles are told whenever that changes the
+// user's status.
+func (s *Service) Heartbeat(ctx context.Context, cmd HeartbeatCommand) (*presence.Presence, error) {
+	p, err := s.presences.Update(ctx, cmd.UserID, func(before *presence.Presence) error {
+		d := presence.NewDevice(cmd.DeviceType, cmd.DeviceID)
+		if existing := before.Device(d.Key()); existing != nil {
+			d = existing
+		}
+		if cmd.Away {
+			d.SetAway()
+		} else {
+			d.SetOnline()
+		}
+		return s.presenceRepo.SetDevice(ctx, cmd.UserID, d, s.ttl)
+	})
+	if err != nil {
+		s.logger.Error("failed to refresh presence", "error", err, "user_id", cmd.UserID)
+		return nil, err
+	}
+	return p, nil
+}
+
+// GoOffline takes the device offline. The user stays online for as long as
+// any of their other devices is.
+func (s *Service) GoOffline(ctx context.Context, cmd GoOfflineCommand) error {
+	_, err := s.presences.Update(ctx, cmd.UserID, func(*presence.Presence) error {
+		return s.presenceRepo.SetOffline(ctx, cmd.UserID, cmd.DeviceType, cmd.DeviceID)
+	})
+	if err != nil {
+		s.logger.Error("failed to set device offline", "error", err, "user_id", cmd.UserID)
+		return err
+	}
+	return nil
+}
+
+// GetPresence returns the user's own presence, including each of their
+// devices.
+func (s *Service) GetPresence(ctx context.Context, query GetPresenceQuery) (*presence.Presence, error) {
+	return s.presences.Get(ctx, query.UserID)
+}
+
+// ListCirclePresences returns the presence of the other members of the
+// circle, as far as each of them lets the requester see it. Members who hide
+// their online status are left out, and those who hide when they were last
+// seen have it cleared. Members never seen online, or whose presence expired,
+// are offline. The requester must be a member.
+func (s *Service) ListCirclePresences(ctx context.Context, query ListCirclePresencesQuery) ([]*presence.Presence, error) {
+	grants, err := s.projector.CircleGrants(ctx, query.UserID, query.CircleID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(grants))
+	for id, g := range grants {
+		if id != query.UserID && g.Presence {
+			userIDs = append(userIDs, id)
+		}
+	}
+	if len(userIDs) == 0 {
+		return []*presence.Presence{}, nil
+	}
+
+	stored, err := s.presenceRepo.GetMultiple(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	byUser := make(map[uuid.UUID]*presence.Presence, len(stored))
+	for _, p := range stored {
+		byUser[p.UserID] = p
+	}
+
+	presences := make([]*presence.Presence, 0, len(userIDs))
+	for _, id := range userIDs {
+		p, ok := byUser[id]
+		switch {
+		case !ok:
+			p = &presence.Presence{UserID: id, Status: presence.OnlineStatusOffline}
+		case p.IsOnline() && p.IsStale(s.ttl):
+			p.Status = presence.OnlineStatusOffline
+		}
+		view := grants[id].Apply(privacy.Subject{UserID: id, Presence: p})
+		presences = append(presences, view.Presence)
+	}
+	return presences, nil
+}
+
+// SetTyping shows the other participants that the user is typing until they
+// clear it or presence.TypingDuration passes without it being set again.
+func (s *Service) SetTyping(ctx context.Context, cmd SetTypingCommand) error {
+	if err := s.checkParticipant(ctx, cmd.ConversationID, cmd.UserID); err != nil {
+		return err
+	}
+
+	indicator := presence.NewTypingIndicator(cmd.UserID, cmd.ConversationID, presence.TypingDuration)
+	if err := s.presenceRepo.SetTyping(ctx, indicator); err != nil {
+		s.logger.Error("failed to set typing indicator", "error", err, "user_id", cmd.UserID)
+		return err
+	}
+
+	s.publish(ctx, cmd.ConversationID, realtime.NewTypingEvent(realtime.EventTypeTypingStarted, cmd.UserID, cmd.ConversationID))
+	return nil
+}
+
+func (s *Service) ClearTyping(ctx context.Context, cmd ClearTypingCommand) error {
+	if err := s.checkParticipant(ctx, cmd.ConversationID, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := s.presenceRepo.ClearTyping(ctx, cmd.UserID, cmd.ConversationID); err != nil {
+		s.logger.Error("failed to clear typing indicator", "error", err, "user_id", cmd.UserID)
+		return err
+	}
+
+	s.publish(ctx, cmd.ConversationID, realtime.NewTypingEvent(realtime.EventTypeTypingStopped, cmd.UserID, cmd.ConversationID))
+	return nil
+}
+
+func (s *Service) checkParticipant(ctx context.Context, conversationID, userID uuid.UUID) error {
+	isParticipant, err := s.conversationRepo.IsParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if !isParticipant {
+		return conversation.ErrNotParticipant
+	}
+	return nil
+}
+
+// publish delivers a realtime event on a best-effort basis; the change itself
+// has already been stored.
+func (s *Service) publish(ctx context.Context, conversationID uuid.UUID, event *realtime.Event) {
+	if err := s.publisher.PublishToConversation(ctx, conversationID, event); err != nil {
+		s.logger.Warn("failed to publish realtime event", "error", err, "event_type", event.Type)
+	}
+}
@@ -0,0 +1,353 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"github.com/danielng/kin-core-svc/internal/domain/privacy"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+const testTTL = time.Minute
+
+// fakePresences keeps each user's devices and aggregates them as the Redis
+// repository does.
+type fakePresences struct {
+	presence.Repository
+	devices  map[uuid.UUID]map[string]*presence.Device
+	lastSeen map[uuid.UUID]time.Time
+	typing   map[[2]uuid.UUID]bool // By user and conversation
+}
+
+func newFakePresences() *fakePresences {
+	return &fakePresences{
+		devices:  make(map[uuid.UUID]map[string]*presence.Device),
+		lastSeen: make(map[uuid.UUID]time.Time),
+		typing:   make(map[[2]uuid.UUID]bool),
+	}
+}
+
+func (r *fakePresences) Get(ctx context.Context, userID uuid.UUID) (*presence.Presence, error) {
+	lastSeen, ok := r.lastSeen[userID]
+	if !ok {
+		return nil, presence.ErrPresenceNotFound
+	}
+	var devices []*presence.Device
+	for _, d := range r.devices[userID] {
+		c := *d
+		devices = append(devices, &c)
+	}
+	return presence.Aggregate(userID, devices, lastSeen), nil
+}
+
+func (r *fakePresences) GetMultiple(ctx context.Context, userIDs []uuid.UUID) ([]*presence.Presence, error) {
+	var presences []*presence.Presence
+	for _, id := range userIDs {
+		if p, err := r.Get(ctx, id); err == nil {
+			presences = append(presences, p)
+		}
+	}
+	return presences, nil
+}
+
+func (r *fakePresences) SetDevice(ctx context.Context, userID uuid.UUID, d *presence.Device, ttl time.Duration) error {
+	if r.devices[userID] == nil {
+		r.devices[userID] = make(map[string]*presence.Device)
+	}
+	r.devices[userID][d.Key()] = d
+	r.lastSeen[userID] = d.LastSeenAt
+	return nil
+}
+
+func (r *fakePresences) SetOffline(ctx context.Context, userID uuid.UUID, deviceType presence.DeviceType, deviceID *string) error {
+	delete(r.devices[userID], presence.DeviceKey(deviceType, deviceID))
+	return nil
+}
+
+func (r *fakePresences) SetTyping(ctx context.Context, indicator *presence.TypingIndicator) error {
+	r.typing[[2]uuid.UUID{indicator.UserID, indicator.ConversationID}] = true
+	return nil
+}
+
+func (r *fakePresences) ClearTyping(ctx context.Context, userID, conversationID uuid.UUID) error {
+	delete(r.typing, [2]uuid.UUID{userID, conversationID})
+	return nil
+}
+
+type fakeConversations struct {
+	conversation.Repository
+	participants map[[2]uuid.UUID]bool // By conversation and user
+}
+
+func (r *fakeConversations) IsParticipant(ctx context.Context, conversationID, userID uuid.UUID) (bool, error) {
+	return r.participants[[2]uuid.UUID{conversationID, userID}], nil
+}
+
+type fakeUsers struct {
+	user.Repository
+	prefs map[uuid.UUID]*user.Preferences
+}
+
+func (r *fakeUsers) GetPreferences(ctx context.Context, userID uuid.UUID) (*user.Preferences, error) {
+	p, ok := r.prefs[userID]
+	if !ok {
+		return nil, user.ErrPreferencesNotFound
+	}
+	return p, nil
+}
+
+// fakeCircles holds one circle in which every member shares basic presence.
+type fakeCircles struct {
+	circle.Repository
+	circleID uuid.UUID
+	members  []uuid.UUID
+}
+
+func (r *fakeCircles) ListByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*circle.Circle, error) {
+	if offset > 0 || !slices.Contains(r.members, userID) {
+		return nil, nil
+	}
+	return []*circle.Circle{{ID: r.circleID}}, nil
+}
+
+func (r *fakeCircles) IsMember(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
+	return circleID == r.circleID && slices.Contains(r.members, userID), nil
+}
+
+func (r *fakeCircles) ListMembers(ctx context.Context, circleID uuid.UUID) ([]*circle.Member, error) {
+	var members []*circle.Member
+	for _, id := range r.members {
+		members = append(members, &circle.Member{CircleID: circleID, UserID: id})
+	}
+	return members, nil
+}
+
+func (r *fakeCircles) basic(userID uuid.UUID) *circle.SharingPreference {
+	pref := circle.NewSharingPreference(r.circleID, userID)
+	pref.PrivacyLevel = user.PrivacyLevelBasic
+	return pref
+}
+
+func (r *fakeCircles) GetSharingPreference(ctx context.Context, circleID, userID uuid.UUID) (*circle.SharingPreference, error) {
+	if circleID != r.circleID || !slices.Contains(r.members, userID) {
+		return nil, circle.ErrSharingPreferenceNotFound
+	}
+	return r.basic(userID), nil
+}
+
+func (r *fakeCircles) ListSharingPreferences(ctx context.Context, userID uuid.UUID) ([]*circle.SharingPreference, error) {
+	if !slices.Contains(r.members, userID) {
+		return nil, nil
+	}
+	return []*circle.SharingPreference{r.basic(userID)}, nil
+}
+
+type fakeBlocks struct {
+	contact.Repository
+}
+
+func (fakeBlocks) ListBlockRelations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+type fakeBroker struct {
+	realtime.Broker
+	events map[uuid.UUID][]*realtime.Event
+}
+
+func (b *fakeBroker) Publish(ctx context.Context, userIDs []uuid.UUID, event *realtime.Event) error {
+	for _, id := range userIDs {
+		b.events[id] = append(b.events[id], event)
+	}
+	return nil
+}
+
+type fakePublisher struct {
+	realtime.Publisher
+	conversations []uuid.UUID
+}
+
+func (p *fakePublisher) PublishToConversation(ctx context.Context, conversationID uuid.UUID, event *realtime.Event) error {
+	p.conversations = append(p.conversations, conversationID)
+	return nil
+}
+
+type fixture struct {
+	svc           *Service
+	presences     *fakePresences
+	conversations *fakeConversations
+	users         *fakeUsers
+	broker        *fakeBroker
+	publisher     *fakePublisher
+}
+
+func newFixture(circles *fakeCircles) *fixture {
+	f := &fixture{
+		presences:     newFakePresences(),
+		conversations: &fakeConversations{participants: make(map[[2]uuid.UUID]bool)},
+		users:         &fakeUsers{prefs: make(map[uuid.UUID]*user.Preferences)},
+		broker:        &fakeBroker{events: make(map[uuid.UUID][]*realtime.Event)},
+		publisher:     &fakePublisher{},
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	projector := privacy.NewProjector(circles, f.users, contact.NewBlockPolicy(fakeBlocks{}))
+	presences := NewPublisher(f.presences, f.broker, projector, logger)
+	f.svc = NewService(f.presences, f.conversations, f.publisher, presences, projector, testTTL, logger)
+	return f
+}
+
+func TestHeartbeatPublishesStatusChanges(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	f := newFixture(&fakeCircles{circleID: uuid.New(), members: []uuid.UUID{alice, bob}})
+
+	steps := []struct {
+		name       string
+		do         func() error
+		wantStatus presence.OnlineStatus
+		published  bool
+	}{
+		{
+			name: "coming online",
+			do: func() error {
+				_, err := f.svc.Heartbeat(ctx, HeartbeatCommand{UserID: alice, DeviceType: presence.DeviceTypeMobile})
+				return err
+			},
+			wantStatus: presence.OnlineStatusOnline, published: true,
+		},
+		{
+			name: "staying online",
+			do: func() error {
+				_, err := f.svc.Heartbeat(ctx, HeartbeatCommand{UserID: alice, DeviceType: presence.DeviceTypeMobile})
+				return err
+			},
+			wantStatus: presence.OnlineStatusOnline,
+		},
+		{
+			name: "going away",
+			do: func() error {
+				_, err := f.svc.Heartbeat(ctx, HeartbeatCommand{UserID: alice, DeviceType: presence.DeviceTypeMobile, Away: true})
+				return err
+			},
+			wantStatus: presence.OnlineStatusAway, published: true,
+		},
+		{
+			name: "going offline",
+			do: func() error {
+				return f.svc.GoOffline(ctx, GoOfflineCommand{UserID: alice, DeviceType: presence.DeviceTypeMobile})
+			},
+			wantStatus: presence.OnlineStatusOffline, published: true,
+		},
+	}
+
+	for _, step := range steps {
+		before := len(f.broker.events[bob])
+		if err := step.do(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+
+		p, err := f.svc.GetPresence(ctx, GetPresenceQuery{UserID: alice})
+		if err != nil {
+			t.Fatalf("%s: GetPresence: %v", step.name, err)
+		}
+		if p.Status != step.wantStatus {
+			t.Errorf("%s: status = %q, want %q", step.name, p.Status, step.wantStatus)
+		}
+		if published := len(f.broker.events[bob]) > before; published != step.published {
+			t.Errorf("%s: published to the circle = %v, want %v", step.name, published, step.published)
+		}
+	}
+}
+
+func TestListCirclePresences(t *testing.T) {
+	ctx := context.Background()
+	alice, bob, carol, dave, erin := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	circles := &fakeCircles{circleID: uuid.New(), members: []uuid.UUID{alice, bob, carol, dave, erin}}
+	f := newFixture(circles)
+
+	// Carol hides her online status and Dave when he was last seen. Erin has
+	// never been online.
+	hidden := user.NewPreferences(carol)
+	hidden.ShowOnlineStatus = false
+	f.users.prefs[carol] = hidden
+	noLastSeen := user.NewPreferences(dave)
+	noLastSeen.ShowLastSeen = false
+	f.users.prefs[dave] = noLastSeen
+
+	for _, id := range []uuid.UUID{alice, bob, carol, dave} {
+		if _, err := f.svc.Heartbeat(ctx, HeartbeatCommand{UserID: id, DeviceType: presence.DeviceTypeWeb}); err != nil {
+			t.Fatalf("Heartbeat: %v", err)
+		}
+	}
+
+	if _, err := f.svc.ListCirclePresences(ctx, ListCirclePresencesQuery{UserID: uuid.New(), CircleID: circles.circleID}); !errors.Is(err, circle.ErrNotCircleMember) {
+		t.Fatalf("ListCirclePresences by a non-member: error = %v, want %v", err, circle.ErrNotCircleMember)
+	}
+
+	list, err := f.svc.ListCirclePresences(ctx, ListCirclePresencesQuery{UserID: alice, CircleID: circles.circleID})
+	if err != nil {
+		t.Fatalf("ListCirclePresences: %v", err)
+	}
+	got := make(map[uuid.UUID]*presence.Presence)
+	for _, p := range list {
+		got[p.UserID] = p
+	}
+
+	if len(got) != 3 || got[alice] != nil || got[carol] != nil {
+		t.Fatalf("listed %d presences, want bob, dave and erin", len(got))
+	}
+	if p := got[bob]; p.Status != presence.OnlineStatusOnline || p.LastSeenAt.IsZero() || p.Devices != nil {
+		t.Errorf("bob = %+v, want online with last seen and no devices", p)
+	}
+	if p := got[dave]; p.Status != presence.OnlineStatusOnline || !p.LastSeenAt.IsZero() {
+		t.Errorf("dave = %+v, want online without last seen", p)
+	}
+	if p := got[erin]; p.Status != presence.OnlineStatusOffline {
+		t.Errorf("erin = %+v, want offline", p)
+	}
+}
+
+func TestTypingRequiresParticipant(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := uuid.New(), uuid.New()
+	conversationID := uuid.New()
+	f := newFixture(&fakeCircles{})
+	f.conversations.participants[[2]uuid.UUID{conversationID, alice}] = true
+
+	if err := f.svc.SetTyping(ctx, SetTypingCommand{UserID: bob, ConversationID: conversationID}); !errors.Is(err, conversation.ErrNotParticipant) {
+		t.Errorf("SetTyping by a non-participant: error = %v, want %v", err, conversation.ErrNotParticipant)
+	}
+	if err := f.svc.ClearTyping(ctx, ClearTypingCommand{UserID: bob, ConversationID: conversationID}); !errors.Is(err, conversation.ErrNotParticipant) {
+		t.Errorf("ClearTyping by a non-participant: error = %v, want %v", err, conversation.ErrNotParticipant)
+	}
+	if len(f.presences.typing) != 0 || len(f.publisher.conversations) != 0 {
+		t.Fatalf("a non-participant's typing was stored or published")
+	}
+
+	if err := f.svc.SetTyping(ctx, SetTypingCommand{UserID: alice, ConversationID: conversationID}); err != nil {
+		t.Fatalf("SetTyping: %v", err)
+	}
+	if !f.presences.typing[[2]uuid.UUID{alice, conversationID}] {
+		t.Errorf("typing indicator was not stored")
+	}
+	if err := f.svc.ClearTyping(ctx, ClearTypingCommand{UserID: alice, ConversationID: conversationID}); err != nil {
+		t.Fatalf("ClearTyping: %v", err)
+	}
+	if len(f.presences.typing) != 0 {
+		t.Errorf("typing indicator was not cleared")
+	}
+	if len(f.publisher.conversations) != 2 {
+		t.Errorf("published %d typing events, want 2", len(f.publisher.conversations))
+	}
+}
@@ -0,0 +1,24 @@
+package realtime
+
+import (
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"github.com/google/uuid"
+)
+
+type SubscribeCommand struct {
+	UserID     uuid.UUID
+	DeviceType presence.DeviceType
+	DeviceID   *string
+}
+
+type HeartbeatCommand struct {
+	UserID     uuid.UUID
+	DeviceType presence.DeviceType
+	DeviceID   *string
+}
+
+type UnsubscribeCommand struct {
+	UserID     uuid.UUID
+	DeviceType presence.DeviceType
+	DeviceID   *string
+}
@@ -0,0 +1,131 @@
+package realtime
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	apppresence "github.com/danielng/kin-core-svc/internal/application/presence"
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	broker           realtime.Broker
+	conversationRepo conversation.Repository
+	circleRepo       circle.Repository
+	presenceRepo     presence.Repository
+	presenceTTL      time.Duration
+	presences        *apppresence.Publisher
+	logger           *slog.Logger
+}
+
+func NewService(
+	broker realtime.Broker,
+	conversationRepo conversation.Repository,
+	circleRepo circle.Repository,
+	presenceRepo presence.Repository,
+	presenceTTL time.Duration,
+	presences *apppresence.Publisher,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		broker:           broker,
+		conversationRepo: conversationRepo,
+		circleRepo:       circleRepo,
+		presenceRepo:     presenceRepo,
+		presenceTTL:      presenceTTL,
+		presences:        presences,
+		logger:           logger,
+	}
+}
+
+// Subscribe opens the user's event feed and marks the device online for as
+// long as the caller keeps sending heartbeats.
+func (s *Service) Subscribe(ctx context.Context, cmd SubscribeCommand) (realtime.Subscription, error) {
+	sub, err := s.broker.Subscribe(ctx, cmd.UserID)
+	if err != nil {
+		s.logger.Error("failed to subscribe to events", "error", err, "user_id", cmd.UserID)
+		return nil, err
+	}
+
+	_, err = s.presences.Update(ctx, cmd.UserID, func(*presence.Presence) error {
+		return s.presenceRepo.SetOnline(ctx, cmd.UserID, cmd.DeviceType, cmd.DeviceID, s.presenceTTL)
+	})
+	if err != nil {
+		s.logger.Error("failed to set user online", "error", err, "user_id", cmd.UserID)
+	}
+
+	return sub, nil
+}
+
+// Heartbeat keeps the subscribed device online, or away if it was set away
+// through the presence API, bringing it back online if it was taken offline
+// while subscribed.
+func (s *Service) Heartbeat(ctx context.Context, cmd HeartbeatCommand) error {
+	err := s.presenceRepo.Heartbeat(ctx, cmd.UserID, cmd.DeviceType, cmd.DeviceID, s.presenceTTL)
+	if !errors.Is(err, presence.ErrPresenceNotFound) {
+		return err
+	}
+
+	_, err = s.presences.Update(ctx, cmd.UserID, func(*presence.Presence) error {
+		return s.presenceRepo.SetOnline(ctx, cmd.UserID, cmd.DeviceType, cmd.DeviceID, s.presenceTTL)
+	})
+	return err
+}
+
+// Unsubscribe takes the device offline. The user stays online for as long as
+// any of their other devices is, and their circles are told only when the
+// user's status changes.
+func (s *Service) Unsubscribe(ctx context.Context, cmd UnsubscribeCommand) error {
+	_, err := s.presences.Update(ctx, cmd.UserID, func(*presence.Presence) error {
+		return s.presenceRepo.SetOffline(ctx, cmd.UserID, cmd.DeviceType, cmd.DeviceID)
+	})
+	if err != nil {
+		s.logger.Error("failed to set device offline", "error", err, "user_id", cmd.UserID)
+		return err
+	}
+	return nil
+}
+
+func (s *Service) PublishToUsers(ctx context.Context, userIDs []uuid.UUID, event *realtime.Event) error {
+	if err := s.broker.Publish(ctx, userIDs, event); err != nil {
+		s.logger.Error("failed to publish event", "error", err, "event_type", event.Type)
+		return err
+	}
+	return nil
+}
+
+func (s *Service) PublishToConversation(ctx context.Context, conversationID uuid.UUID, event *realtime.Event) error {
+	participants, err := s.conversationRepo.ListActiveParticipants(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+
+	userIDs := make([]uuid.UUID, len(participants))
+	for i, p := range participants {
+		userIDs[i] = p.UserID
+	}
+
+	return s.PublishToUsers(ctx, userIDs, event)
+}
+
+func (s *Service) PublishToCircle(ctx context.Context, circleID uuid.UUID, event *realtime.Event) error {
+	members, err := s.circleRepo.ListMembers(ctx, circleID)
+	if err != nil {
+		return err
+	}
+
+	userIDs := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		userIDs[i] = m.UserID
+	}
+
+	return s.PublishToUsers(ctx, userIDs, event)
+}
+
+var _ realtime.Publisher = (*Service)(nil)
@@ -37,4 +37,5 @@ type UpdatePreferencesCommand struct {
 	QuietHoursEnabled     *bool
 	QuietHoursStart       *string
 	QuietHoursEnd         *string
+	LocationRetention     *string
 }
@@ -11,8 +11,9 @@ type GetUserByAuth0SubQuery struct {
 }
 
 type SearchUsersQuery struct {
-	Query string
-	Limit int
+	UserID uuid.UUID // The searcher; users they are blocked with are hidden
+	Query  string
+	Limit  int
 }
 
 type GetUserPreferencesQuery struct {
@@ -3,20 +3,25 @@ package user
 import (
 	"context"
 	"log/slog"
+	"time"
 
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
 	"github.com/danielng/kin-core-svc/internal/domain/user"
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	repo   user.Repository
-	logger *slog.Logger
+	repo        user.Repository
+	blockPolicy *contact.BlockPolicy
+	logger      *slog.Logger
 }
 
-func NewService(repo user.Repository, logger *slog.Logger) *Service {
+func NewService(repo user.Repository, blockPolicy *contact.BlockPolicy, logger *slog.Logger) *Service {
 	return &Service{
-		repo:   repo,
-		logger: logger,
+		repo:        repo,
+		blockPolicy: blockPolicy,
+		logger:      logger,
 	}
 }
 
@@ -84,6 +89,10 @@ func (s *Service) UpdateTimezone(ctx context.Context, cmd UpdateTimezoneCommand)
 		return nil, err
 	}
 
+	if _, err := time.LoadLocation(cmd.Timezone); err != nil {
+		return nil, user.ErrInvalidTimezone
+	}
+
 	u.SetTimezone(cmd.Timezone)
 
 	if err := s.repo.Update(ctx, u); err != nil {
@@ -115,6 +124,10 @@ func (s *Service) GetPreferences(ctx context.Context, query GetUserPreferencesQu
 }
 
 func (s *Service) UpdatePreferences(ctx context.Context, cmd UpdatePreferencesCommand) (*user.Preferences, error) {
+	if cmd.LocationRetention != nil && !location.IsValidRetention(location.Retention(*cmd.LocationRetention)) {
+		return nil, location.ErrInvalidRetention
+	}
+
 	prefs, err := s.repo.GetPreferences(ctx, cmd.UserID)
 	if err != nil {
 		prefs = user.NewPreferences(cmd.UserID)
@@ -153,6 +166,9 @@ func (s *Service) UpdatePreferences(ctx context.Context, cmd UpdatePreferencesCo
 	if cmd.QuietHoursEnd != nil {
 		prefs.QuietHoursEnd = cmd.QuietHoursEnd
 	}
+	if cmd.LocationRetention != nil {
+		prefs.LocationRetention = location.Retention(*cmd.LocationRetention)
+	}
 
 	if err := s.repo.UpdatePreferences(ctx, prefs); err != nil {
 		s.logger.Error("failed to update preferences", "error", err, "user_id", cmd.UserID)
@@ -167,7 +183,29 @@ func (s *Service) SearchUsers(ctx context.Context, query SearchUsersQuery) ([]*u
 	if limit <= 0 || limit > 50 {
 		limit = 20
 	}
-	return s.repo.SearchByDisplayName(ctx, query.Query, limit)
+
+	hidden, err := s.blockPolicy.HiddenUsers(ctx, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Over-fetch so that filtering out hidden users still fills the page.
+	users, err := s.repo.SearchByDisplayName(ctx, query.Query, limit+len(hidden))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*user.User, 0, limit)
+	for _, u := range users {
+		if _, ok := hidden[u.ID]; ok {
+			continue
+		}
+		result = append(result, u)
+		if len(result) == limit {
+			break
+		}
+	}
+	return result, nil
 }
 
 func (s *Service) GetUsersByIDs(ctx context.Context, ids []uuid.UUID) ([]*user.User, error) {
@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type fakeUsers struct {
+	user.Repository
+	prefs   map[uuid.UUID]*user.Preferences
+	updates int
+}
+
+func (f *fakeUsers) GetPreferences(_ context.Context, userID uuid.UUID) (*user.Preferences, error) {
+	p, ok := f.prefs[userID]
+	if !ok {
+		return nil, user.ErrPreferencesNotFound
+	}
+	return p, nil
+}
+
+func (f *fakeUsers) CreatePreferences(_ context.Context, p *user.Preferences) error {
+	f.prefs[p.UserID] = p
+	return nil
+}
+
+func (f *fakeUsers) UpdatePreferences(_ context.Context, p *user.Preferences) error {
+	f.updates++
+	f.prefs[p.UserID] = p
+	return nil
+}
+
+func TestUpdatePreferencesLocationRetention(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		retention *string
+		want      location.Retention
+		wantErr   error
+	}{
+		{name: "keeps the current value when unset", want: location.RetentionWeek},
+		{name: "sets a valid value", retention: ptr("24h"), want: location.RetentionDay},
+		{name: "rejects an invalid value", retention: ptr("1y"), want: location.RetentionWeek, wantErr: location.ErrInvalidRetention},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := uuid.New()
+			prefs := user.NewPreferences(userID)
+			prefs.LocationRetention = location.RetentionWeek
+			repo := &fakeUsers{prefs: map[uuid.UUID]*user.Preferences{userID: prefs}}
+			svc := NewService(repo, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			_, err := svc.UpdatePreferences(context.Background(), UpdatePreferencesCommand{
+				UserID:            userID,
+				LocationRetention: tt.retention,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdatePreferences error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && repo.updates != 0 {
+				t.Errorf("preferences were saved")
+			}
+			if got := repo.prefs[userID].LocationRetention; got != tt.want {
+				t.Errorf("LocationRetention = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,15 +10,18 @@ import (
 )
 
 type Config struct {
-	Server     ServerConfig     `mapstructure:"server"`
-	Database   DatabaseConfig   `mapstructure:"database"`
-	Redis      RedisConfig      `mapstructure:"redis"`
-	S3         S3Config         `mapstructure:"s3"`
-	Auth       AuthConfig       `mapstructure:"auth"`
-	Logging    LoggingConfig    `mapstructure:"logging"`
-	Telemetry  TelemetryConfig  `mapstructure:"telemetry"`
-	Presence   PresenceConfig   `mapstructure:"presence"`
-	Pagination PaginationConfig `mapstructure:"pagination"`
+	Server       ServerConfig       `mapstructure:"server"`
+	Database     DatabaseConfig     `mapstructure:"database"`
+	Redis        RedisConfig        `mapstructure:"redis"`
+	S3           S3Config           `mapstructure:"s3"`
+	Auth         AuthConfig         `mapstructure:"auth"`
+	Logging      LoggingConfig      `mapstructure:"logging"`
+	Telemetry    TelemetryConfig    `mapstructure:"telemetry"`
+	Presence     PresenceConfig     `mapstructure:"presence"`
+	Pagination   PaginationConfig   `mapstructure:"pagination"`
+	Outbox       OutboxConfig       `mapstructure:"outbox"`
+	Availability AvailabilityConfig `mapstructure:"availability"`
+	Location     LocationConfig     `mapstructure:"location"`
 }
 
 type ServerConfig struct {
@@ -86,6 +89,29 @@ type PaginationConfig struct {
 	MaxLimit     int `mapstructure:"max_limit"`
 }
 
+type OutboxConfig struct {
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	StreamName   string        `mapstructure:"stream_name"`
+	StreamMaxLen int64         `mapstructure:"stream_max_len"`
+	Retention    time.Duration `mapstructure:"retention"` // How long published events are kept
+}
+
+type AvailabilityConfig struct {
+	SchedulerInterval time.Duration `mapstructure:"scheduler_interval"`
+	BatchSize         int           `mapstructure:"batch_size"`
+}
+
+type LocationConfig struct {
+	RetentionInterval  time.Duration `mapstructure:"retention_interval"`
+	RetentionBatchSize int           `mapstructure:"retention_batch_size"`
+	LiveExpiryInterval time.Duration `mapstructure:"live_expiry_interval"`
+	GeocoderDataPath   string        `mapstructure:"geocoder_data_path"` // GeoNames cities dump; the bundled list of major cities if empty
+	GeocoderCacheSize  int           `mapstructure:"geocoder_cache_size"`
+	GeocoderCacheTTL   time.Duration `mapstructure:"geocoder_cache_ttl"`
+	GeocoderQueueSize  int           `mapstructure:"geocoder_queue_size"`
+}
+
 func Load() (*Config, error) {
 	env := os.Getenv("KIN_ENV")
 	if env == "" {
@@ -189,6 +215,48 @@ func setDefaults(cfg *Config) {
 		cfg.Pagination.MaxLimit = 100
 	}
 
+	if cfg.Outbox.PollInterval == 0 {
+		cfg.Outbox.PollInterval = 1 * time.Second
+	}
+	if cfg.Outbox.BatchSize == 0 {
+		cfg.Outbox.BatchSize = 100
+	}
+	if cfg.Outbox.StreamName == "" {
+		cfg.Outbox.StreamName = "domain-events"
+	}
+	if cfg.Outbox.StreamMaxLen == 0 {
+		cfg.Outbox.StreamMaxLen = 100000
+	}
+	if cfg.Outbox.Retention == 0 {
+		cfg.Outbox.Retention = 24 * time.Hour
+	}
+
+	if cfg.Availability.SchedulerInterval == 0 {
+		cfg.Availability.SchedulerInterval = 30 * time.Second
+	}
+	if cfg.Availability.BatchSize == 0 {
+		cfg.Availability.BatchSize = 100
+	}
+
+	if cfg.Location.RetentionInterval == 0 {
+		cfg.Location.RetentionInterval = 1 * time.Hour
+	}
+	if cfg.Location.RetentionBatchSize == 0 {
+		cfg.Location.RetentionBatchSize = 1000
+	}
+	if cfg.Location.LiveExpiryInterval == 0 {
+		cfg.Location.LiveExpiryInterval = 30 * time.Second
+	}
+	if cfg.Location.GeocoderCacheSize == 0 {
+		cfg.Location.GeocoderCacheSize = 10000
+	}
+	if cfg.Location.GeocoderCacheTTL == 0 {
+		cfg.Location.GeocoderCacheTTL = 24 * time.Hour
+	}
+	if cfg.Location.GeocoderQueueSize == 0 {
+		cfg.Location.GeocoderQueueSize = 1000
+	}
+
 	if cfg.Auth.TokenLookup == "" {
 		cfg.Auth.TokenLookup = "header:Authorization"
 	}
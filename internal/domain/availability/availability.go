@@ -23,6 +23,9 @@ type Availability struct {
 	ManualUntil   *time.Time `json:"manual_until,omitempty"` // If manually set, when it expires
 	AutoStatus    bool       `json:"auto_status"`            // Whether status is automatically determined
 	UpdatedAt     time.Time  `json:"updated_at"`
+
+	CurrentPlaceID   *uuid.UUID `json:"-"` // The place the user is at, for location rules
+	NextTransitionAt *time.Time `json:"-"` // When the status next needs re-resolving
 }
 
 func NewAvailability(userID uuid.UUID) *Availability {
@@ -54,11 +57,42 @@ func (a *Availability) SetAutoStatus() {
 	a.UpdatedAt = time.Now()
 }
 
+// SetCurrentPlace records the place the user has arrived at, or nil once they
+// have left it.
+func (a *Availability) SetCurrentPlace(placeID *uuid.UUID) {
+	a.CurrentPlaceID = placeID
+}
+
 func (a *Availability) IsManualExpired() bool {
+	return a.IsManualExpiredAt(time.Now())
+}
+
+func (a *Availability) IsManualExpiredAt(t time.Time) bool {
 	if a.AutoStatus || a.ManualUntil == nil {
 		return false
 	}
-	return time.Now().After(*a.ManualUntil)
+	return !t.Before(*a.ManualUntil)
+}
+
+// Apply records a resolution computed at now. An expired manual status reverts
+// to auto, and while auto the stored status tracks the resolved one.
+func (a *Availability) Apply(r Resolution, now time.Time) {
+	if a.IsManualExpiredAt(now) {
+		a.SetAutoStatus()
+	}
+
+	if a.AutoStatus && a.Status != r.Status {
+		a.Status = r.Status
+		a.UpdatedAt = now
+	}
+
+	// A manual status must be cleared when it expires even if the status
+	// that takes over happens to be the same.
+	next := r.NextChange
+	if !a.AutoStatus && a.ManualUntil != nil && (next == nil || a.ManualUntil.Before(*next)) {
+		next = a.ManualUntil
+	}
+	a.NextTransitionAt = next
 }
 
 func IsValidStatus(s Status) bool {
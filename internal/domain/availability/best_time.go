@@ -0,0 +1,194 @@
+package availability
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxSearchRange bounds how far ahead FindSlots may look.
+	MaxSearchRange = 14 * 24 * time.Hour
+
+	// MaxParticipants bounds how many users, the requester included, one
+	// search may resolve.
+	MaxParticipants = 20
+
+	// Slots are scored by how much of them falls between these local times
+	// for every participant.
+	daytimeStart = 8 * 60
+	daytimeEnd   = 22 * 60
+)
+
+// Interval is a span of time with a single effective status.
+type Interval struct {
+	Start  time.Time
+	End    time.Time
+	Status Status
+}
+
+type Slot struct {
+	Start time.Time
+	End   time.Time
+	Score float64 // Share of the slot that is daytime for every participant
+}
+
+type Participant struct {
+	UserID   uuid.UUID
+	Timezone *string // Nil unless the user shares their timezone
+}
+
+type BestTimes struct {
+	Slots        []Slot
+	Participants []Participant
+	Excluded     []uuid.UUID // Users who do not share their availability
+}
+
+// Timeline returns the effective status over [from, to) as consecutive
+// intervals, each with a different status from the one before. Where the user
+// will be is unknown, so location rules are ignored.
+func Timeline(in ResolveInput, from, to time.Time) []Interval {
+	if in.Availability != nil && in.Availability.CurrentPlaceID != nil {
+		a := *in.Availability
+		a.CurrentPlaceID = nil
+		in.Availability = &a
+	}
+
+	var intervals []Interval
+	for t := from; t.Before(to); {
+		r := Resolve(in, t)
+
+		end := t.Add(resolveHorizon)
+		if r.NextChange != nil {
+			end = *r.NextChange
+		}
+		if end.After(to) {
+			end = to
+		}
+
+		if n := len(intervals); n > 0 && intervals[n-1].Status == r.Status {
+			intervals[n-1].End = end
+		} else {
+			intervals = append(intervals, Interval{Start: t, End: end, Status: r.Status})
+		}
+		t = end
+	}
+	return intervals
+}
+
+// FindSlots returns the periods in [from, to) during which every participant
+// is expected to be free, split at midnight in loc and at least minDuration
+// long. Slots that fall in everyone's daytime rank first, then longer slots,
+// then earlier ones.
+func FindSlots(participants []ResolveInput, from, to time.Time, minDuration time.Duration, loc *time.Location) []Slot {
+	if len(participants) == 0 {
+		return nil
+	}
+
+	free := []span{{from, to}}
+	daytime := []span{{from, to}}
+	for _, p := range participants {
+		var own []span
+		for _, i := range Timeline(p, from, to) {
+			if i.Status == StatusFree {
+				own = append(own, span{i.Start, i.End})
+			}
+		}
+		free = intersect(free, own)
+		daytime = intersect(daytime, daytimeSpans(p.Location, from, to))
+	}
+
+	var slots []Slot
+	for _, s := range splitAtMidnight(free, loc) {
+		length := s.end.Sub(s.start)
+		if length < minDuration {
+			continue
+		}
+
+		var covered time.Duration
+		for _, d := range intersect([]span{s}, daytime) {
+			covered += d.end.Sub(d.start)
+		}
+		slots = append(slots, Slot{Start: s.start, End: s.end, Score: float64(covered) / float64(length)})
+	}
+
+	slices.SortStableFunc(slots, func(a, b Slot) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.End.Sub(b.Start), a.End.Sub(a.Start)); c != 0 {
+			return c
+		}
+		return a.Start.Compare(b.Start)
+	})
+	return slots
+}
+
+type span struct {
+	start time.Time
+	end   time.Time
+}
+
+// intersect returns the overlap of two sorted lists of disjoint spans.
+func intersect(a, b []span) []span {
+	var out []span
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := a[i].start, a[i].end
+		if b[j].start.After(start) {
+			start = b[j].start
+		}
+		if b[j].end.Before(end) {
+			end = b[j].end
+		}
+		if start.Before(end) {
+			out = append(out, span{start, end})
+		}
+
+		if a[i].end.Before(b[j].end) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return out
+}
+
+// daytimeSpans returns the daytime hours in loc that overlap [from, to).
+func daytimeSpans(loc *time.Location, from, to time.Time) []span {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var out []span
+	local := from.In(loc)
+	date := time.Date(local.Year(), local.Month(), local.Day()-1, 0, 0, 0, 0, loc)
+	for ; date.Before(to); date = date.AddDate(0, 0, 1) {
+		out = append(out, span{wallClock(date, daytimeStart), wallClock(date, daytimeEnd)})
+	}
+	return intersect(out, []span{{from, to}})
+}
+
+// splitAtMidnight breaks spans at each local midnight in loc.
+func splitAtMidnight(spans []span, loc *time.Location) []span {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var out []span
+	for _, s := range spans {
+		for start := s.start; start.Before(s.end); {
+			local := start.In(loc)
+			midnight := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+
+			end := s.end
+			if midnight.Before(end) {
+				end = midnight
+			}
+			out = append(out, span{start, end})
+			start = end
+		}
+	}
+	return out
+}
@@ -0,0 +1,192 @@
+package availability
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/danielng/kin-core-svc/pkg/uid"
+	"github.com/google/uuid"
+)
+
+// MaxCalendarSize bounds uploaded and fetched iCalendar documents.
+const MaxCalendarSize = 2 << 20
+
+// CalendarFetcher downloads an iCalendar feed.
+type CalendarFetcher interface {
+	Fetch(ctx context.Context, url string) ([]byte, error)
+}
+
+// Calendar is an imported iCalendar source. URL feeds are re-fetched
+// periodically; uploaded files only change when a new file is uploaded.
+type Calendar struct {
+	ID           uuid.UUID       `json:"id"`
+	UserID       uuid.UUID       `json:"user_id"`
+	Name         string          `json:"name"`
+	URL          *string         `json:"url,omitempty"` // Nil for uploaded files
+	Events       []CalendarEvent `json:"events"`        // Busy events only
+	IsActive     bool            `json:"is_active"`
+	LastSyncedAt *time.Time      `json:"last_synced_at,omitempty"`
+	LastError    *string         `json:"last_error,omitempty"` // Why the last sync failed
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// CalendarEvent is a busy event, possibly recurring. Start, Until and ExDates
+// hold wall-clock times in UTC fields and are interpreted in TZID, or in the
+// user's timezone when Floating.
+type CalendarEvent struct {
+	UID      string        `json:"uid"`
+	Summary  string        `json:"summary,omitempty"`
+	Start    time.Time     `json:"start"`
+	TZID     string        `json:"tzid,omitempty"` // Empty means UTC unless Floating
+	Floating bool          `json:"floating,omitempty"`
+	AllDay   bool          `json:"all_day,omitempty"`
+	Duration time.Duration `json:"duration"`
+	RRule    *Recurrence   `json:"rrule,omitempty"`
+	ExDates  []time.Time   `json:"exdates,omitempty"`
+}
+
+// NormalizeCalendarURL validates a feed URL and rewrites webcal:// links,
+// which calendar apps publish for subscriptions, to https.
+func NormalizeCalendarURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return "", ErrInvalidCalendarURL
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "webcal", "webcals":
+		u.Scheme = "https"
+	case "http", "https":
+	default:
+		return "", ErrInvalidCalendarURL
+	}
+	return u.String(), nil
+}
+
+func NewCalendar(userID uuid.UUID, name string, feedURL *string) *Calendar {
+	now := time.Now()
+	return &Calendar{
+		ID:        uid.New(),
+		UserID:    userID,
+		Name:      name,
+		URL:       feedURL,
+		Events:    []CalendarEvent{},
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (c *Calendar) Update(name string) {
+	c.Name = name
+	c.UpdatedAt = time.Now()
+}
+
+func (c *Calendar) SetActive(active bool) {
+	c.IsActive = active
+	c.UpdatedAt = time.Now()
+}
+
+func (c *Calendar) SetEvents(events []CalendarEvent) {
+	if events == nil {
+		events = []CalendarEvent{}
+	}
+
+	now := time.Now()
+	c.Events = events
+	c.LastSyncedAt = &now
+	c.LastError = nil
+	c.UpdatedAt = now
+}
+
+// SetSyncError records a failed sync. The previous events are kept so that a
+// feed that is briefly unreachable does not clear the user's status.
+func (c *Calendar) SetSyncError(err error) {
+	now := time.Now()
+	msg := err.Error()
+	c.LastSyncedAt = &now
+	c.LastError = &msg
+	c.UpdatedAt = now
+}
+
+// location returns the timezone the event's wall-clock times are in.
+func (e CalendarEvent) location(floating *time.Location) *time.Location {
+	if e.Floating {
+		return floating
+	}
+	if e.TZID == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(e.TZID)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// occurrences returns the instances of the event that overlap [from, to).
+func (e CalendarEvent) occurrences(from, to time.Time, floating *time.Location) []span {
+	loc := e.location(floating)
+
+	excluded := make(map[int64]struct{}, len(e.ExDates))
+	for _, d := range e.ExDates {
+		excluded[d.Unix()] = struct{}{}
+	}
+
+	var out []span
+	add := func(wall time.Time) bool {
+		start := inLocation(wall, loc)
+		if !start.Before(to) {
+			return false
+		}
+
+		if _, ok := excluded[wall.Unix()]; !ok {
+			end := start.Add(e.Duration)
+			if e.AllDay {
+				end = inLocation(wall.Add(e.Duration), loc)
+			}
+			if end.After(from) {
+				out = append(out, span{start, end})
+			}
+		}
+		return true
+	}
+
+	if e.RRule == nil {
+		add(e.Start)
+		return out
+	}
+
+	// Occurrences starting before this cannot reach from.
+	notBefore := from.Add(-e.Duration).In(loc)
+	e.RRule.each(e.Start, wallTime(notBefore), wallTime(to.In(loc)), add)
+	return out
+}
+
+// calendarBusy expands the events of every active calendar over [from, to),
+// keyed by calendar.
+func calendarBusy(calendars []*Calendar, floating *time.Location, from, to time.Time) map[uuid.UUID][]span {
+	busy := make(map[uuid.UUID][]span, len(calendars))
+	for _, c := range calendars {
+		if !c.IsActive {
+			continue
+		}
+		for _, e := range c.Events {
+			busy[c.ID] = append(busy[c.ID], e.occurrences(from, to, floating)...)
+		}
+	}
+	return busy
+}
+
+// wallTime returns t's wall clock in t's location as a UTC time.
+func wallTime(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// inLocation interprets the wall clock in wall as a time in loc.
+func inLocation(wall time.Time, loc *time.Location) time.Time {
+	return time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+}
@@ -25,6 +25,12 @@ var (
 		http.StatusNotFound,
 	)
 
+	ErrCalendarNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"calendar not found",
+		http.StatusNotFound,
+	)
+
 	ErrInvalidStatus = apperror.New(
 		apperror.CodeValidation,
 		"invalid availability status",
@@ -43,6 +49,72 @@ var (
 		http.StatusBadRequest,
 	)
 
+	ErrInvalidDuration = apperror.New(
+		apperror.CodeValidation,
+		"status duration must be positive",
+		http.StatusBadRequest,
+	)
+
+	ErrEmptyWindow = apperror.New(
+		apperror.CodeValidation,
+		"window start and end time must differ",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidCondition = apperror.New(
+		apperror.CodeValidation,
+		"invalid auto rule condition",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidTimeRange = apperror.New(
+		apperror.CodeValidation,
+		"time range end must be after its start",
+		http.StatusBadRequest,
+	)
+
+	ErrTimeRangeTooLong = apperror.New(
+		apperror.CodeValidation,
+		"time range must not exceed 14 days",
+		http.StatusBadRequest,
+	)
+
+	ErrTooManyParticipants = apperror.New(
+		apperror.CodeValidation,
+		"a search may include at most 20 participants",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidCalendar = apperror.New(
+		apperror.CodeValidation,
+		"invalid iCalendar data",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidCalendarURL = apperror.New(
+		apperror.CodeValidation,
+		"calendar URL must be an http, https or webcal URL",
+		http.StatusBadRequest,
+	)
+
+	ErrCalendarSourceRequired = apperror.New(
+		apperror.CodeValidation,
+		"exactly one of calendar URL or data is required",
+		http.StatusBadRequest,
+	)
+
+	ErrCalendarTooLarge = apperror.New(
+		apperror.CodeValidation,
+		"calendar data exceeds 2 MB",
+		http.StatusRequestEntityTooLarge,
+	)
+
+	ErrCalendarFetchFailed = apperror.New(
+		apperror.CodeBadRequest,
+		"failed to fetch calendar",
+		http.StatusBadRequest,
+	)
+
 	ErrWindowOverlap = apperror.New(
 		apperror.CodeConflict,
 		"availability window overlaps with existing window",
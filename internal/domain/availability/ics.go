@@ -0,0 +1,482 @@
+package availability
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// windowsZones maps the Windows timezone names Outlook and Exchange put in
+// TZID to IANA names.
+var windowsZones = map[string]string{
+	"UTC":                            "UTC",
+	"GMT Standard Time":              "Europe/London",
+	"W. Europe Standard Time":        "Europe/Berlin",
+	"Romance Standard Time":          "Europe/Paris",
+	"Central Europe Standard Time":   "Europe/Budapest",
+	"Central European Standard Time": "Europe/Warsaw",
+	"E. Europe Standard Time":        "Europe/Chisinau",
+	"FLE Standard Time":              "Europe/Kiev",
+	"Russian Standard Time":          "Europe/Moscow",
+	"Eastern Standard Time":          "America/New_York",
+	"Central Standard Time":          "America/Chicago",
+	"Mountain Standard Time":         "America/Denver",
+	"Pacific Standard Time":          "America/Los_Angeles",
+	"Alaskan Standard Time":          "America/Anchorage",
+	"Hawaiian Standard Time":         "Pacific/Honolulu",
+	"E. South America Standard Time": "America/Sao_Paulo",
+	"India Standard Time":            "Asia/Kolkata",
+	"SE Asia Standard Time":          "Asia/Bangkok",
+	"China Standard Time":            "Asia/Shanghai",
+	"Singapore Standard Time":        "Asia/Singapore",
+	"Tokyo Standard Time":            "Asia/Tokyo",
+	"Korea Standard Time":            "Asia/Seoul",
+	"AUS Eastern Standard Time":      "Australia/Sydney",
+	"New Zealand Standard Time":      "Pacific/Auckland",
+}
+
+type icsProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseICS extracts the busy events from an iCalendar (RFC 5545) document.
+// Cancelled and transparent (free) events are dropped, and modified or
+// cancelled instances of recurring events replace the original occurrence.
+// Timezones are resolved by name, so VTIMEZONE definitions are not needed for
+// IANA or common Windows TZIDs. Malformed events are skipped and counted
+// rather than failing the whole document.
+func ParseICS(data []byte) (events []CalendarEvent, skipped int, err error) {
+	lines := unfoldLines(data)
+	if len(lines) == 0 || !strings.EqualFold(strings.TrimSpace(lines[0]), "BEGIN:VCALENDAR") {
+		return nil, 0, ErrInvalidCalendar
+	}
+
+	var (
+		overrides = map[string][]time.Time{} // Instances replaced per UID
+		current   []icsProperty
+		inEvent   bool
+		nested    int
+	)
+	for _, line := range lines {
+		prop, ok := parseProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent, current = true, nil
+		case prop.name == "BEGIN" && inEvent:
+			nested++
+		case prop.name == "END" && inEvent && nested > 0:
+			nested--
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent = false
+			e, recurrenceID, busy, err := buildEvent(current)
+			if err != nil {
+				skipped++
+				continue
+			}
+			if recurrenceID != nil {
+				overrides[e.UID] = append(overrides[e.UID], *recurrenceID)
+			}
+			if busy {
+				events = append(events, e)
+			}
+		case inEvent && nested == 0:
+			current = append(current, prop)
+		}
+	}
+
+	for i, e := range events {
+		if e.RRule != nil {
+			events[i].ExDates = append(events[i].ExDates, overrides[e.UID]...)
+		}
+	}
+	return events, skipped, nil
+}
+
+// buildEvent converts a VEVENT's properties. It returns the instance the event
+// replaces when it has a RECURRENCE-ID, and whether it makes the user busy.
+func buildEvent(props []icsProperty) (CalendarEvent, *time.Time, bool, error) {
+	var e CalendarEvent
+
+	var dtstart *icsProperty
+	for i := range props {
+		if props[i].name == "DTSTART" {
+			dtstart = &props[i]
+		}
+	}
+	if dtstart == nil {
+		return e, nil, false, ErrInvalidCalendar
+	}
+
+	start, kind, err := parseICSTime(dtstart.value, dtstart.params)
+	if err != nil {
+		return e, nil, false, err
+	}
+
+	var loc *time.Location
+	switch kind {
+	case icsDate:
+		e.AllDay, e.Floating = true, true
+		loc = time.UTC
+	case icsUTC:
+		loc = time.UTC
+	case icsZoned:
+		loc = loadTZID(dtstart.params["TZID"])
+		if loc != time.UTC {
+			e.TZID = loc.String()
+		}
+	case icsFloating:
+		e.Floating = true
+		loc = time.UTC
+	}
+	e.Start = toWall(start, kind, loc)
+
+	var (
+		recurrenceID *time.Time
+		hasEnd       bool
+		busy         = true
+	)
+	for _, p := range props {
+		switch p.name {
+		case "UID":
+			e.UID = p.value
+		case "SUMMARY":
+			e.Summary = unescapeText(p.value)
+		case "DTEND":
+			end, _, err := parseICSTime(p.value, p.params)
+			if err != nil {
+				return e, nil, false, err
+			}
+			if e.AllDay {
+				e.Duration = wallTime(end).Sub(e.Start)
+			} else {
+				e.Duration = end.Sub(inLocation(e.Start, loc))
+			}
+			hasEnd = true
+		case "DURATION":
+			d, err := parseICSDuration(p.value)
+			if err != nil {
+				return e, nil, false, err
+			}
+			e.Duration, hasEnd = d, true
+		case "RRULE":
+			r, err := parseRRule(p.value, loc)
+			if err != nil {
+				return e, nil, false, err
+			}
+			e.RRule = r
+		case "EXDATE":
+			for _, v := range strings.Split(p.value, ",") {
+				t, kind, err := parseICSTime(v, p.params)
+				if err != nil {
+					return e, nil, false, err
+				}
+				e.ExDates = append(e.ExDates, toWall(t, kind, loc))
+			}
+		case "RECURRENCE-ID":
+			t, kind, err := parseICSTime(p.value, p.params)
+			if err != nil {
+				return e, nil, false, err
+			}
+			wall := toWall(t, kind, loc)
+			recurrenceID = &wall
+		case "STATUS":
+			busy = busy && !strings.EqualFold(p.value, "CANCELLED")
+		case "TRANSP":
+			busy = busy && !strings.EqualFold(p.value, "TRANSPARENT")
+		}
+	}
+
+	if !hasEnd && e.AllDay {
+		e.Duration = 24 * time.Hour
+	}
+	if e.Duration <= 0 {
+		busy = false
+	}
+	if e.RRule != nil && !e.RRule.Bound(e.Start) {
+		busy = false
+	}
+	return e, recurrenceID, busy, nil
+}
+
+// unfoldLines splits a document into logical lines, joining continuation lines
+// that start with a space or tab.
+func unfoldLines(data []byte) []string {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	var lines []string
+	for _, raw := range strings.Split(string(data), "\n") {
+		raw = strings.TrimRight(raw, "\r")
+		if raw == "" {
+			continue
+		}
+		if (raw[0] == ' ' || raw[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += raw[1:]
+			continue
+		}
+		lines = append(lines, raw)
+	}
+	return lines
+}
+
+// parseProperty splits a content line into its name, parameters and value.
+// Parameter values may be quoted and contain colons.
+func parseProperty(line string) (icsProperty, bool) {
+	colon, quoted := -1, false
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return icsProperty{}, false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	prop := icsProperty{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  line[colon+1:],
+	}
+	for _, p := range parts[1:] {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			prop.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return prop, true
+}
+
+type icsTimeKind int
+
+const (
+	icsDate icsTimeKind = iota
+	icsUTC
+	icsZoned
+	icsFloating
+)
+
+// parseICSTime parses a DATE or DATE-TIME value. Zoned times are returned in
+// their TZID; dates and floating times are returned in UTC.
+func parseICSTime(value string, params map[string]string) (time.Time, icsTimeKind, error) {
+	value = strings.TrimSpace(value)
+
+	if params["VALUE"] == "DATE" || len(value) == 8 {
+		t, err := time.Parse("20060102", value)
+		if err != nil {
+			return time.Time{}, 0, ErrInvalidCalendar
+		}
+		return t, icsDate, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, 0, ErrInvalidCalendar
+		}
+		return t, icsUTC, nil
+	}
+
+	if tzid, ok := params["TZID"]; ok {
+		t, err := time.ParseInLocation("20060102T150405", value, loadTZID(tzid))
+		if err != nil {
+			return time.Time{}, 0, ErrInvalidCalendar
+		}
+		return t, icsZoned, nil
+	}
+
+	t, err := time.Parse("20060102T150405", value)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCalendar
+	}
+	return t, icsFloating, nil
+}
+
+// toWall converts a parsed time to a wall-clock time in loc. Dates and floating
+// times already are one.
+func toWall(t time.Time, kind icsTimeKind, loc *time.Location) time.Time {
+	if kind == icsDate || kind == icsFloating {
+		return wallTime(t)
+	}
+	return wallTime(t.In(loc))
+}
+
+// loadTZID resolves a TZID to a location, accepting IANA names, names with a
+// vendor prefix such as /mozilla.org/20050126_1/Europe/Berlin, and common
+// Windows names. Unknown zones fall back to UTC.
+func loadTZID(tzid string) *time.Location {
+	tzid = strings.Trim(tzid, `"`)
+	if name, ok := windowsZones[tzid]; ok {
+		tzid = name
+	}
+
+	for name := tzid; name != ""; {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+		_, rest, ok := strings.Cut(name, "/")
+		if !ok {
+			break
+		}
+		name = rest
+	}
+	return time.UTC
+}
+
+// parseICSDuration parses a positive duration such as P1D, PT1H30M or P2W.
+func parseICSDuration(value string) (time.Duration, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "+")
+	if !strings.HasPrefix(value, "P") {
+		return 0, ErrInvalidCalendar
+	}
+
+	var (
+		d      time.Duration
+		num    int
+		inTime bool
+	)
+	for _, c := range value[1:] {
+		switch {
+		case c >= '0' && c <= '9':
+			num = num*10 + int(c-'0')
+			continue
+		case c == 'T':
+			inTime = true
+			continue
+		case c == 'W':
+			d += time.Duration(num) * 7 * 24 * time.Hour
+		case c == 'D':
+			d += time.Duration(num) * 24 * time.Hour
+		case c == 'H' && inTime:
+			d += time.Duration(num) * time.Hour
+		case c == 'M' && inTime:
+			d += time.Duration(num) * time.Minute
+		case c == 'S' && inTime:
+			d += time.Duration(num) * time.Second
+		default:
+			return 0, ErrInvalidCalendar
+		}
+		num = 0
+	}
+	return d, nil
+}
+
+// parseRRule parses an RRULE value. UNTIL is converted to a wall-clock time in
+// loc to match the event's start.
+func parseRRule(value string, loc *time.Location) (*Recurrence, error) {
+	r := &Recurrence{Interval: 1, WeekStart: time.Monday}
+
+	for _, part := range strings.Split(value, ";") {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+
+		var err error
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(v))
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(v)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(v)
+		case "UNTIL":
+			var (
+				t    time.Time
+				kind icsTimeKind
+			)
+			t, kind, err = parseICSTime(v, nil)
+			until := toWall(t, kind, loc)
+			if kind == icsDate {
+				// A date UNTIL includes the whole day.
+				until = until.Add(24*time.Hour - time.Second)
+			}
+			r.Until = &until
+		case "BYDAY":
+			for _, d := range strings.Split(v, ",") {
+				var bd ByDay
+				bd, err = parseByDay(d)
+				if err != nil {
+					break
+				}
+				r.ByDay = append(r.ByDay, bd)
+			}
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseInts(v)
+		case "BYMONTH":
+			r.ByMonth, err = parseInts(v)
+		case "BYSETPOS":
+			r.BySetPos, err = parseInts(v)
+		case "WKST":
+			var bd ByDay
+			bd, err = parseByDay(v)
+			r.WeekStart = bd.Weekday
+		}
+		if err != nil {
+			return nil, ErrInvalidCalendar
+		}
+	}
+
+	if !IsValidFrequency(r.Freq) || r.Interval < 1 {
+		return nil, ErrInvalidCalendar
+	}
+	return r, nil
+}
+
+var icsWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// parseByDay parses a BYDAY entry such as MO, 2TU or -1FR.
+func parseByDay(value string) (ByDay, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) < 2 {
+		return ByDay{}, ErrInvalidCalendar
+	}
+
+	day, ok := icsWeekdays[value[len(value)-2:]]
+	if !ok {
+		return ByDay{}, ErrInvalidCalendar
+	}
+
+	bd := ByDay{Weekday: day}
+	if n := value[:len(value)-2]; n != "" {
+		var err error
+		if bd.N, err = strconv.Atoi(n); err != nil {
+			return ByDay{}, ErrInvalidCalendar
+		}
+	}
+	return bd, nil
+}
+
+func parseInts(value string) ([]int, error) {
+	var out []int
+	for _, s := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+var textUnescaper = strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
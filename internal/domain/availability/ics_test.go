@@ -0,0 +1,187 @@
+package availability
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ics(events ...string) []byte {
+	return []byte("BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" + strings.Join(events, "") + "END:VCALENDAR\r\n")
+}
+
+func vevent(lines ...string) string {
+	return "BEGIN:VEVENT\r\n" + strings.Join(lines, "\r\n") + "\r\nEND:VEVENT\r\n"
+}
+
+func TestParseICS(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        []byte
+		wantEvents  []CalendarEvent
+		wantSkipped int
+	}{
+		{
+			name: "utc event",
+			data: ics(vevent("UID:a", "SUMMARY:Stand-up", "DTSTART:20250106T090000Z", "DTEND:20250106T093000Z")),
+			wantEvents: []CalendarEvent{
+				{UID: "a", Summary: "Stand-up", Start: day(2025, 1, 6, 9, 0), Duration: 30 * time.Minute},
+			},
+		},
+		{
+			name: "zoned event with a windows timezone",
+			data: ics(vevent("UID:b", `DTSTART;TZID="Eastern Standard Time":20250106T090000`, "DURATION:PT1H")),
+			wantEvents: []CalendarEvent{
+				{UID: "b", Start: day(2025, 1, 6, 9, 0), TZID: "America/New_York", Duration: time.Hour},
+			},
+		},
+		{
+			name: "all-day event",
+			data: ics(vevent("UID:c", "DTSTART;VALUE=DATE:20250106")),
+			wantEvents: []CalendarEvent{
+				{UID: "c", Start: day(2025, 1, 6, 0, 0), AllDay: true, Floating: true, Duration: 24 * time.Hour},
+			},
+		},
+		{
+			name: "folded summary",
+			data: ics(vevent("UID:d", "SUMMARY:Long\r\n  meeting", "DTSTART:20250106T090000", "DURATION:PT1H")),
+			wantEvents: []CalendarEvent{
+				{UID: "d", Summary: "Long meeting", Start: day(2025, 1, 6, 9, 0), Floating: true, Duration: time.Hour},
+			},
+		},
+		{
+			name: "cancelled and free events are dropped",
+			data: ics(
+				vevent("UID:e", "DTSTART:20250106T090000Z", "DURATION:PT1H", "STATUS:CANCELLED"),
+				vevent("UID:f", "DTSTART:20250106T090000Z", "DURATION:PT1H", "TRANSP:TRANSPARENT"),
+			),
+		},
+		{
+			name: "modified instance excludes the original occurrence",
+			data: ics(
+				vevent("UID:g", "DTSTART:20250106T090000Z", "DURATION:PT1H", "RRULE:FREQ=DAILY"),
+				vevent("UID:g", "RECURRENCE-ID:20250107T090000Z", "DTSTART:20250107T150000Z", "DURATION:PT1H"),
+			),
+			wantEvents: []CalendarEvent{
+				{UID: "g", Start: day(2025, 1, 6, 9, 0), Duration: time.Hour, RRule: &Recurrence{Freq: FrequencyDaily, Interval: 1, WeekStart: time.Monday}, ExDates: []time.Time{day(2025, 1, 7, 9, 0)}},
+				{UID: "g", Start: day(2025, 1, 7, 15, 0), Duration: time.Hour},
+			},
+		},
+		{
+			name: "count becomes until",
+			data: ics(vevent("UID:h", "DTSTART:20250106T090000Z", "DURATION:PT1H", "RRULE:FREQ=WEEKLY;COUNT=2")),
+			wantEvents: []CalendarEvent{
+				{UID: "h", Start: day(2025, 1, 6, 9, 0), Duration: time.Hour, RRule: &Recurrence{Freq: FrequencyWeekly, Interval: 1, Until: until(day(2025, 1, 13, 9, 0)), WeekStart: time.Monday}},
+			},
+		},
+		{
+			name: "rule without occurrences is dropped",
+			data: ics(vevent("UID:i", "DTSTART:20250106T090000Z", "DURATION:PT1H", "RRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30;COUNT=1")),
+		},
+		{
+			name: "malformed events are skipped",
+			data: ics(
+				vevent("UID:j", "DTSTART:not-a-date", "DURATION:PT1H"),
+				vevent("UID:k", "DURATION:PT1H"),
+				vevent("UID:l", "DTSTART:20250106T090000Z", "DURATION:PT1H", "RRULE:FREQ=HOURLY"),
+				vevent("UID:m", "DTSTART:20250106T090000Z", "DURATION:PT1H"),
+			),
+			wantEvents: []CalendarEvent{
+				{UID: "m", Start: day(2025, 1, 6, 9, 0), Duration: time.Hour},
+			},
+			wantSkipped: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, skipped, err := ParseICS(tt.data)
+			if err != nil {
+				t.Fatalf("ParseICS: %v", err)
+			}
+			if skipped != tt.wantSkipped {
+				t.Errorf("skipped = %d, want %d", skipped, tt.wantSkipped)
+			}
+			if len(events) != len(tt.wantEvents) {
+				t.Fatalf("events = %+v, want %+v", events, tt.wantEvents)
+			}
+			for i := range events {
+				if !equalEvent(events[i], tt.wantEvents[i]) {
+					t.Errorf("event %d = %+v, want %+v", i, events[i], tt.wantEvents[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseICSRejectsOtherDocuments(t *testing.T) {
+	for _, data := range []string{"", "BEGIN:VCARD\r\nEND:VCARD\r\n"} {
+		if _, _, err := ParseICS([]byte(data)); !errors.Is(err, ErrInvalidCalendar) {
+			t.Errorf("ParseICS(%q) error = %v, want %v", data, err, ErrInvalidCalendar)
+		}
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Recurrence
+		wantErr bool
+	}{
+		{
+			value: "FREQ=MONTHLY;INTERVAL=2;BYDAY=2TU,-1FR",
+			want:  Recurrence{Freq: FrequencyMonthly, Interval: 2, ByDay: []ByDay{{Weekday: time.Tuesday, N: 2}, {Weekday: time.Friday, N: -1}}, WeekStart: time.Monday},
+		},
+		{
+			value: "FREQ=WEEKLY;UNTIL=20250131;WKST=SU",
+			want:  Recurrence{Freq: FrequencyWeekly, Interval: 1, Until: until(day(2025, 1, 31, 23, 59).Add(59 * time.Second)), WeekStart: time.Sunday},
+		},
+		{
+			value: "FREQ=YEARLY;BYMONTH=3;BYDAY=SU;BYSETPOS=-1",
+			want:  Recurrence{Freq: FrequencyYearly, Interval: 1, ByMonth: []int{3}, ByDay: []ByDay{{Weekday: time.Sunday}}, BySetPos: []int{-1}, WeekStart: time.Monday},
+		},
+		{value: "FREQ=SECONDLY", wantErr: true},
+		{value: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{value: "FREQ=DAILY;BYDAY=XX", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseRRule(tt.value, time.UTC)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseRRule = %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRRule: %v", err)
+			}
+			if !equalRecurrence(got, &tt.want) {
+				t.Errorf("parseRRule = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func equalEvent(a, b CalendarEvent) bool {
+	return a.UID == b.UID && a.Summary == b.Summary && a.Start.Equal(b.Start) &&
+		a.TZID == b.TZID && a.Floating == b.Floating && a.AllDay == b.AllDay &&
+		a.Duration == b.Duration && equalRecurrence(a.RRule, b.RRule) &&
+		slices.EqualFunc(a.ExDates, b.ExDates, time.Time.Equal)
+}
+
+func equalRecurrence(a, b *Recurrence) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Freq == b.Freq && a.Interval == b.Interval && a.Count == b.Count &&
+		equalPtr(a.Until, b.Until, time.Time.Equal) &&
+		slices.Equal(a.ByDay, b.ByDay) &&
+		slices.Equal(a.ByMonthDay, b.ByMonthDay) &&
+		slices.Equal(a.ByMonth, b.ByMonth) &&
+		slices.Equal(a.BySetPos, b.BySetPos) &&
+		a.WeekStart == b.WeekStart
+}
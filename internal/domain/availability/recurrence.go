@@ -0,0 +1,283 @@
+package availability
+
+import (
+	"slices"
+	"time"
+)
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+// maxRecurrencePeriods stops runaway expansion of rules whose filters never
+// match, such as the 30th of February.
+const maxRecurrencePeriods = 100000
+
+// maxTime lets Bound expand a COUNT rule to its end.
+var maxTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// Recurrence is the subset of an RFC 5545 RRULE that calendar apps produce.
+// Until is a wall-clock time like the event's Start.
+type Recurrence struct {
+	Freq       Frequency    `json:"freq"`
+	Interval   int          `json:"interval,omitempty"`
+	Count      int          `json:"count,omitempty"`
+	Until      *time.Time   `json:"until,omitempty"`
+	ByDay      []ByDay      `json:"by_day,omitempty"`
+	ByMonthDay []int        `json:"by_month_day,omitempty"`
+	ByMonth    []int        `json:"by_month,omitempty"`
+	BySetPos   []int        `json:"by_set_pos,omitempty"`
+	WeekStart  time.Weekday `json:"week_start"`
+}
+
+// ByDay is a weekday, optionally the Nth (or Nth from last when negative) in
+// the month or year.
+type ByDay struct {
+	Weekday time.Weekday `json:"weekday"`
+	N       int          `json:"n,omitempty"`
+}
+
+func IsValidFrequency(f Frequency) bool {
+	switch f {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+		return true
+	default:
+		return false
+	}
+}
+
+// each calls yield with every occurrence of the rule in order, starting at
+// start, until yield returns false, the rule ends or the occurrences pass
+// notAfter. Without a COUNT, periods entirely before notBefore are skipped.
+func (r *Recurrence) each(start, notBefore, notAfter time.Time, yield func(time.Time) bool) {
+	interval := max(r.Interval, 1)
+
+	first := 0
+	if r.Count == 0 && notBefore.After(start) {
+		first = max(r.periodsBetween(start, notBefore)/interval-1, 0)
+	}
+
+	count := 0
+	for k := first; k < first+maxRecurrencePeriods; k++ {
+		// Checked per period rather than per occurrence so that rules whose
+		// filters rarely match stop as soon as they leave the window.
+		if r.periodStart(start, k*interval).After(notAfter) {
+			return
+		}
+
+		candidates := r.period(start, k*interval)
+		if len(r.BySetPos) > 0 {
+			candidates = selectPositions(candidates, r.BySetPos)
+		}
+
+		for _, c := range candidates {
+			if c.Before(start) {
+				continue
+			}
+			if r.Until != nil && c.After(*r.Until) {
+				return
+			}
+			if !yield(c) {
+				return
+			}
+			count++
+			if r.Count > 0 && count >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// Bound replaces a COUNT with the UNTIL of its last occurrence, so that later
+// expansions can skip straight to the periods they need instead of counting
+// from start every time. It reports false when the rule has no occurrences.
+func (r *Recurrence) Bound(start time.Time) bool {
+	if r.Count == 0 {
+		return true
+	}
+
+	var last *time.Time
+	r.each(start, start, maxTime, func(t time.Time) bool {
+		last = &t
+		return true
+	})
+	if last == nil {
+		return false
+	}
+	r.Count, r.Until = 0, last
+	return true
+}
+
+// periodStart returns the earliest time the period offset periods after the
+// one containing start can produce.
+func (r *Recurrence) periodStart(start time.Time, offset int) time.Time {
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	switch r.Freq {
+	case FrequencyWeekly:
+		back := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		return day.AddDate(0, 0, offset*7-back)
+	case FrequencyMonthly:
+		return time.Date(start.Year(), start.Month()+time.Month(offset), 1, 0, 0, 0, 0, time.UTC)
+	case FrequencyYearly:
+		return time.Date(start.Year()+offset, time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day.AddDate(0, 0, offset)
+	}
+}
+
+// periodsBetween counts whole periods of the rule's frequency from start to t.
+func (r *Recurrence) periodsBetween(start, t time.Time) int {
+	switch r.Freq {
+	case FrequencyDaily:
+		return int(t.Sub(start) / (24 * time.Hour))
+	case FrequencyWeekly:
+		return int(t.Sub(start) / (7 * 24 * time.Hour))
+	case FrequencyMonthly:
+		return (t.Year()-start.Year())*12 + int(t.Month()-start.Month())
+	case FrequencyYearly:
+		return t.Year() - start.Year()
+	default:
+		return 0
+	}
+}
+
+// period returns the sorted candidate occurrences in the period offset
+// periods after the one containing start.
+func (r *Recurrence) period(start time.Time, offset int) []time.Time {
+	h, m, s := start.Clock()
+	at := func(y int, mon time.Month, d int) time.Time {
+		return time.Date(y, mon, d, h, m, s, 0, time.UTC)
+	}
+
+	var out []time.Time
+	switch r.Freq {
+	case FrequencyDaily:
+		d := start.AddDate(0, 0, offset)
+		if r.matchesMonth(d) && r.matchesMonthDay(d) && r.matchesWeekday(d) {
+			out = append(out, d)
+		}
+
+	case FrequencyWeekly:
+		back := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		weekStart := start.AddDate(0, 0, offset*7-back)
+		for i := range 7 {
+			d := weekStart.AddDate(0, 0, i)
+			if len(r.ByDay) == 0 && d.Weekday() != start.Weekday() {
+				continue
+			}
+			if r.matchesMonth(d) && r.matchesWeekday(d) {
+				out = append(out, d)
+			}
+		}
+
+	case FrequencyMonthly:
+		month := at(start.Year(), start.Month()+time.Month(offset), 1)
+		if r.matchesMonth(month) {
+			out = r.monthDays(month, start.Day())
+		}
+
+	case FrequencyYearly:
+		year := start.Year() + offset
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []int{int(start.Month())}
+		}
+		for _, mon := range months {
+			out = append(out, r.monthDays(at(year, time.Month(mon), 1), start.Day())...)
+		}
+	}
+
+	slices.SortFunc(out, func(a, b time.Time) int { return a.Compare(b) })
+	return out
+}
+
+// monthDays returns the days in the month starting at first selected by
+// BYMONTHDAY and BYDAY, or day itself when neither is set.
+func (r *Recurrence) monthDays(first time.Time, day int) []time.Time {
+	last := first.AddDate(0, 1, -1).Day()
+
+	var out []time.Time
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = last + d + 1
+			}
+			if d < 1 || d > last {
+				continue
+			}
+			if t := first.AddDate(0, 0, d-1); r.matchesWeekday(t) {
+				out = append(out, t)
+			}
+		}
+
+	case len(r.ByDay) > 0:
+		for _, bd := range r.ByDay {
+			var days []time.Time
+			for d := 1; d <= last; d++ {
+				if t := first.AddDate(0, 0, d-1); t.Weekday() == bd.Weekday {
+					days = append(days, t)
+				}
+			}
+
+			switch {
+			case bd.N == 0:
+				out = append(out, days...)
+			case bd.N > 0 && bd.N <= len(days):
+				out = append(out, days[bd.N-1])
+			case bd.N < 0 && -bd.N <= len(days):
+				out = append(out, days[len(days)+bd.N])
+			}
+		}
+
+	default:
+		if day <= last {
+			out = append(out, first.AddDate(0, 0, day-1))
+		}
+	}
+	return out
+}
+
+func (r *Recurrence) matchesMonth(t time.Time) bool {
+	return len(r.ByMonth) == 0 || slices.Contains(r.ByMonth, int(t.Month()))
+}
+
+func (r *Recurrence) matchesMonthDay(t time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	last := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	return slices.ContainsFunc(r.ByMonthDay, func(d int) bool {
+		return d == t.Day() || d < 0 && last+d+1 == t.Day()
+	})
+}
+
+// matchesWeekday filters by BYDAY, ignoring ordinals, which only apply when
+// BYDAY expands a month.
+func (r *Recurrence) matchesWeekday(t time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(r.ByDay, func(bd ByDay) bool { return bd.Weekday == t.Weekday() })
+}
+
+// selectPositions applies BYSETPOS to a period's sorted candidates.
+func selectPositions(candidates []time.Time, positions []int) []time.Time {
+	var out []time.Time
+	for _, p := range positions {
+		i := p - 1
+		if p < 0 {
+			i = len(candidates) + p
+		}
+		if i >= 0 && i < len(candidates) && !slices.Contains(out, candidates[i]) {
+			out = append(out, candidates[i])
+		}
+	}
+	slices.SortFunc(out, func(a, b time.Time) int { return a.Compare(b) })
+	return out
+}
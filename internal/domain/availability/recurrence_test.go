@@ -0,0 +1,175 @@
+package availability
+
+import (
+	"testing"
+	"time"
+)
+
+func day(year int, month time.Month, d, hour, minute int) time.Time {
+	return time.Date(year, month, d, hour, minute, 0, 0, time.UTC)
+}
+
+func until(t time.Time) *time.Time {
+	return &t
+}
+
+func TestRecurrenceEach(t *testing.T) {
+	// 2025-01-06 is a Monday.
+	start := day(2025, 1, 6, 9, 0)
+
+	tests := []struct {
+		name     string
+		rule     Recurrence
+		start    time.Time
+		from, to time.Time
+		want     []time.Time
+	}{
+		{
+			name:  "daily",
+			rule:  Recurrence{Freq: FrequencyDaily},
+			start: start,
+			from:  start,
+			to:    day(2025, 1, 9, 0, 0),
+			want:  []time.Time{day(2025, 1, 6, 9, 0), day(2025, 1, 7, 9, 0), day(2025, 1, 8, 9, 0)},
+		},
+		{
+			name:  "every other day",
+			rule:  Recurrence{Freq: FrequencyDaily, Interval: 2},
+			start: start,
+			from:  start,
+			to:    day(2025, 1, 11, 0, 0),
+			want:  []time.Time{day(2025, 1, 6, 9, 0), day(2025, 1, 8, 9, 0), day(2025, 1, 10, 9, 0)},
+		},
+		{
+			name:  "weekly on several days",
+			rule:  Recurrence{Freq: FrequencyWeekly, ByDay: []ByDay{{Weekday: time.Monday}, {Weekday: time.Thursday}}, WeekStart: time.Monday},
+			start: start,
+			from:  start,
+			to:    day(2025, 1, 17, 0, 0),
+			want:  []time.Time{day(2025, 1, 6, 9, 0), day(2025, 1, 9, 9, 0), day(2025, 1, 13, 9, 0), day(2025, 1, 16, 9, 0)},
+		},
+		{
+			name:  "last friday of the month",
+			rule:  Recurrence{Freq: FrequencyMonthly, ByDay: []ByDay{{Weekday: time.Friday, N: -1}}},
+			start: day(2025, 1, 31, 9, 0),
+			from:  day(2025, 1, 1, 0, 0),
+			to:    day(2025, 4, 1, 0, 0),
+			want:  []time.Time{day(2025, 1, 31, 9, 0), day(2025, 2, 28, 9, 0), day(2025, 3, 28, 9, 0)},
+		},
+		{
+			name:  "last weekday of the month",
+			rule:  Recurrence{Freq: FrequencyMonthly, ByDay: []ByDay{{Weekday: time.Monday}, {Weekday: time.Tuesday}, {Weekday: time.Wednesday}, {Weekday: time.Thursday}, {Weekday: time.Friday}}, BySetPos: []int{-1}},
+			start: day(2025, 5, 30, 9, 0),
+			from:  day(2025, 5, 1, 0, 0),
+			to:    day(2025, 7, 1, 0, 0),
+			want:  []time.Time{day(2025, 5, 30, 9, 0), day(2025, 6, 30, 9, 0)},
+		},
+		{
+			name:  "31st skips shorter months",
+			rule:  Recurrence{Freq: FrequencyMonthly},
+			start: day(2025, 1, 31, 9, 0),
+			from:  day(2025, 1, 1, 0, 0),
+			to:    day(2025, 5, 1, 0, 0),
+			want:  []time.Time{day(2025, 1, 31, 9, 0), day(2025, 3, 31, 9, 0)},
+		},
+		{
+			name:  "yearly",
+			rule:  Recurrence{Freq: FrequencyYearly},
+			start: day(2024, 2, 29, 9, 0),
+			from:  day(2024, 1, 1, 0, 0),
+			to:    day(2029, 1, 1, 0, 0),
+			want:  []time.Time{day(2024, 2, 29, 9, 0), day(2028, 2, 29, 9, 0)},
+		},
+		{
+			name:  "count",
+			rule:  Recurrence{Freq: FrequencyDaily, Count: 2},
+			start: start,
+			from:  start,
+			to:    day(2025, 2, 1, 0, 0),
+			want:  []time.Time{day(2025, 1, 6, 9, 0), day(2025, 1, 7, 9, 0)},
+		},
+		{
+			name:  "until is inclusive",
+			rule:  Recurrence{Freq: FrequencyDaily, Until: until(day(2025, 1, 7, 9, 0))},
+			start: start,
+			from:  start,
+			to:    day(2025, 2, 1, 0, 0),
+			want:  []time.Time{day(2025, 1, 6, 9, 0), day(2025, 1, 7, 9, 0)},
+		},
+		{
+			name:  "skips ahead to the window",
+			rule:  Recurrence{Freq: FrequencyWeekly, WeekStart: time.Monday},
+			start: day(2000, 1, 3, 9, 0),
+			from:  day(2025, 1, 6, 0, 0),
+			to:    day(2025, 1, 14, 0, 0),
+			want:  []time.Time{day(2025, 1, 6, 9, 0), day(2025, 1, 13, 9, 0)},
+		},
+		{
+			name:  "filters that never match",
+			rule:  Recurrence{Freq: FrequencyYearly, ByMonth: []int{2}, ByMonthDay: []int{30}},
+			start: start,
+			from:  start,
+			to:    day(2030, 1, 1, 0, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []time.Time
+			tt.rule.each(tt.start, tt.from, tt.to, func(occ time.Time) bool {
+				if !occ.Before(tt.to) {
+					return false
+				}
+				if !occ.Before(tt.from) {
+					got = append(got, occ)
+				}
+				return true
+			})
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("occurrences = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrenceEachStopsAfterWindow(t *testing.T) {
+	rule := Recurrence{Freq: FrequencyDaily, ByMonth: []int{12}}
+	start := day(2025, 1, 1, 9, 0)
+
+	calls := 0
+	rule.each(start, start, day(2025, 3, 1, 0, 0), func(time.Time) bool {
+		calls++
+		return true
+	})
+	if calls != 0 {
+		t.Errorf("yielded %d occurrences past the window", calls)
+	}
+}
+
+func TestRecurrenceBound(t *testing.T) {
+	start := day(2025, 1, 6, 9, 0)
+
+	rule := Recurrence{Freq: FrequencyWeekly, Count: 3, WeekStart: time.Monday}
+	if !rule.Bound(start) {
+		t.Fatal("Bound reported no occurrences")
+	}
+	if rule.Count != 0 || rule.Until == nil || !rule.Until.Equal(day(2025, 1, 20, 9, 0)) {
+		t.Errorf("bound rule = count %d until %v, want until 2025-01-20 09:00", rule.Count, rule.Until)
+	}
+
+	open := Recurrence{Freq: FrequencyDaily}
+	if !open.Bound(start) || open.Until != nil {
+		t.Errorf("rule without COUNT changed: until %v", open.Until)
+	}
+
+	never := Recurrence{Freq: FrequencyYearly, ByMonth: []int{2}, ByMonthDay: []int{30}, Count: 1}
+	if never.Bound(start) {
+		t.Error("Bound reported occurrences for a rule that never matches")
+	}
+}
@@ -2,6 +2,7 @@ package availability
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,6 +11,7 @@ type Repository interface {
 	CreateOrUpdate(ctx context.Context, availability *Availability) error
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*Availability, error)
 	GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*Availability, error)
+	ListDueTransitions(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error)
 
 	CreateWindow(ctx context.Context, window *Window) error
 	GetWindowByID(ctx context.Context, id uuid.UUID) (*Window, error)
@@ -25,4 +27,12 @@ type Repository interface {
 	DeleteAutoRule(ctx context.Context, id uuid.UUID) error
 	ListAutoRulesByUser(ctx context.Context, userID uuid.UUID) ([]*AutoRule, error)
 	ListActiveAutoRulesByUser(ctx context.Context, userID uuid.UUID) ([]*AutoRule, error)
+
+	CreateCalendar(ctx context.Context, calendar *Calendar) error
+	GetCalendarByID(ctx context.Context, id uuid.UUID) (*Calendar, error)
+	UpdateCalendar(ctx context.Context, calendar *Calendar) error
+	DeleteCalendar(ctx context.Context, id uuid.UUID) error
+	ListCalendarsByUser(ctx context.Context, userID uuid.UUID) ([]*Calendar, error)
+	ListActiveCalendarsByUser(ctx context.Context, userID uuid.UUID) ([]*Calendar, error)
+	ListCalendarsDueSync(ctx context.Context, syncedBefore time.Time, limit int) ([]*Calendar, error)
 }
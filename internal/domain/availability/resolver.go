@@ -0,0 +1,255 @@
+package availability
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// resolveHorizon bounds the search for the next status change. Windows and
+// time-range rules repeat weekly, so anything further out never changes.
+const resolveHorizon = 8 * 24 * time.Hour
+
+type Source string
+
+const (
+	SourceManual  Source = "manual"
+	SourceRule    Source = "rule"
+	SourceWindow  Source = "window"
+	SourceDefault Source = "default"
+)
+
+// ResolveInput is everything that contributes to a user's effective status.
+// Inactive windows and rules are ignored.
+type ResolveInput struct {
+	Availability *Availability
+	Windows      []*Window
+	Rules        []*AutoRule
+	Calendars    []*Calendar
+	Location     *time.Location // The user's timezone; nil means UTC
+}
+
+type Resolution struct {
+	Status     Status
+	Source     Source
+	SourceID   *uuid.UUID // The window or rule that produced the status
+	Message    *string    // Only set for manual statuses
+	NextChange *time.Time // Nil when the status will not change on its own
+}
+
+// Resolve computes the effective status at now. A manual status wins until it
+// expires, then active rules in priority order, then weekly windows, and
+// finally StatusFree. Calendar rules match while an event from an imported
+// calendar is in progress. Windows and rules are evaluated against wall-clock time
+// in the user's timezone, so a 09:00 window starts at 09:00 local time on
+// either side of a DST change.
+func Resolve(in ResolveInput, now time.Time) Resolution {
+	loc := in.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	rules := slices.Clone(in.Rules)
+	slices.SortStableFunc(rules, func(a, b *AutoRule) int {
+		return cmp.Compare(b.Priority, a.Priority)
+	})
+
+	busy := calendarBusy(in.Calendars, loc, now.Add(-24*time.Hour), now.Add(resolveHorizon+24*time.Hour))
+
+	r := resolveAt(in.Availability, in.Windows, rules, busy, now.In(loc))
+	r.NextChange = nextChange(in, rules, busy, loc, now, r.Status)
+	return r
+}
+
+func resolveAt(a *Availability, windows []*Window, rules []*AutoRule, busy map[uuid.UUID][]span, t time.Time) Resolution {
+	if a != nil && !a.AutoStatus && !a.IsManualExpiredAt(t) {
+		return Resolution{Status: a.Status, Source: SourceManual, Message: a.StatusMessage}
+	}
+
+	var place *uuid.UUID
+	if a != nil {
+		place = a.CurrentPlaceID
+	}
+	for _, rule := range rules {
+		if rule.IsActive && rule.Condition.matchesAt(t, place, busy) {
+			return Resolution{Status: rule.TargetStatus, Source: SourceRule, SourceID: &rule.ID}
+		}
+	}
+
+	for _, w := range windows {
+		if w.IsActive && w.containsAt(t) {
+			return Resolution{Status: w.Status, Source: SourceWindow, SourceID: &w.ID}
+		}
+	}
+
+	return Resolution{Status: StatusFree, Source: SourceDefault}
+}
+
+// nextChange walks the upcoming boundaries in order and returns the first one
+// at which the effective status differs from current.
+func nextChange(in ResolveInput, rules []*AutoRule, busy map[uuid.UUID][]span, loc *time.Location, now time.Time, current Status) *time.Time {
+	manual := in.Availability != nil && !in.Availability.AutoStatus && !in.Availability.IsManualExpiredAt(now)
+	if manual && in.Availability.ManualUntil == nil {
+		return nil
+	}
+
+	var candidates []time.Time
+	if manual {
+		candidates = append(candidates, *in.Availability.ManualUntil)
+	}
+
+	local := now.In(loc)
+	for day := -1; day <= int(resolveHorizon/(24*time.Hour)); day++ {
+		date := time.Date(local.Year(), local.Month(), local.Day()+day, 0, 0, 0, 0, loc)
+
+		for _, w := range in.Windows {
+			if !w.IsActive || Weekday(date.Weekday()) != w.Weekday {
+				continue
+			}
+			candidates = append(candidates, boundaries(date, w.StartTime, w.EndTime)...)
+		}
+
+		for _, rule := range rules {
+			c := rule.Condition
+			if !rule.IsActive || c.Type != ConditionTypeTimeRange || c.StartTime == nil || c.EndTime == nil {
+				continue
+			}
+			if !c.appliesOn(Weekday(date.Weekday())) {
+				continue
+			}
+			candidates = append(candidates, boundaries(date, *c.StartTime, *c.EndTime)...)
+		}
+	}
+
+	for _, spans := range busy {
+		for _, s := range spans {
+			candidates = append(candidates, s.start, s.end)
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b time.Time) int { return a.Compare(b) })
+
+	horizon := now.Add(resolveHorizon)
+	for _, t := range candidates {
+		if !t.After(now) || t.After(horizon) {
+			continue
+		}
+		if resolveAt(in.Availability, in.Windows, rules, busy, t.In(loc)).Status != current {
+			return &t
+		}
+	}
+	return nil
+}
+
+// boundaries returns the instants at which a start–end range beginning on
+// date opens and closes. Ranges whose end is not after their start close on
+// the following day.
+func boundaries(date time.Time, startTime, endTime string) []time.Time {
+	start, err := ParseClock(startTime)
+	if err != nil {
+		return nil
+	}
+	end, err := ParseClock(endTime)
+	if err != nil {
+		return nil
+	}
+
+	endDate := date
+	if end <= start {
+		endDate = date.AddDate(0, 0, 1)
+	}
+	return []time.Time{wallClock(date, start), wallClock(endDate, end)}
+}
+
+// wallClock returns the first instant on date whose local time is at least
+// minutes past midnight. When that time is skipped by a DST gap, it is the
+// moment the clocks jump forward.
+func wallClock(date time.Time, minutes int) time.Time {
+	t := time.Date(date.Year(), date.Month(), date.Day(), minutes/60, minutes%60, 0, 0, date.Location())
+
+	// time.Date may normalize a skipped time to either side of the gap; the
+	// transition itself is the zone boundary between the two.
+	switch wall := t.Hour()*60 + t.Minute(); {
+	case wall < minutes:
+		if _, end := t.ZoneBounds(); !end.IsZero() {
+			return end
+		}
+	case wall > minutes:
+		if start, _ := t.ZoneBounds(); !start.IsZero() {
+			return start
+		}
+	}
+	return t
+}
+
+// containsAt reports whether the local time t falls inside the window.
+func (w *Window) containsAt(t time.Time) bool {
+	start, err := ParseClock(w.StartTime)
+	if err != nil {
+		return false
+	}
+	end, err := ParseClock(w.EndTime)
+	if err != nil {
+		return false
+	}
+	return inRange(Weekday(t.Weekday()), t.Hour()*60+t.Minute(), []Weekday{w.Weekday}, start, end)
+}
+
+// matchesAt reports whether the condition holds at local time t while the
+// user is at place, which is nil when they are not at any known place, given
+// the busy periods of each of their calendars.
+func (c Condition) matchesAt(t time.Time, place *uuid.UUID, busy map[uuid.UUID][]span) bool {
+	switch c.Type {
+	case ConditionTypeLocation:
+		return c.PlaceID != nil && place != nil && *c.PlaceID == *place
+	case ConditionTypeCalendar:
+		for id, spans := range busy {
+			if c.CalendarID != nil && *c.CalendarID != id {
+				continue
+			}
+			for _, s := range spans {
+				if !t.Before(s.start) && t.Before(s.end) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if c.Type != ConditionTypeTimeRange || c.StartTime == nil || c.EndTime == nil {
+		return false
+	}
+	start, err := ParseClock(*c.StartTime)
+	if err != nil {
+		return false
+	}
+	end, err := ParseClock(*c.EndTime)
+	if err != nil {
+		return false
+	}
+
+	days := c.Weekdays
+	if len(days) == 0 {
+		days = []Weekday{Sunday, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday}
+	}
+	return inRange(Weekday(t.Weekday()), t.Hour()*60+t.Minute(), days, start, end)
+}
+
+// appliesOn reports whether a range starting on day is covered by the
+// condition. An empty weekday list means every day.
+func (c Condition) appliesOn(day Weekday) bool {
+	return len(c.Weekdays) == 0 || slices.Contains(c.Weekdays, day)
+}
+
+// inRange reports whether minute on day falls in a start–end range that
+// begins on one of days. Ranges crossing midnight continue into the next day.
+func inRange(day Weekday, minute int, days []Weekday, start, end int) bool {
+	if start < end {
+		return slices.Contains(days, day) && minute >= start && minute < end
+	}
+	if slices.Contains(days, day) && minute >= start {
+		return true
+	}
+	return slices.Contains(days, (day+6)%7) && minute < end
+}
@@ -0,0 +1,315 @@
+package availability
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load location %s: %v", name, err)
+	}
+	return loc
+}
+
+func window(day Weekday, start, end string, status Status) *Window {
+	return NewWindow(uuid.Nil, "window", day, start, end, status)
+}
+
+func timeRule(start, end string, status Status, priority int, days ...Weekday) *AutoRule {
+	return NewAutoRule(uuid.Nil, "rule", Condition{
+		Type:      ConditionTypeTimeRange,
+		StartTime: &start,
+		EndTime:   &end,
+		Weekdays:  days,
+	}, status, priority)
+}
+
+func manual(status Status, until *time.Time) *Availability {
+	a := NewAvailability(uuid.Nil)
+	a.Status = status
+	a.AutoStatus = false
+	a.ManualUntil = until
+	return a
+}
+
+func at(loc *time.Location, year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, loc)
+}
+
+func TestResolve(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+
+	// 2025-06-02 is a Monday.
+	monday10 := at(time.UTC, 2025, 6, 2, 10, 0)
+	noon := at(time.UTC, 2025, 6, 2, 12, 0)
+
+	workday := window(Monday, "09:00", "17:00", StatusBusy)
+	late := window(Friday, "22:00", "02:00", StatusSleeping)
+	first := timeRule("09:00", "17:00", StatusDoNotDisturb, 5)
+	second := timeRule("09:00", "17:00", StatusAway, 5)
+	low := timeRule("00:00", "23:59", StatusBusy, 1)
+	high := timeRule("09:00", "11:00", StatusAway, 10)
+	quiet := timeRule("22:00", "07:00", StatusDoNotDisturb, math.MaxInt)
+	evening := timeRule("20:00", "23:30", StatusBusy, 100)
+	short := window(Monday, "09:00", "10:00", StatusBusy)
+	gap := window(Sunday, "02:30", "04:00", StatusBusy)
+	overlap := window(Sunday, "01:30", "03:00", StatusBusy)
+	idle := window(Monday, "09:00", "17:00", StatusFree)
+
+	tests := []struct {
+		name       string
+		in         ResolveInput
+		now        time.Time
+		wantStatus Status
+		wantSource Source
+		wantID     *uuid.UUID
+		wantNext   *time.Time
+	}{
+		{
+			name:       "default",
+			now:        monday10,
+			wantStatus: StatusFree,
+			wantSource: SourceDefault,
+		},
+		{
+			name:       "inside window",
+			in:         ResolveInput{Windows: []*Window{workday}},
+			now:        monday10,
+			wantStatus: StatusBusy,
+			wantSource: SourceWindow,
+			wantID:     &workday.ID,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 2, 17, 0)),
+		},
+		{
+			name:       "priority tie keeps rule order",
+			in:         ResolveInput{Rules: []*AutoRule{first, second}},
+			now:        monday10,
+			wantStatus: StatusDoNotDisturb,
+			wantSource: SourceRule,
+			wantID:     &first.ID,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 2, 17, 0)),
+		},
+		{
+			name:       "higher priority wins regardless of order",
+			in:         ResolveInput{Rules: []*AutoRule{low, high}},
+			now:        monday10,
+			wantStatus: StatusAway,
+			wantSource: SourceRule,
+			wantID:     &high.ID,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 2, 11, 0)),
+		},
+		{
+			name:       "rule outranks window",
+			in:         ResolveInput{Windows: []*Window{workday}, Rules: []*AutoRule{high}},
+			now:        monday10,
+			wantStatus: StatusAway,
+			wantSource: SourceRule,
+			wantID:     &high.ID,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 2, 11, 0)),
+		},
+		{
+			name:       "window crossing midnight continues into next day",
+			in:         ResolveInput{Windows: []*Window{late}},
+			now:        at(time.UTC, 2025, 6, 7, 1, 0), // Saturday
+			wantStatus: StatusSleeping,
+			wantSource: SourceWindow,
+			wantID:     &late.ID,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 7, 2, 0)),
+		},
+		{
+			name:       "window crossing midnight not yet started",
+			in:         ResolveInput{Windows: []*Window{late}},
+			now:        at(time.UTC, 2025, 6, 6, 21, 0), // Friday
+			wantStatus: StatusFree,
+			wantSource: SourceDefault,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 6, 22, 0)),
+		},
+		{
+			name:       "quiet hours override other rules and windows",
+			in:         ResolveInput{Windows: []*Window{window(Monday, "20:00", "23:59", StatusBusy)}, Rules: []*AutoRule{evening, quiet}},
+			now:        at(time.UTC, 2025, 6, 2, 22, 30),
+			wantStatus: StatusDoNotDisturb,
+			wantSource: SourceRule,
+			wantID:     &quiet.ID,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 3, 7, 0)),
+		},
+		{
+			name:       "manual status outranks quiet hours",
+			in:         ResolveInput{Availability: manual(StatusFree, nil), Rules: []*AutoRule{quiet}},
+			now:        at(time.UTC, 2025, 6, 2, 23, 0),
+			wantStatus: StatusFree,
+			wantSource: SourceManual,
+		},
+		{
+			name:       "manual status until expiry",
+			in:         ResolveInput{Availability: manual(StatusBusy, &noon)},
+			now:        monday10,
+			wantStatus: StatusBusy,
+			wantSource: SourceManual,
+			wantNext:   &noon,
+		},
+		{
+			name:       "expired manual status falls through",
+			in:         ResolveInput{Availability: manual(StatusBusy, &noon), Windows: []*Window{workday}},
+			now:        noon,
+			wantStatus: StatusBusy,
+			wantSource: SourceWindow,
+			wantID:     &workday.ID,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 2, 17, 0)),
+		},
+		{
+			name:       "next change a week out",
+			in:         ResolveInput{Windows: []*Window{short}},
+			now:        at(time.UTC, 2025, 6, 2, 10, 30),
+			wantStatus: StatusFree,
+			wantSource: SourceDefault,
+			wantNext:   ptr(at(time.UTC, 2025, 6, 9, 9, 0)),
+		},
+		{
+			name:       "window matching the default never changes the status",
+			in:         ResolveInput{Windows: []*Window{idle}},
+			now:        monday10,
+			wantStatus: StatusFree,
+			wantSource: SourceWindow,
+			wantID:     &idle.ID,
+		},
+		{
+			name:       "window starting in a DST gap opens when clocks jump",
+			in:         ResolveInput{Windows: []*Window{gap}, Location: newYork},
+			now:        at(newYork, 2025, 3, 9, 1, 0),
+			wantStatus: StatusFree,
+			wantSource: SourceDefault,
+			wantNext:   ptr(time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC)), // 03:00 EDT
+		},
+		{
+			name:       "window starting in a DST overlap opens at the first occurrence",
+			in:         ResolveInput{Windows: []*Window{overlap}, Location: newYork},
+			now:        at(newYork, 2025, 11, 2, 0, 0),
+			wantStatus: StatusFree,
+			wantSource: SourceDefault,
+			wantNext:   ptr(time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC)), // 01:30 EDT
+		},
+		{
+			name:       "windows follow local time in the user's timezone",
+			in:         ResolveInput{Windows: []*Window{workday}, Location: newYork},
+			now:        time.Date(2025, 6, 2, 14, 0, 0, 0, time.UTC), // 10:00 EDT
+			wantStatus: StatusBusy,
+			wantSource: SourceWindow,
+			wantID:     &workday.ID,
+			wantNext:   ptr(time.Date(2025, 6, 2, 21, 0, 0, 0, time.UTC)), // 17:00 EDT
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Resolve(tt.in, tt.now)
+			if got.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", got.Status, tt.wantStatus)
+			}
+			if got.Source != tt.wantSource {
+				t.Errorf("source = %s, want %s", got.Source, tt.wantSource)
+			}
+			if !equalPtr(got.SourceID, tt.wantID, func(a, b uuid.UUID) bool { return a == b }) {
+				t.Errorf("source ID = %v, want %v", got.SourceID, tt.wantID)
+			}
+			if !equalPtr(got.NextChange, tt.wantNext, time.Time.Equal) {
+				t.Errorf("next change = %v, want %v", got.NextChange, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestWallClock(t *testing.T) {
+	newYork := loadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		minutes int
+		want    time.Time
+	}{
+		{
+			name:    "ordinary day",
+			date:    at(newYork, 2025, 6, 2, 0, 0),
+			minutes: 9 * 60,
+			want:    time.Date(2025, 6, 2, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "skipped time moves to the jump",
+			date:    at(newYork, 2025, 3, 9, 0, 0),
+			minutes: 2*60 + 30,
+			want:    time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "time after the gap",
+			date:    at(newYork, 2025, 3, 9, 0, 0),
+			minutes: 3 * 60,
+			want:    time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "repeated time is its first occurrence",
+			date:    at(newYork, 2025, 11, 2, 0, 0),
+			minutes: 60 + 30,
+			want:    time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "time after the overlap",
+			date:    at(newYork, 2025, 11, 2, 0, 0),
+			minutes: 2 * 60,
+			want:    time.Date(2025, 11, 2, 7, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wallClock(tt.date, tt.minutes); !got.Equal(tt.want) {
+				t.Errorf("wallClock = %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestInRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		day        Weekday
+		minute     int
+		days       []Weekday
+		start, end int
+		want       bool
+	}{
+		{"inside same-day range", Monday, 10 * 60, []Weekday{Monday}, 9 * 60, 17 * 60, true},
+		{"end is exclusive", Monday, 17 * 60, []Weekday{Monday}, 9 * 60, 17 * 60, false},
+		{"other day", Tuesday, 10 * 60, []Weekday{Monday}, 9 * 60, 17 * 60, false},
+		{"before midnight", Friday, 23 * 60, []Weekday{Friday}, 22 * 60, 2 * 60, true},
+		{"after midnight", Saturday, 60, []Weekday{Friday}, 22 * 60, 2 * 60, true},
+		{"after the end on the next day", Saturday, 3 * 60, []Weekday{Friday}, 22 * 60, 2 * 60, false},
+		{"after midnight of a day not listed", Friday, 60, []Weekday{Friday}, 22 * 60, 2 * 60, false},
+		{"saturday night into sunday", Sunday, 60, []Weekday{Saturday}, 22 * 60, 2 * 60, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inRange(tt.day, tt.minute, tt.days, tt.start, tt.end); got != tt.want {
+				t.Errorf("inRange = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func equalPtr[T any](a, b *T, eq func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return eq(*a, *b)
+}
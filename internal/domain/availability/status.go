@@ -35,6 +35,8 @@ type Condition struct {
 	Weekdays  []Weekday `json:"weekdays,omitempty"`
 
 	PlaceID *uuid.UUID `json:"place_id,omitempty"` // Reference to a user-defined place
+
+	CalendarID *uuid.UUID `json:"calendar_id,omitempty"` // Nil matches events in any calendar
 }
 
 func NewAutoRule(userID uuid.UUID, name string, condition Condition, targetStatus Status, priority int) *AutoRule {
@@ -64,3 +66,39 @@ func (r *AutoRule) Update(name string, condition Condition, targetStatus Status,
 	r.Priority = priority
 	r.UpdatedAt = time.Now()
 }
+
+func (r *AutoRule) Validate() error {
+	if !IsValidStatus(r.TargetStatus) {
+		return ErrInvalidStatus
+	}
+	return r.Condition.Validate()
+}
+
+func (c Condition) Validate() error {
+	for _, d := range c.Weekdays {
+		if !IsValidWeekday(d) {
+			return ErrInvalidWeekday
+		}
+	}
+
+	switch c.Type {
+	case ConditionTypeTimeRange:
+		if c.StartTime == nil || c.EndTime == nil {
+			return ErrInvalidCondition
+		}
+		if _, err := ParseClock(*c.StartTime); err != nil {
+			return err
+		}
+		if _, err := ParseClock(*c.EndTime); err != nil {
+			return err
+		}
+	case ConditionTypeLocation:
+		if c.PlaceID == nil {
+			return ErrInvalidCondition
+		}
+	case ConditionTypeCalendar:
+	default:
+		return ErrInvalidCondition
+	}
+	return nil
+}
@@ -69,3 +69,70 @@ func IsValidWeekday(d Weekday) bool {
 func WeekdayFromTime(t time.Time) Weekday {
 	return Weekday(t.Weekday())
 }
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+// ParseClock parses an HH:MM time of day into minutes since midnight.
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil || len(s) != 5 {
+		return 0, ErrInvalidTimeFormat
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Validate checks the window's weekday, times and status. A window whose end
+// time is earlier than its start time runs past midnight into the next day.
+func (w *Window) Validate() error {
+	if !IsValidWeekday(w.Weekday) {
+		return ErrInvalidWeekday
+	}
+	if !IsValidStatus(w.Status) {
+		return ErrInvalidStatus
+	}
+	start, err := ParseClock(w.StartTime)
+	if err != nil {
+		return err
+	}
+	end, err := ParseClock(w.EndTime)
+	if err != nil {
+		return err
+	}
+	if start == end {
+		return ErrEmptyWindow
+	}
+	return nil
+}
+
+// Overlaps reports whether the two windows share any minute of the week.
+// Both windows must be valid.
+func (w *Window) Overlaps(other *Window) bool {
+	aStart, aEnd := w.weekRange()
+	bStart, bEnd := other.weekRange()
+
+	// A window starting late on Saturday can run into Sunday, so compare
+	// against the other window shifted by a week in both directions.
+	for _, shift := range []int{-minutesPerWeek, 0, minutesPerWeek} {
+		if aStart < bEnd+shift && bStart+shift < aEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// weekRange returns the window as a half-open range of minutes from the start
+// of Sunday. The end may exceed minutesPerWeek for windows crossing midnight
+// on Saturday.
+func (w *Window) weekRange() (int, int) {
+	start, _ := ParseClock(w.StartTime)
+	end, _ := ParseClock(w.EndTime)
+
+	offset := int(w.Weekday) * minutesPerDay
+	if end <= start {
+		end += minutesPerDay
+	}
+	return offset + start, offset + end
+}
@@ -0,0 +1,30 @@
+package circle
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	EventTypeMemberAdded        = "circle.member_added"
+	EventTypeInvitationAccepted = "circle.invitation_accepted"
+)
+
+type MemberAdded struct {
+	CircleID uuid.UUID  `json:"circle_id"`
+	UserID   uuid.UUID  `json:"user_id"`
+	Role     MemberRole `json:"role"`
+}
+
+func (e MemberAdded) EventType() string      { return EventTypeMemberAdded }
+func (e MemberAdded) AggregateType() string  { return "circle" }
+func (e MemberAdded) AggregateID() uuid.UUID { return e.CircleID }
+
+type InvitationAccepted struct {
+	InvitationID uuid.UUID `json:"invitation_id"`
+	CircleID     uuid.UUID `json:"circle_id"`
+	UserID       uuid.UUID `json:"user_id"`
+}
+
+func (e InvitationAccepted) EventType() string      { return EventTypeInvitationAccepted }
+func (e InvitationAccepted) AggregateType() string  { return "circle" }
+func (e InvitationAccepted) AggregateID() uuid.UUID { return e.CircleID }
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"time"
 
+	"github.com/danielng/kin-core-svc/internal/domain/event"
 	"github.com/danielng/kin-core-svc/pkg/uid"
 	"github.com/google/uuid"
 )
@@ -38,6 +39,8 @@ type Invitation struct {
 	ExpiresAt *time.Time       `json:"expires_at,omitempty"`
 	CreatedAt time.Time        `json:"created_at"`
 	UpdatedAt time.Time        `json:"updated_at"`
+
+	event.Recorder `json:"-"`
 }
 
 func NewDirectInvitation(circleID, inviterID, inviteeID uuid.UUID, expiresAt *time.Time) *Invitation {
@@ -98,12 +101,13 @@ func (i *Invitation) IsExpired() bool {
 	return false
 }
 
-func (i *Invitation) Accept() {
+func (i *Invitation) Accept(userID uuid.UUID) {
 	i.UseCount++
 	if i.Type == InvitationTypeDirect || (i.MaxUses != nil && i.UseCount >= *i.MaxUses) {
 		i.Status = InvitationStatusAccepted
 	}
 	i.UpdatedAt = time.Now()
+	i.Record(InvitationAccepted{InvitationID: i.ID, CircleID: i.CircleID, UserID: userID})
 }
 
 func (i *Invitation) Revoke() {
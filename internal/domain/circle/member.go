@@ -3,6 +3,7 @@ package circle
 import (
 	"time"
 
+	"github.com/danielng/kin-core-svc/internal/domain/event"
 	"github.com/danielng/kin-core-svc/pkg/uid"
 	"github.com/google/uuid"
 )
@@ -22,11 +23,13 @@ type Member struct {
 	Nickname  *string    `json:"nickname,omitempty"` // Circle-specific nickname
 	JoinedAt  time.Time  `json:"joined_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
+
+	event.Recorder `json:"-"`
 }
 
 func NewMember(circleID, userID uuid.UUID, role MemberRole) *Member {
 	now := time.Now()
-	return &Member{
+	m := &Member{
 		ID:        uid.New(),
 		CircleID:  circleID,
 		UserID:    userID,
@@ -34,6 +37,8 @@ func NewMember(circleID, userID uuid.UUID, role MemberRole) *Member {
 		JoinedAt:  now,
 		UpdatedAt: now,
 	}
+	m.Record(MemberAdded{CircleID: circleID, UserID: userID, Role: role})
+	return m
 }
 
 func (m *Member) IsAdmin() bool {
@@ -3,45 +3,40 @@ package circle
 import (
 	"time"
 
+	"github.com/danielng/kin-core-svc/internal/domain/location"
 	"github.com/danielng/kin-core-svc/internal/domain/user"
 	"github.com/danielng/kin-core-svc/pkg/uid"
 	"github.com/google/uuid"
 )
 
+// SharingPreference is what a member shares with the rest of a circle. Each
+// Share flag only takes effect when PrivacyLevel reaches the tier of that
+// data; see privacy.Projector.
 type SharingPreference struct {
-	ID                uuid.UUID         `json:"id"`
-	CircleID          uuid.UUID         `json:"circle_id"`
-	UserID            uuid.UUID         `json:"user_id"`
-	PrivacyLevel      user.PrivacyLevel `json:"privacy_level"`
-	ShareTimezone     bool              `json:"share_timezone"`
-	ShareAvailability bool              `json:"share_availability"`
-	ShareLocation     bool              `json:"share_location"`
-	LocationPrecision LocationPrecision `json:"location_precision"`
-	ShareActivity     bool              `json:"share_activity"`
-	CreatedAt         time.Time         `json:"created_at"`
-	UpdatedAt         time.Time         `json:"updated_at"`
+	ID                uuid.UUID          `json:"id"`
+	CircleID          uuid.UUID          `json:"circle_id"`
+	UserID            uuid.UUID          `json:"user_id"`
+	PrivacyLevel      user.PrivacyLevel  `json:"privacy_level"`
+	ShareTimezone     bool               `json:"share_timezone"`
+	ShareAvailability bool               `json:"share_availability"`
+	ShareLocation     bool               `json:"share_location"`
+	LocationPrecision location.Precision `json:"location_precision"`
+	ShareActivity     bool               `json:"share_activity"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
 }
 
-type LocationPrecision string
-
-const (
-	LocationPrecisionCountry      LocationPrecision = "country"
-	LocationPrecisionCity         LocationPrecision = "city"
-	LocationPrecisionNeighborhood LocationPrecision = "neighborhood"
-	LocationPrecisionExact        LocationPrecision = "exact"
-)
-
 func NewSharingPreference(circleID, userID uuid.UUID) *SharingPreference {
 	now := time.Now()
 	return &SharingPreference{
 		ID:                uid.New(),
 		CircleID:          circleID,
 		UserID:            userID,
-		PrivacyLevel:      user.PrivacyLevelBasic,
+		PrivacyLevel:      user.PrivacyLevelStatus,
 		ShareTimezone:     true,
 		ShareAvailability: true,
 		ShareLocation:     false,
-		LocationPrecision: LocationPrecisionCity,
+		LocationPrecision: location.PrecisionCity,
 		ShareActivity:     false,
 		CreatedAt:         now,
 		UpdatedAt:         now,
@@ -53,17 +48,8 @@ func (sp *SharingPreference) SetPrivacyLevel(level user.PrivacyLevel) {
 	sp.UpdatedAt = time.Now()
 }
 
-func (sp *SharingPreference) SetLocationSharing(share bool, precision LocationPrecision) {
+func (sp *SharingPreference) SetLocationSharing(share bool, precision location.Precision) {
 	sp.ShareLocation = share
 	sp.LocationPrecision = precision
 	sp.UpdatedAt = time.Now()
 }
-
-func IsValidLocationPrecision(precision LocationPrecision) bool {
-	switch precision {
-	case LocationPrecisionCountry, LocationPrecisionCity, LocationPrecisionNeighborhood, LocationPrecisionExact:
-		return true
-	default:
-		return false
-	}
-}
@@ -3,6 +3,7 @@ package contact
 import (
 	"time"
 
+	"github.com/danielng/kin-core-svc/internal/domain/event"
 	"github.com/danielng/kin-core-svc/pkg/uid"
 	"github.com/google/uuid"
 )
@@ -59,6 +60,8 @@ type ContactRequest struct {
 	Status     ContactRequestStatus `json:"status"`
 	CreatedAt  time.Time            `json:"created_at"`
 	UpdatedAt  time.Time            `json:"updated_at"`
+
+	event.Recorder `json:"-"`
 }
 
 type ContactRequestStatus string
@@ -71,7 +74,7 @@ const (
 
 func NewContactRequest(fromUserID, toUserID uuid.UUID, message *string) *ContactRequest {
 	now := time.Now()
-	return &ContactRequest{
+	cr := &ContactRequest{
 		ID:         uid.New(),
 		FromUserID: fromUserID,
 		ToUserID:   toUserID,
@@ -80,6 +83,8 @@ func NewContactRequest(fromUserID, toUserID uuid.UUID, message *string) *Contact
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
+	cr.Record(ContactRequestCreated{RequestID: cr.ID, FromUserID: fromUserID, ToUserID: toUserID})
+	return cr
 }
 
 func (cr *ContactRequest) Accept() {
@@ -20,19 +20,19 @@ var (
 	)
 
 	ErrContactRequestNotFound = apperror.New(
-		apperror.CodeNotFound,
+		apperror.CodeContactRequestNotFound,
 		"contact request not found",
 		http.StatusNotFound,
 	)
 
 	ErrContactRequestAlreadyExists = apperror.New(
-		apperror.CodeConflict,
+		apperror.CodeContactRequestExists,
 		"contact request already exists",
 		http.StatusConflict,
 	)
 
 	ErrContactRequestNotPending = apperror.New(
-		apperror.CodeBadRequest,
+		apperror.CodeContactRequestNotPending,
 		"contact request is not pending",
 		http.StatusBadRequest,
 	)
@@ -43,8 +43,22 @@ var (
 		http.StatusBadRequest,
 	)
 
-	ErrContactBlocked = apperror.New(
+	ErrCannotBlockSelf = apperror.New(
+		apperror.CodeBadRequest,
+		"cannot block yourself",
+		http.StatusBadRequest,
+	)
+
+	// ErrUserUnavailable is returned to a user who has been blocked, without
+	// revealing the block.
+	ErrUserUnavailable = apperror.New(
 		apperror.CodeForbidden,
+		"this action is not available",
+		http.StatusForbidden,
+	)
+
+	ErrContactBlocked = apperror.New(
+		apperror.CodeContactBlocked,
 		"contact is blocked",
 		http.StatusForbidden,
 	)
@@ -0,0 +1,17 @@
+package contact
+
+import (
+	"github.com/google/uuid"
+)
+
+const EventTypeContactRequestCreated = "contact.request_created"
+
+type ContactRequestCreated struct {
+	RequestID  uuid.UUID `json:"request_id"`
+	FromUserID uuid.UUID `json:"from_user_id"`
+	ToUserID   uuid.UUID `json:"to_user_id"`
+}
+
+func (e ContactRequestCreated) EventType() string      { return EventTypeContactRequestCreated }
+func (e ContactRequestCreated) AggregateType() string  { return "contact_request" }
+func (e ContactRequestCreated) AggregateID() uuid.UUID { return e.RequestID }
@@ -0,0 +1,59 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BlockPolicy is the single place that decides whether a block between two
+// users prevents an interaction. A block applies in both directions.
+type BlockPolicy struct {
+	repo Repository
+}
+
+func NewBlockPolicy(repo Repository) *BlockPolicy {
+	return &BlockPolicy{repo: repo}
+}
+
+// Check reports whether actorID may interact with targetID. An actor who
+// placed the block is told so; an actor who has been blocked receives
+// ErrUserUnavailable, which does not reveal the block.
+func (p *BlockPolicy) Check(ctx context.Context, actorID, targetID uuid.UUID) error {
+	if actorID == targetID {
+		return nil
+	}
+
+	blocked, err := p.repo.IsBlocked(ctx, actorID, targetID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrContactBlocked
+	}
+
+	blocked, err = p.repo.IsBlocked(ctx, targetID, actorID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrUserUnavailable
+	}
+
+	return nil
+}
+
+// HiddenUsers returns the users that should not be discoverable by userID,
+// i.e. everyone on either side of a block with them.
+func (p *BlockPolicy) HiddenUsers(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]struct{}, error) {
+	ids, err := p.repo.ListBlockRelations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	hidden := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		hidden[id] = struct{}{}
+	}
+	return hidden, nil
+}
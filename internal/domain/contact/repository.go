@@ -16,6 +16,8 @@ type Repository interface {
 	Update(ctx context.Context, contact *Contact) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	CountByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	IsBlocked(ctx context.Context, userID, targetID uuid.UUID) (bool, error)
+	ListBlockRelations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 
 	CreateRequest(ctx context.Context, request *ContactRequest) error
 	GetRequestByID(ctx context.Context, id uuid.UUID) (*ContactRequest, error)
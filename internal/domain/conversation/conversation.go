@@ -1,6 +1,7 @@
 package conversation
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/danielng/kin-core-svc/pkg/uid"
@@ -72,3 +73,12 @@ func (c *Conversation) IsDirect() bool {
 func (c *Conversation) IsCircle() bool {
 	return c.Type == ConversationTypeCircle
 }
+
+// DirectKey returns an order-independent key identifying the direct
+// conversation between two users.
+func DirectKey(userID1, userID2 uuid.UUID) string {
+	if bytes.Compare(userID1[:], userID2[:]) > 0 {
+		userID1, userID2 = userID2, userID1
+	}
+	return userID1.String() + ":" + userID2.String()
+}
@@ -43,6 +43,12 @@ var (
 		http.StatusConflict,
 	)
 
+	ErrCannotConverseWithSelf = apperror.New(
+		apperror.CodeBadRequest,
+		"cannot start a conversation with yourself",
+		http.StatusBadRequest,
+	)
+
 	ErrInvalidConversationType = apperror.New(
 		apperror.CodeBadRequest,
 		"invalid conversation type",
@@ -0,0 +1,64 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/danielng/kin-core-svc/pkg/uid"
+	"github.com/google/uuid"
+)
+
+// Payload is a domain event raised by an aggregate, such as a message being
+// sent or a member joining a circle.
+type Payload interface {
+	EventType() string
+	AggregateType() string
+	AggregateID() uuid.UUID
+}
+
+// Event is the envelope stored in the outbox and handed to sinks.
+type Event struct {
+	ID            uuid.UUID       `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   uuid.UUID       `json:"aggregate_id"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+}
+
+func New(p Payload) (*Event, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event: %w", p.EventType(), err)
+	}
+
+	return &Event{
+		ID:            uid.New(),
+		Type:          p.EventType(),
+		AggregateType: p.AggregateType(),
+		AggregateID:   p.AggregateID(),
+		Payload:       data,
+		OccurredAt:    time.Now(),
+	}, nil
+}
+
+// Recorder collects the events an aggregate raises until its repository
+// persists them alongside the aggregate. Embed it in aggregates that raise
+// events.
+type Recorder struct {
+	events []Payload
+}
+
+func (r *Recorder) Record(p Payload) {
+	r.events = append(r.events, p)
+}
+
+func (r *Recorder) Events() []Payload {
+	return r.events
+}
+
+// ClearEvents is called once the recorded events have been committed.
+func (r *Recorder) ClearEvents() {
+	r.events = nil
+}
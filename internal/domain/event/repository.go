@@ -0,0 +1,23 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	// Process claims up to limit unpublished events in occurrence order and
+	// marks them published only if fn succeeds. Concurrent callers never
+	// receive the same event.
+	Process(ctx context.Context, limit int, fn func(ctx context.Context, events []*Event) error) (int, error)
+	// DeletePublished deletes up to limit events published before the given
+	// time and returns how many it deleted.
+	DeletePublished(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// Sink is a destination the outbox relay delivers events to. Delivery is
+// at-least-once, so consumers must tolerate duplicates.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, events []*Event) error
+}
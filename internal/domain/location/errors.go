@@ -25,24 +25,72 @@ var (
 		http.StatusNotFound,
 	)
 
+	ErrLiveSessionNotFound = apperror.New(
+		apperror.CodeNotFound,
+		"live session not found",
+		http.StatusNotFound,
+	)
+
+	ErrInvalidLiveDuration = apperror.New(
+		apperror.CodeValidation,
+		"live session duration must be between 5 minutes and 24 hours",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidLiveScope = apperror.New(
+		apperror.CodeValidation,
+		"live session needs exactly one of a circle or a direct conversation",
+		http.StatusBadRequest,
+	)
+
 	ErrInvalidCoordinates = apperror.New(
 		apperror.CodeValidation,
 		"invalid coordinates",
 		http.StatusBadRequest,
 	)
 
+	ErrEmptyBatch = apperror.New(
+		apperror.CodeValidation,
+		"batch must contain at least one location",
+		http.StatusBadRequest,
+	)
+
+	ErrBatchTooLarge = apperror.New(
+		apperror.CodeValidation,
+		"too many locations in batch",
+		http.StatusBadRequest,
+	)
+
+	ErrInvalidTimeRange = apperror.New(
+		apperror.CodeValidation,
+		"time range end must be after its start",
+		http.StatusBadRequest,
+	)
+
 	ErrInvalidPlaceType = apperror.New(
 		apperror.CodeValidation,
 		"invalid place type",
 		http.StatusBadRequest,
 	)
 
+	ErrInvalidPlaceRadius = apperror.New(
+		apperror.CodeValidation,
+		"place radius must be between 25 and 5000 meters",
+		http.StatusBadRequest,
+	)
+
 	ErrInvalidPrecision = apperror.New(
 		apperror.CodeValidation,
 		"invalid location precision",
 		http.StatusBadRequest,
 	)
 
+	ErrInvalidRetention = apperror.New(
+		apperror.CodeValidation,
+		"invalid location retention",
+		http.StatusBadRequest,
+	)
+
 	ErrLocationSharingDisabled = apperror.New(
 		apperror.CodeForbidden,
 		"location sharing is disabled",
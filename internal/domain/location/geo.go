@@ -0,0 +1,80 @@
+package location
+
+import "math"
+
+const (
+	earthRadius     = 6371000.0                          // Meters
+	metersPerDegree = earthRadius * math.Pi / 180        // Along a meridian
+	degreesPerMeter = 1 / metersPerDegree                // Along a meridian
+	maxGridError    = 0.5 * math.Sqrt2 * metersPerDegree // Half the diagonal of a one-degree cell
+)
+
+// Distance returns the great-circle distance in meters between two points.
+func Distance(lat1, lng1, lat2, lng2 float64) float64 {
+	return haversineDistance(lat1, lng1, lat2, lng2)
+}
+
+// Box is a latitude and longitude range. MinLng is greater than MaxLng when
+// the box crosses the antimeridian.
+type Box struct {
+	MinLat float64
+	MaxLat float64
+	MinLng float64
+	MaxLng float64
+}
+
+// BoundingBox returns a box containing every point within radius meters of
+// (lat, lng). Points in the box may still be farther away, so it only serves
+// to rule out candidates cheaply, for example through an index, before
+// measuring the actual distance.
+func BoundingBox(lat, lng, radius float64) Box {
+	dLat := radius * degreesPerMeter
+	b := Box{MinLat: lat - dLat, MaxLat: lat + dLat, MinLng: -180, MaxLng: 180}
+	if b.MinLat <= -90 || b.MaxLat >= 90 {
+		// A pole is within reach, so every longitude is.
+		b.MinLat = math.Max(b.MinLat, -90)
+		b.MaxLat = math.Min(b.MaxLat, 90)
+		return b
+	}
+
+	// Half the widest longitude span of the circle.
+	ratio := math.Sin(radius/earthRadius) / math.Cos(lat*math.Pi/180)
+	if ratio >= 1 {
+		return b
+	}
+	dLng := math.Asin(ratio) * 180 / math.Pi
+	b.MinLng = lng - dLng
+	b.MaxLng = lng + dLng
+	if b.MinLng < -180 {
+		b.MinLng += 360
+	}
+	if b.MaxLng > 180 {
+		b.MaxLng -= 360
+	}
+	return b
+}
+
+func (b Box) Contains(lat, lng float64) bool {
+	if lat < b.MinLat || lat > b.MaxLat {
+		return false
+	}
+	if b.MinLng <= b.MaxLng {
+		return lng >= b.MinLng && lng <= b.MaxLng
+	}
+	return lng >= b.MinLng || lng <= b.MaxLng
+}
+
+// MaxError is how far, in meters, coordinates coarsened to the precision may
+// be from the actual ones.
+func (p Precision) MaxError() float64 {
+	switch p {
+	case PrecisionExact:
+		return 0
+	case PrecisionNeighborhood:
+		return maxGridError / 1000
+	case PrecisionCity:
+		return maxGridError / 100
+	default:
+		return maxGridError
+	}
+}
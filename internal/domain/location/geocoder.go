@@ -0,0 +1,19 @@
+package location
+
+import "context"
+
+// GeocodedInfo is what reverse geocoding found for a point. Fields the
+// geocoder could not resolve are nil.
+type GeocodedInfo struct {
+	Country      *string
+	City         *string
+	Neighborhood *string
+	Address      *string
+}
+
+// Geocoder turns coordinates into a place description. Points it knows
+// nothing about, such as the open sea, resolve to an empty GeocodedInfo
+// rather than an error.
+type Geocoder interface {
+	ReverseGeocode(ctx context.Context, lat, lng float64) (*GeocodedInfo, error)
+}
@@ -0,0 +1,64 @@
+package location
+
+import (
+	"time"
+
+	"github.com/danielng/kin-core-svc/pkg/uid"
+	"github.com/google/uuid"
+)
+
+const (
+	MinLiveDuration = 5 * time.Minute
+	MaxLiveDuration = 24 * time.Hour
+)
+
+// LiveSession shares a user's exact location with one circle or one
+// conversation until it expires, whatever their sharing preferences say.
+// Exactly one of CircleID and ConversationID is set.
+type LiveSession struct {
+	ID             uuid.UUID  `json:"id"`
+	UserID         uuid.UUID  `json:"user_id"`
+	CircleID       *uuid.UUID `json:"circle_id,omitempty"`
+	ConversationID *uuid.UUID `json:"conversation_id,omitempty"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// LiveLocation is a live session as seen by one of its viewers, with the
+// sharer's last known location if any.
+type LiveLocation struct {
+	Session  *LiveSession
+	Location *SharedLocation
+}
+
+func NewLiveSession(userID uuid.UUID, circleID, conversationID *uuid.UUID, duration time.Duration) *LiveSession {
+	now := time.Now()
+	return &LiveSession{
+		ID:             uid.New(),
+		UserID:         userID,
+		CircleID:       circleID,
+		ConversationID: conversationID,
+		ExpiresAt:      now.Add(duration),
+		CreatedAt:      now,
+	}
+}
+
+func (s *LiveSession) IsActive() bool {
+	return time.Now().Before(s.ExpiresAt)
+}
+
+// SameScope reports whether both sessions share with the same audience.
+func (s *LiveSession) SameScope(other *LiveSession) bool {
+	return equalIDs(s.CircleID, other.CircleID) && equalIDs(s.ConversationID, other.ConversationID)
+}
+
+func IsValidLiveDuration(d time.Duration) bool {
+	return d >= MinLiveDuration && d <= MaxLiveDuration
+}
+
+func equalIDs(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
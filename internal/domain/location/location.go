@@ -1,12 +1,22 @@
 package location
 
 import (
+	"math"
 	"time"
 
 	"github.com/danielng/kin-core-svc/pkg/uid"
 	"github.com/google/uuid"
 )
 
+// MaxBatchSize bounds the number of points a device may upload at once after
+// being offline.
+const MaxBatchSize = 500
+
+const (
+	DefaultNearbyRadius = 1000.0  // Meters
+	MaxNearbyRadius     = 50000.0 // Meters
+)
+
 type Location struct {
 	UserID       uuid.UUID  `json:"user_id"`
 	Latitude     float64    `json:"latitude"`
@@ -63,6 +73,13 @@ func (l *Location) SetGeocodedInfo(country, city, neighborhood, address *string)
 	l.UpdatedAt = time.Now()
 }
 
+func IsValidCoordinates(lat, lng float64) bool {
+	if math.IsNaN(lat) || math.IsNaN(lng) {
+		return false
+	}
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
 func (l *Location) DistanceTo(other *Location) float64 {
 	return haversineDistance(l.Latitude, l.Longitude, other.Latitude, other.Longitude)
 }
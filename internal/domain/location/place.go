@@ -1,6 +1,8 @@
 package location
 
 import (
+	"context"
+	"math"
 	"time"
 
 	"github.com/danielng/kin-core-svc/pkg/uid"
@@ -17,6 +19,24 @@ const (
 	PlaceTypeOther  PlaceType = "other"
 )
 
+const (
+	MinPlaceRadius = 25.0   // Meters
+	MaxPlaceRadius = 5000.0 // Meters
+)
+
+// Leaving a place takes moving beyond its radius by a margin, so that points
+// jittering around the edge don't flap between arrival and departure.
+const (
+	exitMarginRatio = 0.2
+	minExitMargin   = 25.0 // Meters
+)
+
+// PlaceObserver is told which of their places a user is at after a location
+// update. A nil placeID means they are at none of them.
+type PlaceObserver interface {
+	SetCurrentPlace(ctx context.Context, userID uuid.UUID, placeID *uuid.UUID) error
+}
+
 type Place struct {
 	ID        uuid.UUID `json:"id"`
 	UserID    uuid.UUID `json:"user_id"`
@@ -57,8 +77,58 @@ func (p *Place) Update(name string, placeType PlaceType, lat, lng, radius float6
 }
 
 func (p *Place) ContainsLocation(lat, lng float64) bool {
-	distance := haversineDistance(p.Latitude, p.Longitude, lat, lng)
-	return distance <= p.Radius
+	_, ok := p.within(lat, lng, p.Radius)
+	return ok
+}
+
+// within returns the distance to the point if it is at most limit meters from
+// the center. Points too far north or south are ruled out without computing
+// the distance.
+func (p *Place) within(lat, lng, limit float64) (float64, bool) {
+	if math.Abs(p.Latitude-lat)*metersPerDegree > limit {
+		return 0, false
+	}
+	d := haversineDistance(p.Latitude, p.Longitude, lat, lng)
+	return d, d <= limit
+}
+
+// MatchPlace returns the nearest place whose radius contains the point, or nil.
+func MatchPlace(places []*Place, lat, lng float64) *Place {
+	var match *Place
+	best := 0.0
+	for _, p := range places {
+		if d, ok := p.within(lat, lng, p.Radius); ok && (match == nil || d < best) {
+			match, best = p, d
+		}
+	}
+	return match
+}
+
+// exitRadius is the distance from the center beyond which the user has left.
+func (p *Place) exitRadius() float64 {
+	return p.Radius + max(p.Radius*exitMarginRatio, minExitMargin)
+}
+
+// ResolvePlace returns the place the user is at after moving to the point,
+// given the place they were at before. They stay at currentID until they
+// are clearly outside it, and otherwise arrive at the nearest place
+// containing the point.
+func ResolvePlace(places []*Place, currentID *uuid.UUID, lat, lng float64) *Place {
+	if currentID != nil {
+		for _, p := range places {
+			if p.ID != *currentID {
+				continue
+			}
+			if _, ok := p.within(lat, lng, p.exitRadius()); ok {
+				return p
+			}
+		}
+	}
+	return MatchPlace(places, lat, lng)
+}
+
+func IsValidPlaceRadius(radius float64) bool {
+	return radius >= MinPlaceRadius && radius <= MaxPlaceRadius
 }
 
 func IsValidPlaceType(pt PlaceType) bool {
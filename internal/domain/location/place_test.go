@@ -0,0 +1,67 @@
+package location
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+const (
+	homeLat, homeLng = 51.5, -0.12
+)
+
+// north returns the point meters north of home.
+func north(meters float64) (float64, float64) {
+	return homeLat + meters*degreesPerMeter, homeLng
+}
+
+func TestResolvePlace(t *testing.T) {
+	home := NewPlace(uuid.Nil, "Home", PlaceTypeHome, homeLat, homeLng, 100) // Leaves beyond 125 m
+	lat, _ := north(-170)
+	office := NewPlace(uuid.Nil, "Office", PlaceTypeWork, lat, homeLng, 80)      // 90 to 250 m south of home
+	farm := NewPlace(uuid.Nil, "Farm", PlaceTypeOther, homeLat+1, homeLng, 1000) // Leaves beyond 1200 m
+	places := []*Place{home, office, farm}
+	missing := uuid.New()
+
+	tests := []struct {
+		name    string
+		current *uuid.UUID
+		meters  float64 // North of home, or south when negative
+		from    *Place  // Point relative to this place instead of home
+		want    *Place
+	}{
+		{name: "enter", meters: 50, want: home},
+		{name: "not entered in the exit band", meters: 110, want: nil},
+		{name: "stay inside", current: &home.ID, meters: 90, want: home},
+		{name: "stay inside the exit band", current: &home.ID, meters: 120, want: home},
+		{name: "leave beyond the exit band", current: &home.ID, meters: 200, want: nil},
+		{name: "exit band outranks a nearer place", current: &home.ID, meters: -120, want: home},
+		{name: "enter the place beside the exit band", meters: -120, want: office},
+		{name: "leave into another place", current: &home.ID, meters: -200, want: office},
+		{name: "nearest of overlapping places", meters: -95, want: office},
+		{name: "margin grows with the radius", current: &farm.ID, meters: 1150, from: farm, want: farm},
+		{name: "leave a large place", current: &farm.ID, meters: 1250, from: farm, want: nil},
+		{name: "unknown current place", current: &missing, meters: 50, want: home},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lat, lng := north(tt.meters)
+			if tt.from != nil {
+				lat, lng = tt.from.Latitude+tt.meters*degreesPerMeter, tt.from.Longitude
+			}
+
+			got := ResolvePlace(places, tt.current, lat, lng)
+			if got != tt.want {
+				t.Errorf("ResolvePlace = %v, want %v", placeName(got), placeName(tt.want))
+			}
+		})
+	}
+}
+
+func placeName(p *Place) string {
+	if p == nil {
+		return "none"
+	}
+	return p.Name
+}
@@ -5,8 +5,6 @@ import (
 )
 
 func haversineDistance(lat1, lng1, lat2, lng2 float64) float64 {
-	const earthRadius = 6371000 // meters
-
 	lat1Rad := lat1 * math.Pi / 180
 	lat2Rad := lat2 * math.Pi / 180
 	deltaLat := (lat2 - lat1) * math.Pi / 180
@@ -44,6 +42,22 @@ const (
 	PrecisionCountry      Precision = "country"
 )
 
+// precisionRank orders precisions from coarsest to finest.
+var precisionRank = map[Precision]int{
+	PrecisionCountry:      1,
+	PrecisionCity:         2,
+	PrecisionNeighborhood: 3,
+	PrecisionExact:        4,
+}
+
+// Finer returns the more detailed of p and other.
+func (p Precision) Finer(other Precision) Precision {
+	if precisionRank[other] > precisionRank[p] {
+		return other
+	}
+	return p
+}
+
 func IsValidPrecision(p Precision) bool {
 	switch p {
 	case PrecisionExact, PrecisionNeighborhood, PrecisionCity, PrecisionCountry:
@@ -52,3 +66,46 @@ func IsValidPrecision(p Precision) bool {
 		return false
 	}
 }
+
+// SharedLocation is a user's location as seen by someone else, coarsened to
+// the precision the owner chose for them.
+type SharedLocation struct {
+	Location  *Location
+	Precision Precision
+}
+
+// Nearby is a location shared with the viewer and how far it is from them.
+type Nearby struct {
+	Location *SharedLocation
+	Distance float64 // Meters
+}
+
+// Share returns a copy of the location reduced to precision. Anything finer
+// than the precision, such as the address or the known place, is dropped
+// along with the extra digits of the coordinates. Unknown precisions are
+// treated as the coarsest.
+func (l *Location) Share(precision Precision) *SharedLocation {
+	if !IsValidPrecision(precision) {
+		precision = PrecisionCountry
+	}
+
+	shared := *l
+	shared.Latitude, shared.Longitude = FuzzLocation(l.Latitude, l.Longitude, precision)
+	if precision == PrecisionExact {
+		return &SharedLocation{Location: &shared, Precision: precision}
+	}
+
+	shared.Accuracy = nil
+	shared.Altitude = nil
+	shared.Speed = nil
+	shared.Heading = nil
+	shared.PlaceID = nil
+	shared.Address = nil
+	if precision != PrecisionNeighborhood {
+		shared.Neighborhood = nil
+	}
+	if precision == PrecisionCountry {
+		shared.City = nil
+	}
+	return &SharedLocation{Location: &shared, Precision: precision}
+}
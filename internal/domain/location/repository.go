@@ -12,10 +12,16 @@ type Repository interface {
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*Location, error)
 	GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*Location, error)
 	Delete(ctx context.Context, userID uuid.UUID) error
+	ListNearby(ctx context.Context, userIDs []uuid.UUID, lat, lng, radius float64) ([]*Location, error)
+	// SetGeocodedInfo applies info to the user's location only if it is still
+	// at (lat, lng), so late results never overwrite a newer location.
+	SetGeocodedInfo(ctx context.Context, userID uuid.UUID, lat, lng float64, info *GeocodedInfo) error
 
 	CreateHistory(ctx context.Context, history *LocationHistory) error
 	ListHistoryByUser(ctx context.Context, userID uuid.UUID, from, to time.Time, limit int) ([]*LocationHistory, error)
 	DeleteHistoryOlderThan(ctx context.Context, userID uuid.UUID, before time.Time) error
+	DeleteHistoryBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+	DeleteHistoryByRetention(ctx context.Context, retention Retention, before time.Time, limit int) (int64, error)
 
 	CreatePlace(ctx context.Context, place *Place) error
 	GetPlaceByID(ctx context.Context, id uuid.UUID) (*Place, error)
@@ -30,3 +36,16 @@ type Repository interface {
 	ListCheckInsByPlace(ctx context.Context, placeID uuid.UUID, limit int) ([]*CheckIn, error)
 	GetLatestCheckIn(ctx context.Context, userID uuid.UUID) (*CheckIn, error)
 }
+
+// LiveSessionRepository keeps sessions only until they expire.
+type LiveSessionRepository interface {
+	Create(ctx context.Context, session *LiveSession) error
+	Get(ctx context.Context, id uuid.UUID) (*LiveSession, error)
+	Delete(ctx context.Context, session *LiveSession) error
+	// PopExpired removes and returns up to limit sessions that expired before
+	// the given time. Each session is returned to one caller only.
+	PopExpired(ctx context.Context, before time.Time, limit int) ([]*LiveSession, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*LiveSession, error)
+	ListByCircle(ctx context.Context, circleID uuid.UUID) ([]*LiveSession, error)
+	ListByConversation(ctx context.Context, conversationID uuid.UUID) ([]*LiveSession, error)
+}
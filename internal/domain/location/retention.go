@@ -0,0 +1,39 @@
+package location
+
+import "time"
+
+// Retention is how long a user's location history is kept.
+type Retention string
+
+const (
+	RetentionDay   Retention = "24h"
+	RetentionWeek  Retention = "7d"
+	RetentionMonth Retention = "30d"
+)
+
+// DefaultRetention applies to users who have not chosen one. It is the
+// longest, so history older than it can be dropped for everyone at once.
+const DefaultRetention = RetentionMonth
+
+// Retentions lists every retention, shortest first.
+var Retentions = []Retention{RetentionDay, RetentionWeek, RetentionMonth}
+
+func (r Retention) Duration() time.Duration {
+	switch r {
+	case RetentionDay:
+		return 24 * time.Hour
+	case RetentionWeek:
+		return 7 * 24 * time.Hour
+	default:
+		return 30 * 24 * time.Hour
+	}
+}
+
+func IsValidRetention(r Retention) bool {
+	switch r {
+	case RetentionDay, RetentionWeek, RetentionMonth:
+		return true
+	default:
+		return false
+	}
+}
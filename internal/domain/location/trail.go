@@ -0,0 +1,204 @@
+package location
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxTrailPoints bounds the history loaded for one trail.
+	MaxTrailPoints = 10000
+
+	DefaultSimplifyTolerance = 10.0 // Meters
+	MaxSimplifyTolerance     = 1000.0
+
+	// A stay is at least MinStayDuration spent within StayRadius of where it
+	// began.
+	StayRadius      = 100.0 // Meters
+	MinStayDuration = 5 * time.Minute
+)
+
+type SegmentType string
+
+const (
+	SegmentTypeStay SegmentType = "stay"
+	SegmentTypeTrip SegmentType = "trip"
+)
+
+// Segment is a stretch of history spent in one spot (a stay) or moving
+// between spots (a trip).
+type Segment struct {
+	Type       SegmentType `json:"type"`
+	StartedAt  time.Time   `json:"started_at"`
+	EndedAt    time.Time   `json:"ended_at"`
+	Latitude   float64     `json:"latitude"`  // Center of a stay, end of a trip
+	Longitude  float64     `json:"longitude"` // Center of a stay, end of a trip
+	PlaceID    *uuid.UUID  `json:"place_id,omitempty"`
+	Distance   float64     `json:"distance"` // Meters traveled on a trip
+	PointCount int         `json:"point_count"`
+}
+
+// Trail is a user's history over a period: the points, simplified, and the
+// stays and trips derived from all of them.
+type Trail struct {
+	Points    []*LocationHistory `json:"points"`
+	Segments  []*Segment         `json:"segments"`
+	Truncated bool               `json:"truncated"` // The period held more than MaxTrailPoints; the oldest are left out
+}
+
+// Segments splits points, oldest first, into stays and the trips between
+// them. A trip runs from the last point of the stay before it to the first
+// point of the stay after it.
+func Segments(points []*LocationHistory) []*Segment {
+	var (
+		segments  []*Segment
+		trip      []*LocationHistory
+		inStay    bool // The last segment is a stay
+		stayStart int  // First point of the last stay
+		stayEnd   = -1 // Last point of the last stay, if any
+	)
+	flushTrip := func(next *LocationHistory) {
+		if len(trip) == 0 {
+			return
+		}
+		path := trip
+		if stayEnd >= 0 {
+			path = append([]*LocationHistory{points[stayEnd]}, path...)
+		}
+		if next != nil {
+			path = append(path, next)
+		}
+		segments = append(segments, newTrip(path, len(trip)))
+		trip = nil
+		inStay = false
+	}
+
+	for i := 0; i < len(points); {
+		j := i
+		for j+1 < len(points) && haversineDistance(points[i].Latitude, points[i].Longitude, points[j+1].Latitude, points[j+1].Longitude) <= StayRadius {
+			j++
+		}
+		if points[j].CreatedAt.Sub(points[i].CreatedAt) < MinStayDuration {
+			trip = append(trip, points[i])
+			i++
+			continue
+		}
+
+		flushTrip(points[i])
+		stay := newStay(points[i : j+1])
+		if last := len(segments) - 1; inStay &&
+			haversineDistance(segments[last].Latitude, segments[last].Longitude, stay.Latitude, stay.Longitude) <= StayRadius {
+			// Still in the same spot; only the anchor moved.
+			segments[last] = newStay(points[stayStart : j+1])
+		} else {
+			segments = append(segments, stay)
+			stayStart = i
+		}
+		inStay = true
+		stayEnd = j
+		i = j + 1
+	}
+	flushTrip(nil)
+
+	return segments
+}
+
+func newStay(points []*LocationHistory) *Segment {
+	s := &Segment{
+		Type:       SegmentTypeStay,
+		StartedAt:  points[0].CreatedAt,
+		EndedAt:    points[len(points)-1].CreatedAt,
+		PointCount: len(points),
+	}
+
+	counts := make(map[uuid.UUID]int)
+	best := 0
+	for _, p := range points {
+		s.Latitude += p.Latitude
+		s.Longitude += p.Longitude
+		if p.PlaceID == nil {
+			continue
+		}
+		counts[*p.PlaceID]++
+		if counts[*p.PlaceID] > best {
+			best = counts[*p.PlaceID]
+			s.PlaceID = p.PlaceID
+		}
+	}
+	s.Latitude /= float64(s.PointCount)
+	s.Longitude /= float64(s.PointCount)
+	return s
+}
+
+func newTrip(path []*LocationHistory, pointCount int) *Segment {
+	end := path[len(path)-1]
+	s := &Segment{
+		Type:       SegmentTypeTrip,
+		StartedAt:  path[0].CreatedAt,
+		EndedAt:    end.CreatedAt,
+		Latitude:   end.Latitude,
+		Longitude:  end.Longitude,
+		PointCount: pointCount,
+	}
+	for i := 1; i < len(path); i++ {
+		s.Distance += haversineDistance(path[i-1].Latitude, path[i-1].Longitude, path[i].Latitude, path[i].Longitude)
+	}
+	return s
+}
+
+// Simplify drops points, oldest first, that lie within tolerance meters of
+// the line through the points kept around them (Ramer-Douglas-Peucker). The
+// first and last points are always kept.
+func Simplify(points []*LocationHistory, tolerance float64) []*LocationHistory {
+	if len(points) < 3 {
+		return points
+	}
+
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+
+	type span struct{ first, last int }
+	stack := []span{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		farthest, maxDist := -1, tolerance
+		for i := s.first + 1; i < s.last; i++ {
+			if d := crossTrackDistance(points[i], points[s.first], points[s.last]); d > maxDist {
+				farthest, maxDist = i, d
+			}
+		}
+		if farthest < 0 {
+			continue
+		}
+		keep[farthest] = true
+		stack = append(stack, span{s.first, farthest}, span{farthest, s.last})
+	}
+
+	simplified := make([]*LocationHistory, 0, len(points))
+	for i, p := range points {
+		if keep[i] {
+			simplified = append(simplified, p)
+		}
+	}
+	return simplified
+}
+
+// crossTrackDistance approximates the distance in meters from p to the
+// segment a-b on a plane tangent at a, which is accurate over the short
+// distances between consecutive points.
+func crossTrackDistance(p, a, b *LocationHistory) float64 {
+	scale := math.Cos(a.Latitude * math.Pi / 180)
+	px, py := (p.Longitude-a.Longitude)*scale*metersPerDegree, (p.Latitude-a.Latitude)*metersPerDegree
+	bx, by := (b.Longitude-a.Longitude)*scale*metersPerDegree, (b.Latitude-a.Latitude)*metersPerDegree
+
+	lengthSq := bx*bx + by*by
+	if lengthSq == 0 {
+		return math.Hypot(px, py)
+	}
+	t := math.Max(0, math.Min(1, (px*bx+py*by)/lengthSq))
+	return math.Hypot(px-t*bx, py-t*by)
+}
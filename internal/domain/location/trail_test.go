@@ -0,0 +1,155 @@
+package location
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var trailStart = time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+
+// point returns a history point the given minutes after trailStart, north
+// and east of home by the given meters.
+func point(minutes int, north, east float64) *LocationHistory {
+	return &LocationHistory{
+		Latitude:  homeLat + north*degreesPerMeter,
+		Longitude: homeLng + east*degreesPerMeter/math.Cos(homeLat*math.Pi/180),
+		CreatedAt: trailStart.Add(time.Duration(minutes) * time.Minute),
+	}
+}
+
+// still returns a point per minute from first to last at the same spot.
+func still(first, last int, north float64) []*LocationHistory {
+	var points []*LocationHistory
+	for m := first; m <= last; m++ {
+		points = append(points, point(m, north, 0))
+	}
+	return points
+}
+
+func concat(parts ...[]*LocationHistory) []*LocationHistory {
+	var out []*LocationHistory
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return out
+}
+
+type wantSegment struct {
+	typ        SegmentType
+	start, end int // Minutes after trailStart
+	points     int
+	distance   float64 // Meters, checked for trips only
+}
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []*LocationHistory
+		want   []wantSegment
+	}{
+		{
+			name: "no points",
+		},
+		{
+			name:   "single stay",
+			points: still(0, 10, 0),
+			want:   []wantSegment{{typ: SegmentTypeStay, start: 0, end: 10, points: 11}},
+		},
+		{
+			name:   "too short to be a stay",
+			points: concat(still(0, 3, 0), []*LocationHistory{point(4, 500, 0)}),
+			want:   []wantSegment{{typ: SegmentTypeTrip, start: 0, end: 4, points: 5, distance: 500}},
+		},
+		{
+			name: "trip between stays",
+			points: concat(
+				still(0, 10, 0),
+				[]*LocationHistory{point(11, 500, 0), point(12, 1000, 0)},
+				still(13, 25, 1500),
+			),
+			want: []wantSegment{
+				{typ: SegmentTypeStay, start: 0, end: 10, points: 11},
+				{typ: SegmentTypeTrip, start: 10, end: 13, points: 2, distance: 1500},
+				{typ: SegmentTypeStay, start: 13, end: 25, points: 13},
+			},
+		},
+		{
+			name:   "drifting within the stay radius merges stays",
+			points: concat(still(0, 5, 0), still(6, 11, 90), still(12, 17, 130)),
+			want:   []wantSegment{{typ: SegmentTypeStay, start: 0, end: 17, points: 18}},
+		},
+		{
+			name:   "moving beyond the stay radius starts a new stay",
+			points: concat(still(0, 5, 0), still(6, 11, 90), still(12, 17, 200)),
+			want: []wantSegment{
+				{typ: SegmentTypeStay, start: 0, end: 11, points: 12},
+				{typ: SegmentTypeStay, start: 12, end: 17, points: 6},
+			},
+		},
+		{
+			name:   "trip after the last stay",
+			points: concat(still(0, 10, 0), []*LocationHistory{point(11, 400, 0), point(12, 800, 0)}),
+			want: []wantSegment{
+				{typ: SegmentTypeStay, start: 0, end: 10, points: 11},
+				{typ: SegmentTypeTrip, start: 10, end: 12, points: 2, distance: 800},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Segments(tt.points)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d segments, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, w := range tt.want {
+				s := got[i]
+				if s.Type != w.typ || s.PointCount != w.points ||
+					!s.StartedAt.Equal(trailStart.Add(time.Duration(w.start)*time.Minute)) ||
+					!s.EndedAt.Equal(trailStart.Add(time.Duration(w.end)*time.Minute)) {
+					t.Errorf("segment %d = %s %v-%v with %d points, want %s minute %d-%d with %d points",
+						i, s.Type, s.StartedAt.Format(time.TimeOnly), s.EndedAt.Format(time.TimeOnly), s.PointCount,
+						w.typ, w.start, w.end, w.points)
+				}
+				if w.typ == SegmentTypeTrip && math.Abs(s.Distance-w.distance) > 1 {
+					t.Errorf("segment %d distance = %.1f, want %.1f", i, s.Distance, w.distance)
+				}
+			}
+		})
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	line := []*LocationHistory{point(0, 0, 0), point(1, 100, 0), point(2, 200, 0), point(3, 300, 0)}
+	bend := []*LocationHistory{point(0, 0, 0), point(1, 100, 50), point(2, 200, 0)}
+	zigzag := []*LocationHistory{point(0, 0, 0), point(1, 100, 20), point(2, 200, -20), point(3, 300, 0)}
+
+	tests := []struct {
+		name      string
+		points    []*LocationHistory
+		tolerance float64
+		want      []int // Indexes of the points kept
+	}{
+		{name: "too few points", points: line[:2], tolerance: 10, want: []int{0, 1}},
+		{name: "straight line keeps its ends", points: line, tolerance: 10, want: []int{0, 3}},
+		{name: "bend beyond tolerance is kept", points: bend, tolerance: 10, want: []int{0, 1, 2}},
+		{name: "bend within tolerance is dropped", points: bend, tolerance: 60, want: []int{0, 2}},
+		{name: "zigzag beyond tolerance is kept", points: zigzag, tolerance: 10, want: []int{0, 1, 2, 3}},
+		{name: "zigzag within tolerance is dropped", points: zigzag, tolerance: 30, want: []int{0, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Simplify(tt.points, tt.tolerance)
+			if len(got) != len(tt.want) {
+				t.Fatalf("kept %d points, want %v", len(got), tt.want)
+			}
+			for i, idx := range tt.want {
+				if got[i] != tt.points[idx] {
+					t.Errorf("point %d is not input point %d", i, idx)
+				}
+			}
+		})
+	}
+}
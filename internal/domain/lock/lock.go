@@ -0,0 +1,25 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotAcquired = errors.New("lock is held by another owner")
+	ErrLost        = errors.New("lock is no longer held")
+)
+
+// Locker hands out named leases shared by every replica. A lease expires on
+// its own after its TTL so a crashed holder cannot block others forever.
+type Locker interface {
+	TryAcquire(ctx context.Context, key string, ttl time.Duration) (Lock, error)
+}
+
+type Lock interface {
+	// Extend resets the lease's TTL, failing with ErrLost once it has
+	// expired and may have been taken over.
+	Extend(ctx context.Context, ttl time.Duration) error
+	Release(ctx context.Context) error
+}
@@ -0,0 +1,21 @@
+package messaging
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const EventTypeMessageSent = "messaging.message_sent"
+
+type MessageSent struct {
+	MessageID      uuid.UUID   `json:"message_id"`
+	ConversationID uuid.UUID   `json:"conversation_id"`
+	SenderID       uuid.UUID   `json:"sender_id"`
+	ContentType    ContentType `json:"content_type"`
+	SentAt         time.Time   `json:"sent_at"`
+}
+
+func (e MessageSent) EventType() string      { return EventTypeMessageSent }
+func (e MessageSent) AggregateType() string  { return "message" }
+func (e MessageSent) AggregateID() uuid.UUID { return e.MessageID }
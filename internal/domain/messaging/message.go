@@ -3,6 +3,7 @@ package messaging
 import (
 	"time"
 
+	"github.com/danielng/kin-core-svc/internal/domain/event"
 	"github.com/danielng/kin-core-svc/pkg/uid"
 	"github.com/google/uuid"
 )
@@ -17,10 +18,12 @@ type Message struct {
 	EditedAt       *time.Time `json:"edited_at,omitempty"`
 	CreatedAt      time.Time  `json:"created_at"`
 	DeletedForAll  bool       `json:"-"`
+
+	event.Recorder `json:"-"`
 }
 
 func NewMessage(conversationID, senderID uuid.UUID, content Content) *Message {
-	return &Message{
+	m := &Message{
 		ID:             uid.New(),
 		ConversationID: conversationID,
 		SenderID:       senderID,
@@ -28,6 +31,14 @@ func NewMessage(conversationID, senderID uuid.UUID, content Content) *Message {
 		IsEdited:       false,
 		CreatedAt:      time.Now(),
 	}
+	m.Record(MessageSent{
+		MessageID:      m.ID,
+		ConversationID: m.ConversationID,
+		SenderID:       m.SenderID,
+		ContentType:    m.Content.Type,
+		SentAt:         m.CreatedAt,
+	})
+	return m
 }
 
 func (m *Message) SetReplyTo(messageID uuid.UUID) {
@@ -62,6 +73,14 @@ func (m *Message) CanEdit(editWindowMinutes int) bool {
 	return time.Since(m.CreatedAt).Minutes() <= float64(editWindowMinutes)
 }
 
+// Cursor is a position in a conversation's history, which is ordered by
+// creation time and then by ID, so that messages sharing a timestamp are
+// neither skipped nor repeated across pages.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
 type MessageDeletion struct {
 	ID        uuid.UUID `json:"id"`
 	MessageID uuid.UUID `json:"message_id"`
@@ -10,13 +10,23 @@ import (
 type Repository interface {
 	Create(ctx context.Context, message *Message) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Message, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*Message, error)
 	Update(ctx context.Context, message *Message) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	ListByConversation(ctx context.Context, conversationID uuid.UUID, cursor *time.Time, limit int) ([]*Message, error)
+	// ListByConversation leaves out the messages viewerID deleted for
+	// themselves.
+	ListByConversation(ctx context.Context, conversationID, viewerID uuid.UUID, cursor *Cursor, limit int) ([]*Message, error)
 	ListByConversationAfter(ctx context.Context, conversationID uuid.UUID, afterID uuid.UUID, limit int) ([]*Message, error)
 	CountByConversation(ctx context.Context, conversationID uuid.UUID) (int64, error)
 	CountUnreadByUser(ctx context.Context, conversationID, userID uuid.UUID, after time.Time) (int64, error)
-	GetLatestByConversation(ctx context.Context, conversationID uuid.UUID) (*Message, error)
+	// GetLatestByConversation and ListLatestByConversations skip the messages
+	// viewerID deleted for themselves. A message deleted for everyone is still
+	// the latest, so the preview shows it as deleted, as the conversation does.
+	GetLatestByConversation(ctx context.Context, conversationID, viewerID uuid.UUID) (*Message, error)
+	ListLatestByConversations(ctx context.Context, viewerID uuid.UUID, conversationIDs []uuid.UUID) ([]*Message, error)
+	// CountUnreadByConversations counts, per conversation, the messages the
+	// user has not read since joining or last reading it.
+	CountUnreadByConversations(ctx context.Context, userID uuid.UUID, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error)
 	SearchInConversation(ctx context.Context, conversationID uuid.UUID, query string, limit int) ([]*Message, error)
 
 	CreateDeletion(ctx context.Context, deletion *MessageDeletion) error
@@ -104,3 +104,14 @@ func NewCheckInNotification(userID uuid.UUID, userName, placeName string, checkI
 	notif.SetData("check_in_id", checkInID.String())
 	return notif
 }
+
+func NewDepartureNotification(userID uuid.UUID, userName, placeName string, checkInID uuid.UUID) *Notification {
+	notif := NewNotification(
+		userID,
+		NotificationTypeCheckIn,
+		"Check-in",
+		userName+" left "+placeName,
+	)
+	notif.SetData("check_in_id", checkInID.String())
+	return notif
+}
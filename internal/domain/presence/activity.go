@@ -51,6 +51,9 @@ func (a *Activity) Refresh(duration time.Duration) {
 	a.ExpiresAt = time.Now().Add(duration)
 }
 
+// TypingDuration is how long a typing indicator lasts unless it is set again.
+const TypingDuration = 10 * time.Second
+
 type TypingIndicator struct {
 	UserID         uuid.UUID `json:"user_id"`
 	ConversationID uuid.UUID `json:"conversation_id"`
@@ -0,0 +1,96 @@
+package presence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Device is the presence of one of a user's devices. Each device expires on
+// its own, so the user stays online for as long as any of them keeps sending
+// heartbeats.
+type Device struct {
+	Type       DeviceType   `json:"type"`
+	ID         *string      `json:"id,omitempty"`
+	Status     OnlineStatus `json:"status"`
+	AppVersion *string      `json:"app_version,omitempty"`
+	LastSeenAt time.Time    `json:"last_seen_at"`
+}
+
+func NewDevice(deviceType DeviceType, deviceID *string) *Device {
+	return &Device{
+		Type:       deviceType,
+		ID:         deviceID,
+		Status:     OnlineStatusOnline,
+		LastSeenAt: time.Now(),
+	}
+}
+
+// Key tells the device apart from the user's others.
+func (d *Device) Key() string {
+	return DeviceKey(d.Type, d.ID)
+}
+
+func (d *Device) SetOnline() {
+	d.Status = OnlineStatusOnline
+	d.LastSeenAt = time.Now()
+}
+
+func (d *Device) SetAway() {
+	d.Status = OnlineStatusAway
+	d.LastSeenAt = time.Now()
+}
+
+// Heartbeat keeps the device's status, whether online or away.
+func (d *Device) Heartbeat() {
+	d.LastSeenAt = time.Now()
+}
+
+// DeviceKey identifies a device by its ID, or by its type for clients that
+// send none.
+func DeviceKey(deviceType DeviceType, deviceID *string) string {
+	if deviceID != nil && *deviceID != "" {
+		return "id:" + *deviceID
+	}
+	return "type:" + string(deviceType)
+}
+
+// Aggregate derives a user's presence from that of their devices: online if
+// any device is online, away if all of them are away, and offline without
+// any. The device seen most recently stands for the user. lastSeenAt is when
+// a device was last seen before going offline.
+func Aggregate(userID uuid.UUID, devices []*Device, lastSeenAt time.Time) *Presence {
+	p := &Presence{
+		UserID:     userID,
+		Status:     OnlineStatusOffline,
+		LastSeenAt: lastSeenAt,
+		Devices:    devices,
+		UpdatedAt:  lastSeenAt,
+	}
+
+	var latest *Device
+	for _, d := range devices {
+		switch {
+		case d.Status == OnlineStatusOnline:
+			p.Status = OnlineStatusOnline
+		case d.Status == OnlineStatusAway && p.Status == OnlineStatusOffline:
+			p.Status = OnlineStatusAway
+		}
+		if latest == nil || d.LastSeenAt.After(latest.LastSeenAt) {
+			latest = d
+		}
+	}
+	if latest == nil {
+		return p
+	}
+
+	deviceType := latest.Type
+	p.DeviceType = &deviceType
+	p.DeviceID = latest.ID
+	p.AppVersion = latest.AppVersion
+	if latest.LastSeenAt.After(p.LastSeenAt) {
+		p.LastSeenAt = latest.LastSeenAt
+		p.UpdatedAt = latest.LastSeenAt
+	}
+	return p
+}
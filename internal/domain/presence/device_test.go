@@ -0,0 +1,127 @@
+package presence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var seen = time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+
+func device(id string, status OnlineStatus, minutes int) *Device {
+	return &Device{
+		Type:       DeviceTypeMobile,
+		ID:         &id,
+		Status:     status,
+		LastSeenAt: seen.Add(time.Duration(minutes) * time.Minute),
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		devices    []*Device
+		lastSeenAt time.Time
+		want       OnlineStatus
+		wantDevice string // ID of the device that stands for the user
+		wantSeen   time.Time
+	}{
+		{
+			name:       "no devices",
+			lastSeenAt: seen,
+			want:       OnlineStatusOffline,
+			wantSeen:   seen,
+		},
+		{
+			name:       "online",
+			devices:    []*Device{device("phone", OnlineStatusOnline, 1)},
+			lastSeenAt: seen,
+			want:       OnlineStatusOnline,
+			wantDevice: "phone",
+			wantSeen:   seen.Add(time.Minute),
+		},
+		{
+			name:       "away when every device is",
+			devices:    []*Device{device("phone", OnlineStatusAway, 1), device("laptop", OnlineStatusAway, 2)},
+			lastSeenAt: seen,
+			want:       OnlineStatusAway,
+			wantDevice: "laptop",
+			wantSeen:   seen.Add(2 * time.Minute),
+		},
+		{
+			name:       "online when any device is",
+			devices:    []*Device{device("phone", OnlineStatusAway, 3), device("laptop", OnlineStatusOnline, 2)},
+			lastSeenAt: seen,
+			want:       OnlineStatusOnline,
+			wantDevice: "phone",
+			wantSeen:   seen.Add(3 * time.Minute),
+		},
+		{
+			name:       "online before away",
+			devices:    []*Device{device("laptop", OnlineStatusOnline, 1), device("phone", OnlineStatusAway, 2)},
+			lastSeenAt: seen,
+			want:       OnlineStatusOnline,
+			wantDevice: "phone",
+			wantSeen:   seen.Add(2 * time.Minute),
+		},
+		{
+			name:       "a device that went offline later keeps its last seen time",
+			devices:    []*Device{device("phone", OnlineStatusOnline, 1)},
+			lastSeenAt: seen.Add(5 * time.Minute),
+			want:       OnlineStatusOnline,
+			wantDevice: "phone",
+			wantSeen:   seen.Add(5 * time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Aggregate(userID, tt.devices, tt.lastSeenAt)
+			if p.UserID != userID {
+				t.Errorf("UserID = %v, want %v", p.UserID, userID)
+			}
+			if p.Status != tt.want {
+				t.Errorf("Status = %s, want %s", p.Status, tt.want)
+			}
+			if !p.LastSeenAt.Equal(tt.wantSeen) {
+				t.Errorf("LastSeenAt = %v, want %v", p.LastSeenAt, tt.wantSeen)
+			}
+
+			var gotDevice string
+			if p.DeviceID != nil {
+				gotDevice = *p.DeviceID
+			}
+			if gotDevice != tt.wantDevice {
+				t.Errorf("DeviceID = %q, want %q", gotDevice, tt.wantDevice)
+			}
+			if len(p.Devices) != len(tt.devices) {
+				t.Errorf("got %d devices, want %d", len(p.Devices), len(tt.devices))
+			}
+		})
+	}
+}
+
+func TestDeviceStatus(t *testing.T) {
+	d := NewDevice(DeviceTypeMobile, nil)
+	steps := []struct {
+		name string
+		do   func()
+		want OnlineStatus
+	}{
+		{name: "new", do: func() {}, want: OnlineStatusOnline},
+		{name: "set away", do: d.SetAway, want: OnlineStatusAway},
+		{name: "heartbeat keeps away", do: d.Heartbeat, want: OnlineStatusAway},
+		{name: "set online", do: d.SetOnline, want: OnlineStatusOnline},
+		{name: "heartbeat keeps online", do: d.Heartbeat, want: OnlineStatusOnline},
+	}
+
+	for _, step := range steps {
+		step.do()
+		if d.Status != step.want {
+			t.Fatalf("after %s: Status = %s, want %s", step.name, d.Status, step.want)
+		}
+	}
+}
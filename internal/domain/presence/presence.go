@@ -23,6 +23,8 @@ const (
 	DeviceTypeTablet  DeviceType = "tablet"
 )
 
+// Presence is a user's presence across their devices; the device fields are
+// those of the device seen most recently.
 type Presence struct {
 	UserID     uuid.UUID    `json:"user_id"`
 	Status     OnlineStatus `json:"status"`
@@ -31,6 +33,7 @@ type Presence struct {
 	DeviceID   *string      `json:"device_id,omitempty"`
 	AppVersion *string      `json:"app_version,omitempty"`
 	PushToken  *string      `json:"-"` // Not exposed in API
+	Devices    []*Device    `json:"devices,omitempty"`
 	UpdatedAt  time.Time    `json:"updated_at"`
 }
 
@@ -83,6 +86,17 @@ func (p *Presence) SetAppVersion(version *string) {
 	p.UpdatedAt = time.Now()
 }
 
+// Device returns the user's device with the given key, or nil if it is
+// offline.
+func (p *Presence) Device(key string) *Device {
+	for _, d := range p.Devices {
+		if d.Key() == key {
+			return d
+		}
+	}
+	return nil
+}
+
 func (p *Presence) IsOnline() bool {
 	return p.Status == OnlineStatusOnline
 }
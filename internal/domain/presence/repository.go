@@ -8,13 +8,15 @@ import (
 )
 
 type Repository interface {
-	Set(ctx context.Context, presence *Presence, ttl time.Duration) error
+	// Get and GetMultiple return each user's presence aggregated over their
+	// devices.
 	Get(ctx context.Context, userID uuid.UUID) (*Presence, error)
 	GetMultiple(ctx context.Context, userIDs []uuid.UUID) ([]*Presence, error)
 	Delete(ctx context.Context, userID uuid.UUID) error
+	SetDevice(ctx context.Context, userID uuid.UUID, device *Device, ttl time.Duration) error
 	SetOnline(ctx context.Context, userID uuid.UUID, deviceType DeviceType, deviceID *string, ttl time.Duration) error
-	SetOffline(ctx context.Context, userID uuid.UUID) error
-	Heartbeat(ctx context.Context, userID uuid.UUID, ttl time.Duration) error
+	SetOffline(ctx context.Context, userID uuid.UUID, deviceType DeviceType, deviceID *string) error
+	Heartbeat(ctx context.Context, userID uuid.UUID, deviceType DeviceType, deviceID *string, ttl time.Duration) error
 
 	SetActivity(ctx context.Context, activity *Activity) error
 	GetActivity(ctx context.Context, userID uuid.UUID) (*Activity, error)
@@ -0,0 +1,142 @@
+package privacy
+
+import (
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// Grant is what a subject lets one viewer see. The zero Grant shows nothing.
+type Grant struct {
+	Presence     bool
+	LastSeen     bool
+	Timezone     bool
+	Availability bool
+	Activity     bool
+	Location     bool
+	Precision    location.Precision // Only meaningful with Location
+}
+
+// Full is what users see of themselves.
+var Full = Grant{
+	Presence:     true,
+	LastSeen:     true,
+	Timezone:     true,
+	Availability: true,
+	Activity:     true,
+	Location:     true,
+	Precision:    location.PrecisionExact,
+}
+
+// Subject is what is known about a user. Fields left nil are not shown.
+type Subject struct {
+	UserID       uuid.UUID
+	Presence     *presence.Presence
+	Activity     *presence.Activity
+	Availability *availability.Availability
+	Timezone     *string
+	Location     *location.Location
+}
+
+// View is a subject as one viewer is allowed to see them. Fields the viewer
+// may not see are nil.
+type View struct {
+	UserID       uuid.UUID
+	Presence     *presence.Presence
+	Activity     *presence.Activity
+	Availability *availability.Availability
+	Timezone     *string
+	Location     *location.SharedLocation
+}
+
+// fromPreference derives the grant of one circle's sharing preference. Basic
+// covers presence and timezone; availability, activity and location also
+// need the privacy level to reach their tier.
+func fromPreference(pref *circle.SharingPreference) Grant {
+	level := pref.PrivacyLevel
+	g := Grant{
+		Presence:     level.Includes(user.PrivacyLevelBasic),
+		LastSeen:     level.Includes(user.PrivacyLevelBasic),
+		Timezone:     pref.ShareTimezone && level.Includes(user.PrivacyLevelBasic),
+		Availability: pref.ShareAvailability && level.Includes(user.PrivacyLevelStatus),
+		Activity:     pref.ShareActivity && level.Includes(user.PrivacyLevelActivity),
+		Location:     pref.ShareLocation && level.Includes(user.PrivacyLevelLocation),
+	}
+	if g.Location {
+		g.Precision = pref.LocationPrecision
+	}
+	return g
+}
+
+// merge combines the grants of several circles; the most permissive wins.
+func (g Grant) merge(other Grant) Grant {
+	merged := Grant{
+		Presence:     g.Presence || other.Presence,
+		LastSeen:     g.LastSeen || other.LastSeen,
+		Timezone:     g.Timezone || other.Timezone,
+		Availability: g.Availability || other.Availability,
+		Activity:     g.Activity || other.Activity,
+		Location:     g.Location || other.Location,
+	}
+	switch {
+	case g.Location && other.Location:
+		merged.Precision = g.Precision.Finer(other.Precision)
+	case g.Location:
+		merged.Precision = g.Precision
+	case other.Location:
+		merged.Precision = other.Precision
+	}
+	return merged
+}
+
+// restrict applies the subject's account-wide preferences, which override
+// anything shared with a circle.
+func (g Grant) restrict(prefs *user.Preferences) Grant {
+	if prefs == nil {
+		return g
+	}
+	g.Presence = g.Presence && prefs.ShowOnlineStatus
+	g.LastSeen = g.LastSeen && prefs.ShowLastSeen
+	return g
+}
+
+// Apply redacts s down to what the grant allows. Device details are never
+// shown to others.
+func (g Grant) Apply(s Subject) View {
+	v := View{UserID: s.UserID}
+
+	if g.Presence && s.Presence != nil {
+		p := *s.Presence
+		p.DeviceID = nil
+		p.AppVersion = nil
+		p.PushToken = nil
+		p.Devices = nil
+		if !g.LastSeen {
+			p.LastSeenAt = time.Time{}
+		}
+		v.Presence = &p
+	}
+	if g.Activity && s.Activity != nil {
+		a := *s.Activity
+		v.Activity = &a
+	}
+	if g.Availability && s.Availability != nil {
+		a := *s.Availability
+		a.CurrentPlaceID = nil
+		v.Availability = &a
+	}
+	if g.Timezone && s.Timezone != nil {
+		tz := *s.Timezone
+		v.Timezone = &tz
+	}
+	if g.Location && s.Location != nil {
+		v.Location = s.Location.Share(g.Precision)
+	}
+
+	return v
+}
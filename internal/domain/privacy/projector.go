@@ -0,0 +1,279 @@
+package privacy
+
+import (
+	"context"
+	"errors"
+
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+const circlePageSize = 100
+
+// Projector is the single place that decides what one user may see of
+// another. Users see everything of themselves and nothing of anyone on
+// either side of a block. Otherwise the subject's sharing preferences apply:
+// those of the given circle, or, without one, those of every circle the two
+// have in common combined.
+type Projector struct {
+	circleRepo  circle.Repository
+	userRepo    user.Repository
+	blockPolicy *contact.BlockPolicy
+}
+
+func NewProjector(circleRepo circle.Repository, userRepo user.Repository, blockPolicy *contact.BlockPolicy) *Projector {
+	return &Projector{
+		circleRepo:  circleRepo,
+		userRepo:    userRepo,
+		blockPolicy: blockPolicy,
+	}
+}
+
+// Grant returns what subjectID shares with viewerID.
+func (p *Projector) Grant(ctx context.Context, viewerID, subjectID uuid.UUID, circleID *uuid.UUID) (Grant, error) {
+	grants, err := p.Audience(ctx, subjectID, []uuid.UUID{viewerID}, circleID)
+	if err != nil {
+		return Grant{}, err
+	}
+	return grants[viewerID], nil
+}
+
+// Grants returns what each subject shares with viewerID.
+func (p *Projector) Grants(ctx context.Context, viewerID uuid.UUID, subjectIDs []uuid.UUID, circleID *uuid.UUID) (map[uuid.UUID]Grant, error) {
+	// Blocks hide both sides, so the viewer's own relations cover every
+	// subject.
+	hidden, err := p.blockPolicy.HiddenUsers(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := memberSets{}
+	grants := make(map[uuid.UUID]Grant, len(subjectIDs))
+	for _, id := range subjectIDs {
+		if _, ok := grants[id]; ok {
+			continue
+		}
+		if id == viewerID {
+			grants[id] = Full
+			continue
+		}
+		if _, ok := hidden[id]; ok {
+			grants[id] = Grant{}
+			continue
+		}
+
+		sub, err := p.loadSubject(ctx, id, circleID)
+		if err != nil {
+			return nil, err
+		}
+		g, err := p.grant(ctx, members, sub, viewerID, false)
+		if err != nil {
+			return nil, err
+		}
+		grants[id] = g
+	}
+	return grants, nil
+}
+
+// CircleGrants returns what every member of the circle shares with viewerID
+// in that circle. The viewer must be a member.
+func (p *Projector) CircleGrants(ctx context.Context, viewerID, circleID uuid.UUID) (map[uuid.UUID]Grant, error) {
+	isMember, err := p.circleRepo.IsMember(ctx, circleID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, circle.ErrNotCircleMember
+	}
+
+	members, err := p.circleRepo.ListMembers(ctx, circleID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		memberIDs[i] = m.UserID
+	}
+	return p.Grants(ctx, viewerID, memberIDs, &circleID)
+}
+
+// Audience returns what subjectID shares with each viewer, for fanning out
+// one user's updates.
+func (p *Projector) Audience(ctx context.Context, subjectID uuid.UUID, viewerIDs []uuid.UUID, circleID *uuid.UUID) (map[uuid.UUID]Grant, error) {
+	return p.audience(ctx, subjectID, viewerIDs, circleID, false)
+}
+
+// LiveAudience is Audience for viewers reached by one of the subject's live
+// sessions, who also see the subject's exact location whatever the sharing
+// preferences say. Blocks still hide everything.
+func (p *Projector) LiveAudience(ctx context.Context, subjectID uuid.UUID, viewerIDs []uuid.UUID, circleID *uuid.UUID) (map[uuid.UUID]Grant, error) {
+	return p.audience(ctx, subjectID, viewerIDs, circleID, true)
+}
+
+func (p *Projector) audience(ctx context.Context, subjectID uuid.UUID, viewerIDs []uuid.UUID, circleID *uuid.UUID, live bool) (map[uuid.UUID]Grant, error) {
+	sub, err := p.loadSubject(ctx, subjectID, circleID)
+	if err != nil {
+		return nil, err
+	}
+
+	hidden, err := p.blockPolicy.HiddenUsers(ctx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := memberSets{}
+	grants := make(map[uuid.UUID]Grant, len(viewerIDs))
+	for _, viewerID := range viewerIDs {
+		if viewerID == subjectID {
+			grants[viewerID] = Full
+			continue
+		}
+		if _, ok := hidden[viewerID]; ok {
+			grants[viewerID] = Grant{}
+			continue
+		}
+
+		g, err := p.grant(ctx, members, sub, viewerID, live)
+		if err != nil {
+			return nil, err
+		}
+		grants[viewerID] = g
+	}
+	return grants, nil
+}
+
+// subject is what the projector needs to know about the user being viewed.
+type subject struct {
+	prefs     []*circle.SharingPreference
+	userPrefs *user.Preferences // Nil when the user has none
+}
+
+func (p *Projector) loadSubject(ctx context.Context, subjectID uuid.UUID, circleID *uuid.UUID) (subject, error) {
+	prefs, err := p.sharingPreferences(ctx, subjectID, circleID)
+	if err != nil {
+		return subject{}, err
+	}
+
+	userPrefs, err := p.userRepo.GetPreferences(ctx, subjectID)
+	if errors.Is(err, user.ErrPreferencesNotFound) {
+		userPrefs = nil
+	} else if err != nil {
+		return subject{}, err
+	}
+
+	return subject{prefs: prefs, userPrefs: userPrefs}, nil
+}
+
+// grant combines the subject's preferences for every circle they share with
+// the viewer. Blocks must already have been checked.
+func (p *Projector) grant(ctx context.Context, members memberSets, sub subject, viewerID uuid.UUID, live bool) (Grant, error) {
+	var g Grant
+	for _, pref := range sub.prefs {
+		shared, err := members.contains(ctx, p.circleRepo, pref.CircleID, viewerID)
+		if err != nil {
+			return Grant{}, err
+		}
+		if shared {
+			g = g.merge(fromPreference(pref))
+		}
+	}
+	g = g.restrict(sub.userPrefs)
+	if live {
+		g.Location = true
+		g.Precision = location.PrecisionExact
+	}
+	return g, nil
+}
+
+// memberSets caches the members of each circle consulted during one call, so
+// that checking many viewers or subjects costs one query per circle.
+type memberSets map[uuid.UUID]map[uuid.UUID]struct{}
+
+func (m memberSets) contains(ctx context.Context, repo circle.Repository, circleID, userID uuid.UUID) (bool, error) {
+	set, ok := m[circleID]
+	if !ok {
+		members, err := repo.ListMembers(ctx, circleID)
+		if err != nil {
+			return false, err
+		}
+		set = make(map[uuid.UUID]struct{}, len(members))
+		for _, member := range members {
+			set[member.UserID] = struct{}{}
+		}
+		m[circleID] = set
+	}
+	_, ok = set[userID]
+	return ok, nil
+}
+
+// CircleAudience returns what subjectID shares with everyone they have at
+// least one circle in common with, themself included.
+func (p *Projector) CircleAudience(ctx context.Context, subjectID uuid.UUID) (map[uuid.UUID]Grant, error) {
+	viewerIDs, err := p.circleMembers(ctx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	return p.Audience(ctx, subjectID, viewerIDs, nil)
+}
+
+// MemberGrants returns what everyone viewerID has at least one circle in
+// common with shares with them, the viewer included.
+func (p *Projector) MemberGrants(ctx context.Context, viewerID uuid.UUID) (map[uuid.UUID]Grant, error) {
+	subjectIDs, err := p.circleMembers(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return p.Grants(ctx, viewerID, subjectIDs, nil)
+}
+
+// circleMembers returns the members of every circle the user is in, the
+// user first.
+func (p *Projector) circleMembers(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	seen := map[uuid.UUID]struct{}{userID: {}}
+	members := []uuid.UUID{userID}
+
+	for offset := 0; ; offset += circlePageSize {
+		circles, err := p.circleRepo.ListByUser(ctx, userID, circlePageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range circles {
+			circleMembers, err := p.circleRepo.ListMembers(ctx, c.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range circleMembers {
+				if _, ok := seen[m.UserID]; !ok {
+					seen[m.UserID] = struct{}{}
+					members = append(members, m.UserID)
+				}
+			}
+		}
+
+		if len(circles) < circlePageSize {
+			return members, nil
+		}
+	}
+}
+
+// sharingPreferences returns the subject's preferences for the circle, or for
+// every circle they are in.
+func (p *Projector) sharingPreferences(ctx context.Context, subjectID uuid.UUID, circleID *uuid.UUID) ([]*circle.SharingPreference, error) {
+	if circleID == nil {
+		return p.circleRepo.ListSharingPreferences(ctx, subjectID)
+	}
+
+	pref, err := p.circleRepo.GetSharingPreference(ctx, *circleID, subjectID)
+	if errors.Is(err, circle.ErrSharingPreferenceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*circle.SharingPreference{pref}, nil
+}
@@ -0,0 +1,208 @@
+package privacy
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+type fakeCircles struct {
+	circle.Repository
+	members map[uuid.UUID][]uuid.UUID // By circle
+	prefs   []*circle.SharingPreference
+}
+
+func (r *fakeCircles) ListByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*circle.Circle, error) {
+	var circles []*circle.Circle
+	for id, members := range r.members {
+		if slices.Contains(members, userID) {
+			circles = append(circles, &circle.Circle{ID: id})
+		}
+	}
+	return circles[min(offset, len(circles)):min(offset+limit, len(circles))], nil
+}
+
+func (r *fakeCircles) IsMember(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
+	return slices.Contains(r.members[circleID], userID), nil
+}
+
+func (r *fakeCircles) ListMembers(ctx context.Context, circleID uuid.UUID) ([]*circle.Member, error) {
+	var members []*circle.Member
+	for _, id := range r.members[circleID] {
+		members = append(members, &circle.Member{CircleID: circleID, UserID: id})
+	}
+	return members, nil
+}
+
+func (r *fakeCircles) GetSharingPreference(ctx context.Context, circleID, userID uuid.UUID) (*circle.SharingPreference, error) {
+	for _, pref := range r.prefs {
+		if pref.CircleID == circleID && pref.UserID == userID {
+			return pref, nil
+		}
+	}
+	return nil, circle.ErrSharingPreferenceNotFound
+}
+
+func (r *fakeCircles) ListSharingPreferences(ctx context.Context, userID uuid.UUID) ([]*circle.SharingPreference, error) {
+	var prefs []*circle.SharingPreference
+	for _, pref := range r.prefs {
+		if pref.UserID == userID {
+			prefs = append(prefs, pref)
+		}
+	}
+	return prefs, nil
+}
+
+type fakeUsers struct {
+	user.Repository
+	prefs map[uuid.UUID]*user.Preferences
+}
+
+func (r fakeUsers) GetPreferences(ctx context.Context, userID uuid.UUID) (*user.Preferences, error) {
+	p, ok := r.prefs[userID]
+	if !ok {
+		return nil, user.ErrPreferencesNotFound
+	}
+	return p, nil
+}
+
+// fakeBlocks holds blocks in both directions.
+type fakeBlocks struct {
+	contact.Repository
+	blocked map[uuid.UUID][]uuid.UUID
+}
+
+func (r fakeBlocks) ListBlockRelations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return r.blocked[userID], nil
+}
+
+func TestProjector(t *testing.T) {
+	ctx := context.Background()
+	alice, bob, carol, dave, erin := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	family, work := uuid.New(), uuid.New()
+
+	// Alice shows her family the basics and her colleagues her city.
+	familyPref := circle.NewSharingPreference(family, alice)
+	familyPref.PrivacyLevel = user.PrivacyLevelBasic
+	workPref := circle.NewSharingPreference(work, alice)
+	workPref.PrivacyLevel = user.PrivacyLevelLocation
+	workPref.ShareLocation = true
+	workPref.LocationPrecision = location.PrecisionCity
+
+	newProjector := func(userPrefs map[uuid.UUID]*user.Preferences) *Projector {
+		circles := &fakeCircles{
+			members: map[uuid.UUID][]uuid.UUID{
+				family: {alice, bob, carol},
+				work:   {alice, bob, dave},
+			},
+			prefs: []*circle.SharingPreference{familyPref, workPref},
+		}
+		blocks := fakeBlocks{blocked: map[uuid.UUID][]uuid.UUID{alice: {dave}, dave: {alice}}}
+		return NewProjector(circles, fakeUsers{prefs: userPrefs}, contact.NewBlockPolicy(blocks))
+	}
+
+	basic := Grant{Presence: true, LastSeen: true, Timezone: true}
+	city := Grant{Presence: true, LastSeen: true, Timezone: true, Availability: true, Location: true, Precision: location.PrecisionCity}
+
+	tests := []struct {
+		name     string
+		viewerID uuid.UUID
+		circleID *uuid.UUID
+		want     Grant
+	}{
+		{name: "users see all of themselves", viewerID: alice, want: Full},
+		{name: "circles in common combine", viewerID: bob, want: city},
+		{name: "one circle in common", viewerID: carol, want: basic},
+		{name: "the given circle only", viewerID: bob, circleID: &family, want: basic},
+		{name: "blocks hide everything", viewerID: dave, want: Grant{}},
+		{name: "no circle in common", viewerID: erin, want: Grant{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newProjector(nil)
+
+			got, err := p.Grant(ctx, tt.viewerID, alice, tt.circleID)
+			if err != nil {
+				t.Fatalf("Grant: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Grant = %+v, want %+v", got, tt.want)
+			}
+
+			// Both directions of the projection agree.
+			grants, err := p.Grants(ctx, tt.viewerID, []uuid.UUID{alice}, tt.circleID)
+			if err != nil {
+				t.Fatalf("Grants: %v", err)
+			}
+			if grants[alice] != tt.want {
+				t.Errorf("Grants = %+v, want %+v", grants[alice], tt.want)
+			}
+		})
+	}
+
+	t.Run("account preferences override circles", func(t *testing.T) {
+		prefs := user.NewPreferences(alice)
+		prefs.ShowOnlineStatus = false
+		prefs.ShowLastSeen = false
+
+		got, err := newProjector(map[uuid.UUID]*user.Preferences{alice: prefs}).Grant(ctx, bob, alice, nil)
+		if err != nil {
+			t.Fatalf("Grant: %v", err)
+		}
+		if got.Presence || got.LastSeen || !got.Location {
+			t.Errorf("Grant = %+v, want location without presence", got)
+		}
+	})
+
+	t.Run("live sessions share the exact location but not past a block", func(t *testing.T) {
+		grants, err := newProjector(nil).LiveAudience(ctx, alice, []uuid.UUID{carol, dave}, &family)
+		if err != nil {
+			t.Fatalf("LiveAudience: %v", err)
+		}
+		if g := grants[carol]; !g.Location || g.Precision != location.PrecisionExact {
+			t.Errorf("carol = %+v, want the exact location", g)
+		}
+		if g := grants[dave]; g != (Grant{}) {
+			t.Errorf("dave = %+v, want nothing", g)
+		}
+	})
+
+	t.Run("circle grants need membership", func(t *testing.T) {
+		p := newProjector(nil)
+		if _, err := p.CircleGrants(ctx, dave, family); !errors.Is(err, circle.ErrNotCircleMember) {
+			t.Fatalf("CircleGrants by a non-member: error = %v, want %v", err, circle.ErrNotCircleMember)
+		}
+
+		grants, err := p.CircleGrants(ctx, carol, family)
+		if err != nil {
+			t.Fatalf("CircleGrants: %v", err)
+		}
+		if len(grants) != 3 || grants[alice] != basic || grants[carol] != Full {
+			t.Errorf("CircleGrants = %+v", grants)
+		}
+	})
+
+	t.Run("circle audience reaches everyone in a circle in common", func(t *testing.T) {
+		grants, err := newProjector(nil).CircleAudience(ctx, alice)
+		if err != nil {
+			t.Fatalf("CircleAudience: %v", err)
+		}
+		want := map[uuid.UUID]Grant{alice: Full, bob: city, carol: basic, dave: {}}
+		if len(grants) != len(want) {
+			t.Fatalf("CircleAudience reached %d users, want %d", len(grants), len(want))
+		}
+		for id, g := range want {
+			if grants[id] != g {
+				t.Errorf("grant for %v = %+v, want %+v", id, grants[id], g)
+			}
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package realtime
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Broker fans events out to per-user subscriptions, potentially across
+// several API instances.
+type Broker interface {
+	Publish(ctx context.Context, userIDs []uuid.UUID, event *Event) error
+	Subscribe(ctx context.Context, userID uuid.UUID) (Subscription, error)
+}
+
+type Subscription interface {
+	Events() <-chan *Event
+	Close() error
+}
+
+// Publisher resolves the audience of an event and delivers it.
+type Publisher interface {
+	PublishToUsers(ctx context.Context, userIDs []uuid.UUID, event *Event) error
+	PublishToConversation(ctx context.Context, conversationID uuid.UUID, event *Event) error
+	PublishToCircle(ctx context.Context, circleID uuid.UUID, event *Event) error
+}
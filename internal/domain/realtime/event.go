@@ -0,0 +1,146 @@
+package realtime
+
+import (
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/danielng/kin-core-svc/internal/domain/circle"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/danielng/kin-core-svc/internal/domain/notification"
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"github.com/danielng/kin-core-svc/pkg/uid"
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventTypeMessageCreated      EventType = "message.created"
+	EventTypeMessageUpdated      EventType = "message.updated"
+	EventTypeMessageDeleted      EventType = "message.deleted"
+	EventTypeReactionAdded       EventType = "reaction.added"
+	EventTypeReactionRemoved     EventType = "reaction.removed"
+	EventTypeReceiptDelivered    EventType = "receipt.delivered"
+	EventTypeReceiptRead         EventType = "receipt.read"
+	EventTypeTypingStarted       EventType = "typing.started"
+	EventTypeTypingStopped       EventType = "typing.stopped"
+	EventTypePresenceChanged     EventType = "presence.changed"
+	EventTypeMemberJoined        EventType = "circle.member_joined"
+	EventTypeMemberLeft          EventType = "circle.member_left"
+	EventTypeAvailabilityChanged EventType = "availability.changed"
+	EventTypeNotificationCreated EventType = "notification.created"
+	EventTypeLocationUpdated     EventType = "location.updated"
+	EventTypeLiveSessionStarted  EventType = "location.live_started"
+	EventTypeLiveSessionEnded    EventType = "location.live_ended"
+)
+
+// Event is a change pushed to connected clients. Only the payload fields
+// relevant to Type are set.
+type Event struct {
+	ID             uuid.UUID                  `json:"id"`
+	Type           EventType                  `json:"type"`
+	ActorID        uuid.UUID                  `json:"actor_id"`
+	ConversationID *uuid.UUID                 `json:"conversation_id,omitempty"`
+	CircleID       *uuid.UUID                 `json:"circle_id,omitempty"`
+	Message        *messaging.Message         `json:"message,omitempty"`
+	Reaction       *messaging.Reaction        `json:"reaction,omitempty"`
+	MessageIDs     []uuid.UUID                `json:"message_ids,omitempty"`
+	Presence       *presence.Presence         `json:"presence,omitempty"`
+	Member         *circle.Member             `json:"member,omitempty"`
+	Availability   *availability.Availability `json:"availability,omitempty"`
+	Notification   *notification.Notification `json:"notification,omitempty"`
+	Location       *location.SharedLocation   `json:"location,omitempty"`
+	LiveSession    *location.LiveSession      `json:"live_session,omitempty"`
+	OccurredAt     time.Time                  `json:"occurred_at"`
+}
+
+func newEvent(eventType EventType, actorID uuid.UUID) *Event {
+	return &Event{
+		ID:         uid.New(),
+		Type:       eventType,
+		ActorID:    actorID,
+		OccurredAt: time.Now(),
+	}
+}
+
+func NewMessageEvent(eventType EventType, msg *messaging.Message, actorID uuid.UUID) *Event {
+	e := newEvent(eventType, actorID)
+	e.ConversationID = &msg.ConversationID
+	e.Message = msg
+	e.MessageIDs = []uuid.UUID{msg.ID}
+	return e
+}
+
+// NewMessageDeletedEvent carries only the message reference so deleted
+// content is never redistributed.
+func NewMessageDeletedEvent(msg *messaging.Message, actorID uuid.UUID) *Event {
+	e := newEvent(EventTypeMessageDeleted, actorID)
+	e.ConversationID = &msg.ConversationID
+	e.MessageIDs = []uuid.UUID{msg.ID}
+	return e
+}
+
+func NewReactionEvent(eventType EventType, reaction *messaging.Reaction, conversationID uuid.UUID) *Event {
+	e := newEvent(eventType, reaction.UserID)
+	e.ConversationID = &conversationID
+	e.Reaction = reaction
+	e.MessageIDs = []uuid.UUID{reaction.MessageID}
+	return e
+}
+
+func NewReceiptEvent(eventType EventType, userID, conversationID uuid.UUID, messageIDs []uuid.UUID) *Event {
+	e := newEvent(eventType, userID)
+	e.ConversationID = &conversationID
+	e.MessageIDs = messageIDs
+	return e
+}
+
+func NewTypingEvent(eventType EventType, userID, conversationID uuid.UUID) *Event {
+	e := newEvent(eventType, userID)
+	e.ConversationID = &conversationID
+	return e
+}
+
+func NewPresenceEvent(p *presence.Presence) *Event {
+	e := newEvent(EventTypePresenceChanged, p.UserID)
+	e.Presence = p
+	return e
+}
+
+func NewMemberEvent(eventType EventType, member *circle.Member, actorID uuid.UUID) *Event {
+	e := newEvent(eventType, actorID)
+	e.CircleID = &member.CircleID
+	e.Member = member
+	return e
+}
+
+func NewAvailabilityEvent(a *availability.Availability) *Event {
+	e := newEvent(EventTypeAvailabilityChanged, a.UserID)
+	e.Availability = a
+	return e
+}
+
+func NewNotificationEvent(n *notification.Notification, actorID uuid.UUID) *Event {
+	e := newEvent(EventTypeNotificationCreated, actorID)
+	e.Notification = n
+	return e
+}
+
+// NewLocationEvent carries a location streamed through a live session.
+func NewLocationEvent(l *location.SharedLocation, session *location.LiveSession) *Event {
+	e := newEvent(EventTypeLocationUpdated, session.UserID)
+	e.CircleID = session.CircleID
+	e.ConversationID = session.ConversationID
+	e.Location = l
+	e.LiveSession = session
+	return e
+}
+
+func NewLiveSessionEvent(eventType EventType, session *location.LiveSession) *Event {
+	e := newEvent(eventType, session.UserID)
+	e.CircleID = session.CircleID
+	e.ConversationID = session.ConversationID
+	e.LiveSession = session
+	return e
+}
@@ -0,0 +1,13 @@
+package uow
+
+import (
+	"context"
+)
+
+// UnitOfWork makes a multi-step operation atomic. Repository calls made with
+// the context handed to fn take part in the same transaction, and nested Do
+// calls join it. fn may run more than once when the transaction has to be
+// retried, so it must not have side effects outside the database.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"time"
 
+	"github.com/danielng/kin-core-svc/internal/domain/location"
 	"github.com/google/uuid"
 )
 
@@ -15,20 +16,29 @@ const (
 	PrivacyLevelLocation PrivacyLevel = "location" // Real-time location sharing
 )
 
+// privacyRank orders the levels; each level also shares everything below it.
+var privacyRank = map[PrivacyLevel]int{
+	PrivacyLevelBasic:    1,
+	PrivacyLevelStatus:   2,
+	PrivacyLevelActivity: 3,
+	PrivacyLevelLocation: 4,
+}
+
 type Preferences struct {
-	UserID                uuid.UUID    `json:"user_id"`
-	DefaultPrivacyLevel   PrivacyLevel `json:"default_privacy_level"`
-	ShowOnlineStatus      bool         `json:"show_online_status"`
-	ShowLastSeen          bool         `json:"show_last_seen"`
-	ShowReadReceipts      bool         `json:"show_read_receipts"`
-	AllowContactDiscovery bool         `json:"allow_contact_discovery"`
-	PushNotifications     bool         `json:"push_notifications"`
-	EmailNotifications    bool         `json:"email_notifications"`
-	QuietHoursEnabled     bool         `json:"quiet_hours_enabled"`
-	QuietHoursStart       *string      `json:"quiet_hours_start,omitempty"` // HH:MM format
-	QuietHoursEnd         *string      `json:"quiet_hours_end,omitempty"`   // HH:MM format
-	CreatedAt             time.Time    `json:"created_at"`
-	UpdatedAt             time.Time    `json:"updated_at"`
+	UserID                uuid.UUID          `json:"user_id"`
+	DefaultPrivacyLevel   PrivacyLevel       `json:"default_privacy_level"`
+	ShowOnlineStatus      bool               `json:"show_online_status"`
+	ShowLastSeen          bool               `json:"show_last_seen"`
+	ShowReadReceipts      bool               `json:"show_read_receipts"`
+	AllowContactDiscovery bool               `json:"allow_contact_discovery"`
+	PushNotifications     bool               `json:"push_notifications"`
+	EmailNotifications    bool               `json:"email_notifications"`
+	QuietHoursEnabled     bool               `json:"quiet_hours_enabled"`
+	QuietHoursStart       *string            `json:"quiet_hours_start,omitempty"` // HH:MM format
+	QuietHoursEnd         *string            `json:"quiet_hours_end,omitempty"`   // HH:MM format
+	LocationRetention     location.Retention `json:"location_retention"`
+	CreatedAt             time.Time          `json:"created_at"`
+	UpdatedAt             time.Time          `json:"updated_at"`
 }
 
 func NewPreferences(userID uuid.UUID) *Preferences {
@@ -43,6 +53,7 @@ func NewPreferences(userID uuid.UUID) *Preferences {
 		PushNotifications:     true,
 		EmailNotifications:    false,
 		QuietHoursEnabled:     false,
+		LocationRetention:     location.DefaultRetention,
 		CreatedAt:             now,
 		UpdatedAt:             now,
 	}
@@ -68,3 +79,8 @@ func IsValidPrivacyLevel(level PrivacyLevel) bool {
 		return false
 	}
 }
+
+// Includes reports whether sharing at level l also shares what other covers.
+func (l PrivacyLevel) Includes(other PrivacyLevel) bool {
+	return privacyRank[l] >= privacyRank[other]
+}
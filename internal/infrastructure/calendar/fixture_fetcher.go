@@ -0,0 +1,28 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+)
+
+// FixtureFetcher serves calendar feeds from memory, keyed by URL, so that
+// syncing can be exercised without network access.
+type FixtureFetcher struct {
+	feeds map[string][]byte
+}
+
+func NewFixtureFetcher(feeds map[string][]byte) *FixtureFetcher {
+	return &FixtureFetcher{feeds: feeds}
+}
+
+func (f *FixtureFetcher) Fetch(_ context.Context, url string) ([]byte, error) {
+	data, ok := f.feeds[url]
+	if !ok {
+		return nil, fmt.Errorf("no calendar fixture for %s", url)
+	}
+	return data, nil
+}
+
+var _ availability.CalendarFetcher = (*FixtureFetcher)(nil)
@@ -0,0 +1,104 @@
+package geocoding
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+)
+
+// cacheGrid is how finely points are told apart: three decimal places, or
+// about 100 meters.
+const cacheGrid = 1000
+
+type cacheKey struct {
+	lat int64
+	lng int64
+}
+
+type cacheEntry struct {
+	key       cacheKey
+	info      *location.GeocodedInfo
+	expiresAt time.Time
+}
+
+// Cached remembers what another geocoder returned for points about 100 meters
+// apart, keeping up to size of the most recently used results for ttl.
+type Cached struct {
+	next location.Geocoder
+	size int
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[cacheKey]*list.Element
+	order   *list.List // Most recently used first
+}
+
+func NewCached(next location.Geocoder, size int, ttl time.Duration) *Cached {
+	return &Cached{
+		next:    next,
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[cacheKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *Cached) ReverseGeocode(ctx context.Context, lat, lng float64) (*location.GeocodedInfo, error) {
+	key := cacheKey{
+		lat: int64(math.Round(lat * cacheGrid)),
+		lng: int64(math.Round(lng * cacheGrid)),
+	}
+	if info, ok := c.get(key); ok {
+		return info, nil
+	}
+
+	info, err := c.next.ReverseGeocode(ctx, lat, lng)
+	if err != nil {
+		return nil, err
+	}
+	c.put(key, info)
+	return info, nil
+}
+
+func (c *Cached) get(key cacheKey) (*location.GeocodedInfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return entry.info, true
+}
+
+func (c *Cached) put(key cacheKey, info *location.GeocodedInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{key: key, info: info, expiresAt: time.Now().Add(c.ttl)}
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+var _ location.Geocoder = (*Cached)(nil)
@@ -0,0 +1,206 @@
+package geocoding
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+)
+
+//go:embed data/cities.tsv
+var bundledCities []byte
+
+//go:embed data/countries.tsv
+var bundledCountries []byte
+
+// A point is named after the nearest city within cityRadius, and placed in
+// the country of the nearest city within countryRadius, which makes the
+// country a guess close to borders.
+const (
+	cityRadius    = 50000.0  // Meters
+	countryRadius = 500000.0 // Meters
+)
+
+type city struct {
+	name    string
+	country string // ISO 3166-1 alpha-2 code
+	lat     float64
+	lng     float64
+}
+
+// cell is the one-degree square of latitude and longitude a city is indexed
+// under.
+type cell struct {
+	lat int
+	lng int
+}
+
+// Offline reverse geocodes from a list of cities held in memory, so it needs
+// no network. It resolves countries and cities only.
+type Offline struct {
+	countries map[string]string
+	cells     map[cell][]city
+}
+
+// NewOffline loads the cities of the GeoNames dump at path, such as
+// cities15000.txt, or the bundled list of major cities when path is empty.
+func NewOffline(path string) (*Offline, error) {
+	countries, err := parseCountries(bytes.NewReader(bundledCountries))
+	if err != nil {
+		return nil, err
+	}
+
+	var r io.Reader = bytes.NewReader(bundledCities)
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open geocoder data: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+
+	cities, err := parseCities(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Offline{countries: countries, cells: make(map[cell][]city)}
+	for _, c := range cities {
+		k := cellOf(c.lat, c.lng)
+		g.cells[k] = append(g.cells[k], c)
+	}
+	return g, nil
+}
+
+func (g *Offline) ReverseGeocode(ctx context.Context, lat, lng float64) (*location.GeocodedInfo, error) {
+	info := &location.GeocodedInfo{}
+	nearest, distance := g.nearest(lat, lng)
+	if nearest == nil {
+		return info, nil
+	}
+
+	if name, ok := g.countries[nearest.country]; ok {
+		info.Country = &name
+	}
+	if distance <= cityRadius {
+		name := nearest.name
+		info.City = &name
+	}
+	return info, nil
+}
+
+// nearest returns the city closest to (lat, lng) within countryRadius, if
+// any, and its distance in meters.
+func (g *Offline) nearest(lat, lng float64) (*city, float64) {
+	var (
+		best     *city
+		bestDist = math.Inf(1)
+	)
+	search := func(minLat, maxLat, minLng, maxLng int) {
+		for y := minLat; y <= maxLat; y++ {
+			for x := minLng; x <= maxLng; x++ {
+				cities := g.cells[cell{lat: y, lng: x}]
+				for i := range cities {
+					c := &cities[i]
+					if d := location.Distance(lat, lng, c.lat, c.lng); d < bestDist {
+						best, bestDist = c, d
+					}
+				}
+			}
+		}
+	}
+
+	box := location.BoundingBox(lat, lng, countryRadius)
+	minLat, maxLat := floor(box.MinLat), floor(box.MaxLat)
+	if box.MinLng <= box.MaxLng {
+		search(minLat, maxLat, floor(box.MinLng), floor(box.MaxLng))
+	} else {
+		search(minLat, maxLat, floor(box.MinLng), 180)
+		search(minLat, maxLat, -180, floor(box.MaxLng))
+	}
+
+	if best == nil || bestDist > countryRadius {
+		return nil, 0
+	}
+	return best, bestDist
+}
+
+// parseCities reads either the bundled list, with a name, country code,
+// latitude and longitude per line, or a GeoNames dump, from which only
+// populated places (feature class P) are kept.
+func parseCities(r io.Reader) ([]city, error) {
+	var cities []city
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		var name, country, lat, lng string
+		switch {
+		case len(fields) == 4:
+			name, country, lat, lng = fields[0], fields[1], fields[2], fields[3]
+		case len(fields) >= 9:
+			if fields[6] != "P" {
+				continue
+			}
+			name, country, lat, lng = fields[1], fields[8], fields[4], fields[5]
+		default:
+			return nil, fmt.Errorf("invalid geocoder data on line %d", n)
+		}
+
+		c := city{name: name, country: country}
+		var err error
+		if c.lat, err = strconv.ParseFloat(lat, 64); err != nil {
+			return nil, fmt.Errorf("invalid latitude on line %d: %w", n, err)
+		}
+		if c.lng, err = strconv.ParseFloat(lng, 64); err != nil {
+			return nil, fmt.Errorf("invalid longitude on line %d: %w", n, err)
+		}
+		if !location.IsValidCoordinates(c.lat, c.lng) {
+			return nil, fmt.Errorf("invalid coordinates on line %d", n)
+		}
+		cities = append(cities, c)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read geocoder data: %w", err)
+	}
+	return cities, nil
+}
+
+func parseCountries(r io.Reader) (map[string]string, error) {
+	countries := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		code, name, ok := strings.Cut(scanner.Text(), "\t")
+		if ok {
+			countries[code] = name
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read country names: %w", err)
+	}
+	return countries, nil
+}
+
+func cellOf(lat, lng float64) cell {
+	return cell{lat: floor(lat), lng: floor(lng)}
+}
+
+func floor(v float64) int {
+	return int(math.Floor(v))
+}
+
+var _ location.Geocoder = (*Offline)(nil)
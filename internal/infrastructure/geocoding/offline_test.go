@@ -0,0 +1,54 @@
+package geocoding
+
+import (
+	"math"
+	"testing"
+)
+
+func newTestOffline(cities ...city) *Offline {
+	g := &Offline{countries: map[string]string{}, cells: make(map[cell][]city)}
+	for _, c := range cities {
+		k := cellOf(c.lat, c.lng)
+		g.cells[k] = append(g.cells[k], c)
+	}
+	return g
+}
+
+func TestOfflineNearest(t *testing.T) {
+	suva := city{name: "Suva", country: "FJ", lat: -18.1416, lng: 178.4415}
+	east := city{name: "East", country: "FJ", lat: -18, lng: 179.9}
+	west := city{name: "West", country: "TO", lat: -25, lng: -179.9}
+	beside := city{name: "Beside", country: "FJ", lat: -18, lng: -179.5}
+	g := newTestOffline(suva, east, west, beside)
+
+	tests := []struct {
+		name     string
+		lat, lng float64
+		want     string
+		wantKm   float64
+	}{
+		{name: "same side", lat: -18, lng: 179.5, want: "East", wantKm: 42.3},
+		{name: "across the antimeridian from the west", lat: -18, lng: -179.95, want: "East", wantKm: 15.9},
+		{name: "across the antimeridian from the east", lat: -25, lng: 179.95, want: "West", wantKm: 15.1},
+		{name: "on the antimeridian", lat: -18, lng: 180, want: "East", wantKm: 10.6},
+		{name: "beyond the country radius", lat: -18, lng: -172, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, dist := g.nearest(tt.lat, tt.lng)
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("nearest = %s, want none", got.name)
+				}
+				return
+			}
+			if got == nil || got.name != tt.want {
+				t.Fatalf("nearest = %v, want %s", got, tt.want)
+			}
+			if math.Abs(dist/1000-tt.wantKm) > 0.5 {
+				t.Errorf("distance = %.1f km, want %.1f km", dist/1000, tt.wantKm)
+			}
+		})
+	}
+}
@@ -0,0 +1,419 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const availabilityColumns = `
+	user_id, status, status_message, manual_until, auto_status, updated_at, current_place_id, next_transition_at
+`
+
+const windowColumns = `
+	id, user_id, name, weekday, start_time, end_time, status, is_active, created_at, updated_at
+`
+
+const autoRuleColumns = `
+	id, user_id, name, condition, target_status, priority, is_active, created_at, updated_at
+`
+
+const calendarColumns = `
+	id, user_id, name, url, events, is_active, last_synced_at, last_error, created_at, updated_at
+`
+
+type AvailabilityRepository struct {
+	db *DB
+}
+
+func NewAvailabilityRepository(db *DB) *AvailabilityRepository {
+	return &AvailabilityRepository{db: db}
+}
+
+func (r *AvailabilityRepository) CreateOrUpdate(ctx context.Context, a *availability.Availability) error {
+	query := `
+		INSERT INTO user_availability (user_id, status, status_message, manual_until, auto_status, updated_at, current_place_id, next_transition_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			status_message = EXCLUDED.status_message,
+			manual_until = EXCLUDED.manual_until,
+			auto_status = EXCLUDED.auto_status,
+			updated_at = EXCLUDED.updated_at,
+			current_place_id = EXCLUDED.current_place_id,
+			next_transition_at = EXCLUDED.next_transition_at
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		a.UserID, a.Status, a.StatusMessage, a.ManualUntil, a.AutoStatus, a.UpdatedAt, a.CurrentPlaceID, a.NextTransitionAt)
+	if err != nil {
+		return fmt.Errorf("failed to save availability: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*availability.Availability, error) {
+	query := `SELECT ` + availabilityColumns + ` FROM user_availability WHERE user_id = $1`
+
+	var a availability.Availability
+	err := r.db.reader(ctx).QueryRow(ctx, query, userID).Scan(
+		&a.UserID, &a.Status, &a.StatusMessage, &a.ManualUntil, &a.AutoStatus, &a.UpdatedAt, &a.CurrentPlaceID, &a.NextTransitionAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, availability.ErrAvailabilityNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get availability: %w", err)
+	}
+	return &a, nil
+}
+
+func (r *AvailabilityRepository) GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*availability.Availability, error) {
+	query := `SELECT ` + availabilityColumns + ` FROM user_availability WHERE user_id = ANY($1)`
+
+	rows, err := r.db.reader(ctx).Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get availabilities: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*availability.Availability
+	for rows.Next() {
+		var a availability.Availability
+		if err := rows.Scan(&a.UserID, &a.Status, &a.StatusMessage, &a.ManualUntil, &a.AutoStatus, &a.UpdatedAt, &a.CurrentPlaceID, &a.NextTransitionAt); err != nil {
+			return nil, fmt.Errorf("failed to scan availability: %w", err)
+		}
+		result = append(result, &a)
+	}
+	return result, rows.Err()
+}
+
+// ListDueTransitions returns users whose status needs re-resolving, oldest
+// first. It reads from the primary: the scheduler lists again right after
+// refreshing a batch, and a lagging replica would hand back the same users.
+func (r *AvailabilityRepository) ListDueTransitions(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error) {
+	query := `
+		SELECT user_id
+		FROM user_availability
+		WHERE next_transition_at <= $1
+		ORDER BY next_transition_at
+		LIMIT $2
+	`
+	rows, err := r.db.writer(ctx).Query(ctx, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due availability transitions: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan availability transition: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *AvailabilityRepository) CreateWindow(ctx context.Context, w *availability.Window) error {
+	query := `
+		INSERT INTO availability_windows (id, user_id, name, weekday, start_time, end_time, status, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		w.ID, w.UserID, w.Name, w.Weekday, w.StartTime, w.EndTime, w.Status, w.IsActive, w.CreatedAt, w.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create availability window: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) GetWindowByID(ctx context.Context, id uuid.UUID) (*availability.Window, error) {
+	query := `SELECT ` + windowColumns + ` FROM availability_windows WHERE id = $1`
+	return r.scanWindow(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *AvailabilityRepository) UpdateWindow(ctx context.Context, w *availability.Window) error {
+	query := `
+		UPDATE availability_windows
+		SET name = $1, weekday = $2, start_time = $3, end_time = $4, status = $5, is_active = $6, updated_at = $7
+		WHERE id = $8
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		w.Name, w.Weekday, w.StartTime, w.EndTime, w.Status, w.IsActive, w.UpdatedAt, w.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update availability window: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) DeleteWindow(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM availability_windows WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete availability window: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) ListWindowsByUser(ctx context.Context, userID uuid.UUID) ([]*availability.Window, error) {
+	query := `
+		SELECT ` + windowColumns + `
+		FROM availability_windows
+		WHERE user_id = $1
+		ORDER BY weekday, start_time
+	`
+	return r.queryWindows(ctx, query, userID)
+}
+
+func (r *AvailabilityRepository) ListActiveWindowsByUser(ctx context.Context, userID uuid.UUID) ([]*availability.Window, error) {
+	query := `
+		SELECT ` + windowColumns + `
+		FROM availability_windows
+		WHERE user_id = $1 AND is_active = true
+		ORDER BY weekday, start_time
+	`
+	return r.queryWindows(ctx, query, userID)
+}
+
+func (r *AvailabilityRepository) ListWindowsByUserAndWeekday(ctx context.Context, userID uuid.UUID, weekday availability.Weekday) ([]*availability.Window, error) {
+	query := `
+		SELECT ` + windowColumns + `
+		FROM availability_windows
+		WHERE user_id = $1 AND weekday = $2
+		ORDER BY start_time
+	`
+	return r.queryWindows(ctx, query, userID, weekday)
+}
+
+func (r *AvailabilityRepository) CreateAutoRule(ctx context.Context, rule *availability.AutoRule) error {
+	query := `
+		INSERT INTO availability_auto_rules (id, user_id, name, condition, target_status, priority, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		rule.ID, rule.UserID, rule.Name, rule.Condition, rule.TargetStatus, rule.Priority, rule.IsActive, rule.CreatedAt, rule.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create auto rule: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) GetAutoRuleByID(ctx context.Context, id uuid.UUID) (*availability.AutoRule, error) {
+	query := `SELECT ` + autoRuleColumns + ` FROM availability_auto_rules WHERE id = $1`
+	return r.scanAutoRule(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *AvailabilityRepository) UpdateAutoRule(ctx context.Context, rule *availability.AutoRule) error {
+	query := `
+		UPDATE availability_auto_rules
+		SET name = $1, condition = $2, target_status = $3, priority = $4, is_active = $5, updated_at = $6
+		WHERE id = $7
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		rule.Name, rule.Condition, rule.TargetStatus, rule.Priority, rule.IsActive, rule.UpdatedAt, rule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update auto rule: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) DeleteAutoRule(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM availability_auto_rules WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete auto rule: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) ListAutoRulesByUser(ctx context.Context, userID uuid.UUID) ([]*availability.AutoRule, error) {
+	query := `
+		SELECT ` + autoRuleColumns + `
+		FROM availability_auto_rules
+		WHERE user_id = $1
+		ORDER BY priority DESC, created_at
+	`
+	return r.queryAutoRules(ctx, query, userID)
+}
+
+func (r *AvailabilityRepository) ListActiveAutoRulesByUser(ctx context.Context, userID uuid.UUID) ([]*availability.AutoRule, error) {
+	query := `
+		SELECT ` + autoRuleColumns + `
+		FROM availability_auto_rules
+		WHERE user_id = $1 AND is_active = true
+		ORDER BY priority DESC, created_at
+	`
+	return r.queryAutoRules(ctx, query, userID)
+}
+
+func (r *AvailabilityRepository) CreateCalendar(ctx context.Context, c *availability.Calendar) error {
+	query := `
+		INSERT INTO availability_calendars (id, user_id, name, url, events, is_active, last_synced_at, last_error, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		c.ID, c.UserID, c.Name, c.URL, c.Events, c.IsActive, c.LastSyncedAt, c.LastError, c.CreatedAt, c.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create calendar: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) GetCalendarByID(ctx context.Context, id uuid.UUID) (*availability.Calendar, error) {
+	query := `SELECT ` + calendarColumns + ` FROM availability_calendars WHERE id = $1`
+	return r.scanCalendar(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *AvailabilityRepository) UpdateCalendar(ctx context.Context, c *availability.Calendar) error {
+	query := `
+		UPDATE availability_calendars
+		SET name = $1, events = $2, is_active = $3, last_synced_at = $4, last_error = $5, updated_at = $6
+		WHERE id = $7
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		c.Name, c.Events, c.IsActive, c.LastSyncedAt, c.LastError, c.UpdatedAt, c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update calendar: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) DeleteCalendar(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM availability_calendars WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar: %w", err)
+	}
+	return nil
+}
+
+func (r *AvailabilityRepository) ListCalendarsByUser(ctx context.Context, userID uuid.UUID) ([]*availability.Calendar, error) {
+	query := `
+		SELECT ` + calendarColumns + `
+		FROM availability_calendars
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+	return r.queryCalendars(ctx, query, userID)
+}
+
+func (r *AvailabilityRepository) ListActiveCalendarsByUser(ctx context.Context, userID uuid.UUID) ([]*availability.Calendar, error) {
+	query := `
+		SELECT ` + calendarColumns + `
+		FROM availability_calendars
+		WHERE user_id = $1 AND is_active = true
+		ORDER BY created_at
+	`
+	return r.queryCalendars(ctx, query, userID)
+}
+
+// ListCalendarsDueSync returns active URL calendars not synced since
+// syncedBefore, least recently synced first.
+func (r *AvailabilityRepository) ListCalendarsDueSync(ctx context.Context, syncedBefore time.Time, limit int) ([]*availability.Calendar, error) {
+	query := `
+		SELECT ` + calendarColumns + `
+		FROM availability_calendars
+		WHERE url IS NOT NULL AND is_active
+		  AND (last_synced_at IS NULL OR last_synced_at < $1)
+		ORDER BY last_synced_at NULLS FIRST
+		LIMIT $2
+	`
+	return r.queryCalendars(ctx, query, syncedBefore, limit)
+}
+
+func (r *AvailabilityRepository) queryWindows(ctx context.Context, query string, args ...any) ([]*availability.Window, error) {
+	rows, err := r.db.reader(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list availability windows: %w", err)
+	}
+	defer rows.Close()
+
+	var windows []*availability.Window
+	for rows.Next() {
+		var w availability.Window
+		if err := rows.Scan(&w.ID, &w.UserID, &w.Name, &w.Weekday, &w.StartTime, &w.EndTime, &w.Status, &w.IsActive, &w.CreatedAt, &w.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan availability window: %w", err)
+		}
+		windows = append(windows, &w)
+	}
+	return windows, rows.Err()
+}
+
+func (r *AvailabilityRepository) queryAutoRules(ctx context.Context, query string, args ...any) ([]*availability.AutoRule, error) {
+	rows, err := r.db.reader(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auto rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*availability.AutoRule
+	for rows.Next() {
+		var rule availability.AutoRule
+		if err := rows.Scan(&rule.ID, &rule.UserID, &rule.Name, &rule.Condition, &rule.TargetStatus, &rule.Priority, &rule.IsActive, &rule.CreatedAt, &rule.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan auto rule: %w", err)
+		}
+		rules = append(rules, &rule)
+	}
+	return rules, rows.Err()
+}
+
+func (r *AvailabilityRepository) queryCalendars(ctx context.Context, query string, args ...any) ([]*availability.Calendar, error) {
+	rows, err := r.db.reader(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendars: %w", err)
+	}
+	defer rows.Close()
+
+	var calendars []*availability.Calendar
+	for rows.Next() {
+		var c availability.Calendar
+		if err := rows.Scan(&c.ID, &c.UserID, &c.Name, &c.URL, &c.Events, &c.IsActive, &c.LastSyncedAt, &c.LastError, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan calendar: %w", err)
+		}
+		calendars = append(calendars, &c)
+	}
+	return calendars, rows.Err()
+}
+
+func (r *AvailabilityRepository) scanWindow(row pgx.Row) (*availability.Window, error) {
+	var w availability.Window
+	err := row.Scan(&w.ID, &w.UserID, &w.Name, &w.Weekday, &w.StartTime, &w.EndTime, &w.Status, &w.IsActive, &w.CreatedAt, &w.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, availability.ErrWindowNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan availability window: %w", err)
+	}
+	return &w, nil
+}
+
+func (r *AvailabilityRepository) scanAutoRule(row pgx.Row) (*availability.AutoRule, error) {
+	var rule availability.AutoRule
+	err := row.Scan(&rule.ID, &rule.UserID, &rule.Name, &rule.Condition, &rule.TargetStatus, &rule.Priority, &rule.IsActive, &rule.CreatedAt, &rule.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, availability.ErrAutoRuleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan auto rule: %w", err)
+	}
+	return &rule, nil
+}
+
+func (r *AvailabilityRepository) scanCalendar(row pgx.Row) (*availability.Calendar, error) {
+	var c availability.Calendar
+	err := row.Scan(&c.ID, &c.UserID, &c.Name, &c.URL, &c.Events, &c.IsActive, &c.LastSyncedAt, &c.LastError, &c.CreatedAt, &c.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, availability.ErrCalendarNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan calendar: %w", err)
+	}
+	return &c, nil
+}
+
+var _ availability.Repository = (*AvailabilityRepository)(nil)
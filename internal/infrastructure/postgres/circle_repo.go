@@ -24,7 +24,7 @@ func (r *CircleRepository) Create(ctx context.Context, c *circle.Circle) error {
 		INSERT INTO circles (id, name, description, avatar, created_by, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
-	_, err := r.db.Write().Exec(ctx, query, c.ID, c.Name, c.Description, c.Avatar, c.CreatedBy, c.CreatedAt, c.UpdatedAt)
+	_, err := r.db.writer(ctx).Exec(ctx, query, c.ID, c.Name, c.Description, c.Avatar, c.CreatedBy, c.CreatedAt, c.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create circle: %w", err)
 	}
@@ -37,7 +37,7 @@ func (r *CircleRepository) GetByID(ctx context.Context, id uuid.UUID) (*circle.C
 		FROM circles
 		WHERE id = $1
 	`
-	row := r.db.Read().QueryRow(ctx, query, id)
+	row := r.db.reader(ctx).QueryRow(ctx, query, id)
 
 	var c circle.Circle
 	err := row.Scan(&c.ID, &c.Name, &c.Description, &c.Avatar, &c.CreatedBy, &c.CreatedAt, &c.UpdatedAt)
@@ -56,7 +56,7 @@ func (r *CircleRepository) Update(ctx context.Context, c *circle.Circle) error {
 		SET name = $1, description = $2, avatar = $3, updated_at = $4
 		WHERE id = $5
 	`
-	_, err := r.db.Write().Exec(ctx, query, c.Name, c.Description, c.Avatar, c.UpdatedAt, c.ID)
+	_, err := r.db.writer(ctx).Exec(ctx, query, c.Name, c.Description, c.Avatar, c.UpdatedAt, c.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update circle: %w", err)
 	}
@@ -65,7 +65,7 @@ func (r *CircleRepository) Update(ctx context.Context, c *circle.Circle) error {
 
 func (r *CircleRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM circles WHERE id = $1`
-	_, err := r.db.Write().Exec(ctx, query, id)
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete circle: %w", err)
 	}
@@ -81,7 +81,7 @@ func (r *CircleRepository) ListByUser(ctx context.Context, userID uuid.UUID, lim
 		ORDER BY c.updated_at DESC
 		LIMIT $2 OFFSET $3
 	`
-	rows, err := r.db.Read().Query(ctx, query, userID, limit, offset)
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list circles: %w", err)
 	}
@@ -106,7 +106,7 @@ func (r *CircleRepository) CountByUser(ctx context.Context, userID uuid.UUID) (i
 		WHERE cm.user_id = $1
 	`
 	var count int64
-	err := r.db.Read().QueryRow(ctx, query, userID).Scan(&count)
+	err := r.db.reader(ctx).QueryRow(ctx, query, userID).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count circles: %w", err)
 	}
@@ -118,10 +118,16 @@ func (r *CircleRepository) AddMember(ctx context.Context, m *circle.Member) erro
 		INSERT INTO circle_members (id, circle_id, user_id, role, nickname, joined_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
-	_, err := r.db.Write().Exec(ctx, query, m.ID, m.CircleID, m.UserID, m.Role, m.Nickname, m.JoinedAt, m.UpdatedAt)
+	err := pgx.BeginFunc(ctx, r.db.writer(ctx), func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, query, m.ID, m.CircleID, m.UserID, m.Role, m.Nickname, m.JoinedAt, m.UpdatedAt); err != nil {
+			return fmt.Errorf("failed to add circle member: %w", err)
+		}
+		return insertEvents(ctx, tx, m.Events())
+	})
 	if err != nil {
-		return fmt.Errorf("failed to add circle member: %w", err)
+		return err
 	}
+	r.db.afterCommit(ctx, m.ClearEvents)
 	return nil
 }
 
@@ -131,7 +137,7 @@ func (r *CircleRepository) GetMember(ctx context.Context, circleID, userID uuid.
 		FROM circle_members
 		WHERE circle_id = $1 AND user_id = $2
 	`
-	row := r.db.Read().QueryRow(ctx, query, circleID, userID)
+	row := r.db.reader(ctx).QueryRow(ctx, query, circleID, userID)
 
 	var m circle.Member
 	err := row.Scan(&m.ID, &m.CircleID, &m.UserID, &m.Role, &m.Nickname, &m.JoinedAt, &m.UpdatedAt)
@@ -150,7 +156,7 @@ func (r *CircleRepository) GetMemberByID(ctx context.Context, id uuid.UUID) (*ci
 		FROM circle_members
 		WHERE id = $1
 	`
-	row := r.db.Read().QueryRow(ctx, query, id)
+	row := r.db.reader(ctx).QueryRow(ctx, query, id)
 
 	var m circle.Member
 	err := row.Scan(&m.ID, &m.CircleID, &m.UserID, &m.Role, &m.Nickname, &m.JoinedAt, &m.UpdatedAt)
@@ -169,7 +175,7 @@ func (r *CircleRepository) UpdateMember(ctx context.Context, m *circle.Member) e
 		SET role = $1, nickname = $2, updated_at = $3
 		WHERE id = $4
 	`
-	_, err := r.db.Write().Exec(ctx, query, m.Role, m.Nickname, m.UpdatedAt, m.ID)
+	_, err := r.db.writer(ctx).Exec(ctx, query, m.Role, m.Nickname, m.UpdatedAt, m.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update circle member: %w", err)
 	}
@@ -178,7 +184,7 @@ func (r *CircleRepository) UpdateMember(ctx context.Context, m *circle.Member) e
 
 func (r *CircleRepository) RemoveMember(ctx context.Context, circleID, userID uuid.UUID) error {
 	query := `DELETE FROM circle_members WHERE circle_id = $1 AND user_id = $2`
-	_, err := r.db.Write().Exec(ctx, query, circleID, userID)
+	_, err := r.db.writer(ctx).Exec(ctx, query, circleID, userID)
 	if err != nil {
 		return fmt.Errorf("failed to remove circle member: %w", err)
 	}
@@ -192,7 +198,7 @@ func (r *CircleRepository) ListMembers(ctx context.Context, circleID uuid.UUID)
 		WHERE circle_id = $1
 		ORDER BY joined_at
 	`
-	rows, err := r.db.Read().Query(ctx, query, circleID)
+	rows, err := r.db.reader(ctx).Query(ctx, query, circleID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list circle members: %w", err)
 	}
@@ -212,7 +218,7 @@ func (r *CircleRepository) ListMembers(ctx context.Context, circleID uuid.UUID)
 func (r *CircleRepository) CountMembers(ctx context.Context, circleID uuid.UUID) (int64, error) {
 	query := `SELECT COUNT(*) FROM circle_members WHERE circle_id = $1`
 	var count int64
-	err := r.db.Read().QueryRow(ctx, query, circleID).Scan(&count)
+	err := r.db.reader(ctx).QueryRow(ctx, query, circleID).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to count circle members: %w", err)
 	}
@@ -222,7 +228,7 @@ func (r *CircleRepository) CountMembers(ctx context.Context, circleID uuid.UUID)
 func (r *CircleRepository) IsMember(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
 	query := `SELECT 1 FROM circle_members WHERE circle_id = $1 AND user_id = $2`
 	var exists int
-	err := r.db.Read().QueryRow(ctx, query, circleID, userID).Scan(&exists)
+	err := r.db.reader(ctx).QueryRow(ctx, query, circleID, userID).Scan(&exists)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return false, nil
 	}
@@ -235,7 +241,7 @@ func (r *CircleRepository) IsMember(ctx context.Context, circleID, userID uuid.U
 func (r *CircleRepository) IsAdmin(ctx context.Context, circleID, userID uuid.UUID) (bool, error) {
 	query := `SELECT 1 FROM circle_members WHERE circle_id = $1 AND user_id = $2 AND role = $3`
 	var exists int
-	err := r.db.Read().QueryRow(ctx, query, circleID, userID, circle.MemberRoleAdmin).Scan(&exists)
+	err := r.db.reader(ctx).QueryRow(ctx, query, circleID, userID, circle.MemberRoleAdmin).Scan(&exists)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return false, nil
 	}
@@ -252,7 +258,7 @@ func (r *CircleRepository) CreateSharingPreference(ctx context.Context, pref *ci
 			share_location, location_precision, share_activity, created_at, updated_at
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
-	_, err := r.db.Write().Exec(ctx, query,
+	_, err := r.db.writer(ctx).Exec(ctx, query,
 		pref.ID, pref.CircleID, pref.UserID, pref.PrivacyLevel, pref.ShareTimezone, pref.ShareAvailability,
 		pref.ShareLocation, pref.LocationPrecision, pref.ShareActivity, pref.CreatedAt, pref.UpdatedAt)
 	if err != nil {
@@ -268,7 +274,7 @@ func (r *CircleRepository) GetSharingPreference(ctx context.Context, circleID, u
 		FROM circle_sharing_preferences
 		WHERE circle_id = $1 AND user_id = $2
 	`
-	row := r.db.Read().QueryRow(ctx, query, circleID, userID)
+	row := r.db.reader(ctx).QueryRow(ctx, query, circleID, userID)
 
 	var pref circle.SharingPreference
 	err := row.Scan(
@@ -291,7 +297,7 @@ func (r *CircleRepository) UpdateSharingPreference(ctx context.Context, pref *ci
 			share_location = $4, location_precision = $5, share_activity = $6, updated_at = $7
 		WHERE id = $8
 	`
-	_, err := r.db.Write().Exec(ctx, query,
+	_, err := r.db.writer(ctx).Exec(ctx, query,
 		pref.PrivacyLevel, pref.ShareTimezone, pref.ShareAvailability,
 		pref.ShareLocation, pref.LocationPrecision, pref.ShareActivity, pref.UpdatedAt, pref.ID)
 	if err != nil {
@@ -307,7 +313,7 @@ func (r *CircleRepository) ListSharingPreferences(ctx context.Context, userID uu
 		FROM circle_sharing_preferences
 		WHERE user_id = $1
 	`
-	rows, err := r.db.Read().Query(ctx, query, userID)
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list sharing preferences: %w", err)
 	}
@@ -334,7 +340,7 @@ func (r *CircleRepository) CreateInvitation(ctx context.Context, inv *circle.Inv
 			max_uses, use_count, expires_at, created_at, updated_at
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
-	_, err := r.db.Write().Exec(ctx, query,
+	_, err := r.db.writer(ctx).Exec(ctx, query,
 		inv.ID, inv.CircleID, inv.InviterID, inv.InviteeID, inv.Type, inv.Code, inv.Status,
 		inv.MaxUses, inv.UseCount, inv.ExpiresAt, inv.CreatedAt, inv.UpdatedAt)
 	if err != nil {
@@ -350,7 +356,7 @@ func (r *CircleRepository) GetInvitationByID(ctx context.Context, id uuid.UUID)
 		FROM circle_invitations
 		WHERE id = $1
 	`
-	row := r.db.Read().QueryRow(ctx, query, id)
+	row := r.db.reader(ctx).QueryRow(ctx, query, id)
 	return r.scanInvitation(row)
 }
 
@@ -361,7 +367,7 @@ func (r *CircleRepository) GetInvitationByCode(ctx context.Context, code string)
 		FROM circle_invitations
 		WHERE code = $1
 	`
-	row := r.db.Read().QueryRow(ctx, query, code)
+	row := r.db.reader(ctx).QueryRow(ctx, query, code)
 	return r.scanInvitation(row)
 }
 
@@ -371,10 +377,16 @@ func (r *CircleRepository) UpdateInvitation(ctx context.Context, inv *circle.Inv
 		SET status = $1, use_count = $2, updated_at = $3
 		WHERE id = $4
 	`
-	_, err := r.db.Write().Exec(ctx, query, inv.Status, inv.UseCount, inv.UpdatedAt, inv.ID)
+	err := pgx.BeginFunc(ctx, r.db.writer(ctx), func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, query, inv.Status, inv.UseCount, inv.UpdatedAt, inv.ID); err != nil {
+			return fmt.Errorf("failed to update invitation: %w", err)
+		}
+		return insertEvents(ctx, tx, inv.Events())
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update invitation: %w", err)
+		return err
 	}
+	r.db.afterCommit(ctx, inv.ClearEvents)
 	return nil
 }
 
@@ -386,7 +398,7 @@ func (r *CircleRepository) ListPendingInvitations(ctx context.Context, circleID
 		WHERE circle_id = $1 AND status = $2
 		ORDER BY created_at DESC
 	`
-	rows, err := r.db.Read().Query(ctx, query, circleID, circle.InvitationStatusPending)
+	rows, err := r.db.reader(ctx).Query(ctx, query, circleID, circle.InvitationStatusPending)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list invitations: %w", err)
 	}
@@ -402,7 +414,7 @@ func (r *CircleRepository) ListUserInvitations(ctx context.Context, userID uuid.
 		WHERE invitee_id = $1 AND status = $2
 		ORDER BY created_at DESC
 	`
-	rows, err := r.db.Read().Query(ctx, query, userID, circle.InvitationStatusPending)
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, circle.InvitationStatusPending)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list user invitations: %w", err)
 	}
@@ -412,7 +424,7 @@ func (r *CircleRepository) ListUserInvitations(ctx context.Context, userID uuid.
 
 func (r *CircleRepository) DeleteExpiredInvitations(ctx context.Context) error {
 	query := `DELETE FROM circle_invitations WHERE expires_at < $1 AND status = $2`
-	_, err := r.db.Write().Exec(ctx, query, time.Now(), circle.InvitationStatusPending)
+	_, err := r.db.writer(ctx).Exec(ctx, query, time.Now(), circle.InvitationStatusPending)
 	if err != nil {
 		return fmt.Errorf("failed to delete expired invitations: %w", err)
 	}
@@ -0,0 +1,297 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const contactColumns = `
+	id, user_id, contact_id, nickname, is_favorite, is_blocked, created_at, updated_at
+`
+
+const contactRequestColumns = `
+	id, from_user_id, to_user_id, message, status, created_at, updated_at
+`
+
+type ContactRepository struct {
+	db *DB
+}
+
+func NewContactRepository(db *DB) *ContactRepository {
+	return &ContactRepository{db: db}
+}
+
+func (r *ContactRepository) Create(ctx context.Context, c *contact.Contact) error {
+	query := `
+		INSERT INTO contacts (id, user_id, contact_id, nickname, is_favorite, is_blocked, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		c.ID, c.UserID, c.ContactID, c.Nickname, c.IsFavorite, c.IsBlocked, c.CreatedAt, c.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create contact: %w", err)
+	}
+	return nil
+}
+
+func (r *ContactRepository) GetByID(ctx context.Context, id uuid.UUID) (*contact.Contact, error) {
+	query := `SELECT ` + contactColumns + ` FROM contacts WHERE id = $1`
+	return r.scanContact(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *ContactRepository) GetByUserAndContact(ctx context.Context, userID, contactID uuid.UUID) (*contact.Contact, error) {
+	query := `SELECT ` + contactColumns + ` FROM contacts WHERE user_id = $1 AND contact_id = $2`
+	return r.scanContact(r.db.reader(ctx).QueryRow(ctx, query, userID, contactID))
+}
+
+// ListByUser returns the user's contacts. Blocked users are listed separately
+// by ListBlocked.
+func (r *ContactRepository) ListByUser(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*contact.Contact, error) {
+	query := `
+		SELECT ` + contactColumns + `
+		FROM contacts
+		WHERE user_id = $1 AND is_blocked = false
+		ORDER BY is_favorite DESC, created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contacts: %w", err)
+	}
+	defer rows.Close()
+	return r.scanContacts(rows)
+}
+
+func (r *ContactRepository) ListFavorites(ctx context.Context, userID uuid.UUID) ([]*contact.Contact, error) {
+	query := `
+		SELECT ` + contactColumns + `
+		FROM contacts
+		WHERE user_id = $1 AND is_favorite = true AND is_blocked = false
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list favorite contacts: %w", err)
+	}
+	defer rows.Close()
+	return r.scanContacts(rows)
+}
+
+func (r *ContactRepository) ListBlocked(ctx context.Context, userID uuid.UUID) ([]*contact.Contact, error) {
+	query := `
+		SELECT ` + contactColumns + `
+		FROM contacts
+		WHERE user_id = $1 AND is_blocked = true
+		ORDER BY updated_at DESC
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blocked contacts: %w", err)
+	}
+	defer rows.Close()
+	return r.scanContacts(rows)
+}
+
+func (r *ContactRepository) Update(ctx context.Context, c *contact.Contact) error {
+	query := `
+		UPDATE contacts
+		SET nickname = $1, is_favorite = $2, is_blocked = $3, updated_at = $4
+		WHERE id = $5
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query, c.Nickname, c.IsFavorite, c.IsBlocked, c.UpdatedAt, c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update contact: %w", err)
+	}
+	return nil
+}
+
+func (r *ContactRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM contacts WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete contact: %w", err)
+	}
+	return nil
+}
+
+func (r *ContactRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM contacts WHERE user_id = $1 AND is_blocked = false`
+	var count int64
+	err := r.db.reader(ctx).QueryRow(ctx, query, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count contacts: %w", err)
+	}
+	return count, nil
+}
+
+// IsBlocked reports whether userID has blocked targetID.
+func (r *ContactRepository) IsBlocked(ctx context.Context, userID, targetID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM contacts WHERE user_id = $1 AND contact_id = $2 AND is_blocked = true)`
+	var blocked bool
+	err := r.db.reader(ctx).QueryRow(ctx, query, userID, targetID).Scan(&blocked)
+	if err != nil {
+		return false, fmt.Errorf("failed to check block: %w", err)
+	}
+	return blocked, nil
+}
+
+// ListBlockRelations returns everyone the user has blocked or been blocked by.
+func (r *ContactRepository) ListBlockRelations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT contact_id FROM contacts WHERE user_id = $1 AND is_blocked = true
+		UNION
+		SELECT user_id FROM contacts WHERE contact_id = $1 AND is_blocked = true
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block relations: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan block relation: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// CreateRequest relies on the unique index over pending pairs, in either
+// direction, so that concurrent requests between two users cannot both be
+// created.
+func (r *ContactRepository) CreateRequest(ctx context.Context, cr *contact.ContactRequest) error {
+	query := `
+		INSERT INTO contact_requests (id, from_user_id, to_user_id, message, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT DO NOTHING
+	`
+	err := pgx.BeginFunc(ctx, r.db.writer(ctx), func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, query,
+			cr.ID, cr.FromUserID, cr.ToUserID, cr.Message, cr.Status, cr.CreatedAt, cr.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create contact request: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return contact.ErrContactRequestAlreadyExists
+		}
+		return insertEvents(ctx, tx, cr.Events())
+	})
+	if err != nil {
+		return err
+	}
+	r.db.afterCommit(ctx, cr.ClearEvents)
+	return nil
+}
+
+func (r *ContactRepository) GetRequestByID(ctx context.Context, id uuid.UUID) (*contact.ContactRequest, error) {
+	query := `SELECT ` + contactRequestColumns + ` FROM contact_requests WHERE id = $1`
+	return r.scanRequest(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *ContactRepository) GetPendingRequest(ctx context.Context, fromUserID, toUserID uuid.UUID) (*contact.ContactRequest, error) {
+	query := `
+		SELECT ` + contactRequestColumns + `
+		FROM contact_requests
+		WHERE from_user_id = $1 AND to_user_id = $2 AND status = $3
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+	return r.scanRequest(r.db.reader(ctx).QueryRow(ctx, query, fromUserID, toUserID, contact.ContactRequestStatusPending))
+}
+
+func (r *ContactRepository) ListPendingRequestsForUser(ctx context.Context, userID uuid.UUID) ([]*contact.ContactRequest, error) {
+	query := `
+		SELECT ` + contactRequestColumns + `
+		FROM contact_requests
+		WHERE to_user_id = $1 AND status = $2
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, contact.ContactRequestStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contact requests: %w", err)
+	}
+	defer rows.Close()
+	return r.scanRequests(rows)
+}
+
+func (r *ContactRepository) ListSentRequests(ctx context.Context, userID uuid.UUID) ([]*contact.ContactRequest, error) {
+	query := `
+		SELECT ` + contactRequestColumns + `
+		FROM contact_requests
+		WHERE from_user_id = $1 AND status = $2
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, contact.ContactRequestStatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sent contact requests: %w", err)
+	}
+	defer rows.Close()
+	return r.scanRequests(rows)
+}
+
+func (r *ContactRepository) UpdateRequest(ctx context.Context, cr *contact.ContactRequest) error {
+	query := `UPDATE contact_requests SET status = $1, updated_at = $2 WHERE id = $3`
+	_, err := r.db.writer(ctx).Exec(ctx, query, cr.Status, cr.UpdatedAt, cr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update contact request: %w", err)
+	}
+	return nil
+}
+
+func (r *ContactRepository) scanContact(row pgx.Row) (*contact.Contact, error) {
+	var c contact.Contact
+	err := row.Scan(&c.ID, &c.UserID, &c.ContactID, &c.Nickname, &c.IsFavorite, &c.IsBlocked, &c.CreatedAt, &c.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, contact.ErrContactNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan contact: %w", err)
+	}
+	return &c, nil
+}
+
+func (r *ContactRepository) scanContacts(rows pgx.Rows) ([]*contact.Contact, error) {
+	var contacts []*contact.Contact
+	for rows.Next() {
+		var c contact.Contact
+		if err := rows.Scan(&c.ID, &c.UserID, &c.ContactID, &c.Nickname, &c.IsFavorite, &c.IsBlocked, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan contact: %w", err)
+		}
+		contacts = append(contacts, &c)
+	}
+	return contacts, rows.Err()
+}
+
+func (r *ContactRepository) scanRequest(row pgx.Row) (*contact.ContactRequest, error) {
+	var cr contact.ContactRequest
+	err := row.Scan(&cr.ID, &cr.FromUserID, &cr.ToUserID, &cr.Message, &cr.Status, &cr.CreatedAt, &cr.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, contact.ErrContactRequestNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan contact request: %w", err)
+	}
+	return &cr, nil
+}
+
+func (r *ContactRepository) scanRequests(rows pgx.Rows) ([]*contact.ContactRequest, error) {
+	var requests []*contact.ContactRequest
+	for rows.Next() {
+		var cr contact.ContactRequest
+		if err := rows.Scan(&cr.ID, &cr.FromUserID, &cr.ToUserID, &cr.Message, &cr.Status, &cr.CreatedAt, &cr.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan contact request: %w", err)
+		}
+		requests = append(requests, &cr)
+	}
+	return requests, rows.Err()
+}
+
+var _ contact.Repository = (*ContactRepository)(nil)
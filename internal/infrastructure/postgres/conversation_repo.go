@@ -0,0 +1,361 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const conversationColumns = `
+	c.id, c.type, c.circle_id, c.name, c.avatar, c.last_message_id, c.last_message_at, c.created_at, c.updated_at
+`
+
+const participantColumns = `
+	id, conversation_id, user_id, is_muted, is_archived, last_read_at, joined_at, left_at, updated_at
+`
+
+type ConversationRepository struct {
+	db *DB
+}
+
+func NewConversationRepository(db *DB) *ConversationRepository {
+	return &ConversationRepository{db: db}
+}
+
+func (r *ConversationRepository) Create(ctx context.Context, c *conversation.Conversation) error {
+	query := `
+		INSERT INTO conversations (id, type, circle_id, name, avatar, last_message_id, last_message_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		c.ID, c.Type, c.CircleID, c.Name, c.Avatar, c.LastMessageID, c.LastMessageAt, c.CreatedAt, c.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create conversation: %w", err)
+	}
+	return nil
+}
+
+func (r *ConversationRepository) GetByID(ctx context.Context, id uuid.UUID) (*conversation.Conversation, error) {
+	query := `SELECT ` + conversationColumns + ` FROM conversations c WHERE c.id = $1`
+	return r.scanConversation(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *ConversationRepository) GetDirectConversation(ctx context.Context, userID1, userID2 uuid.UUID) (*conversation.Conversation, error) {
+	query := `SELECT ` + conversationColumns + ` FROM conversations c WHERE c.direct_key = $1`
+	return r.scanConversation(r.db.reader(ctx).QueryRow(ctx, query, conversation.DirectKey(userID1, userID2)))
+}
+
+// GetOrCreateDirectConversation relies on the unique direct_key so that
+// concurrent callers for the same pair of users converge on a single row.
+func (r *ConversationRepository) GetOrCreateDirectConversation(ctx context.Context, userID1, userID2 uuid.UUID) (*conversation.Conversation, error) {
+	directKey := conversation.DirectKey(userID1, userID2)
+	candidate := conversation.NewDirectConversation()
+
+	var conv *conversation.Conversation
+	err := pgx.BeginFunc(ctx, r.db.writer(ctx), func(tx pgx.Tx) error {
+		insertQuery := `
+			INSERT INTO conversations (id, type, direct_key, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (direct_key) DO NOTHING
+		`
+		tag, err := tx.Exec(ctx, insertQuery,
+			candidate.ID, candidate.Type, directKey, candidate.CreatedAt, candidate.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create direct conversation: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			selectQuery := `SELECT ` + conversationColumns + ` FROM conversations c WHERE c.direct_key = $1`
+			conv, err = r.scanConversation(tx.QueryRow(ctx, selectQuery, directKey))
+			if err != nil {
+				return err
+			}
+		} else {
+			conv = candidate
+		}
+
+		participantQuery := `
+			INSERT INTO conversation_participants (id, conversation_id, user_id, joined_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (conversation_id, user_id) DO NOTHING
+		`
+		for _, userID := range []uuid.UUID{userID1, userID2} {
+			p := conversation.NewParticipant(conv.ID, userID)
+			if _, err := tx.Exec(ctx, participantQuery, p.ID, p.ConversationID, p.UserID, p.JoinedAt, p.UpdatedAt); err != nil {
+				return fmt.Errorf("failed to add conversation participant: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conv, nil
+}
+
+func (r *ConversationRepository) GetByCircleID(ctx context.Context, circleID uuid.UUID) (*conversation.Conversation, error) {
+	query := `SELECT ` + conversationColumns + ` FROM conversations c WHERE c.circle_id = $1`
+	return r.scanConversation(r.db.reader(ctx).QueryRow(ctx, query, circleID))
+}
+
+// GetOrCreateCircleConversation inserts c unless the circle already has a
+// conversation, and returns whichever row is stored.
+func (r *ConversationRepository) GetOrCreateCircleConversation(ctx context.Context, c *conversation.Conversation) (*conversation.Conversation, error) {
+	query := `
+		INSERT INTO conversations (id, type, circle_id, name, avatar, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (circle_id) DO NOTHING
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query, c.ID, c.Type, c.CircleID, c.Name, c.Avatar, c.CreatedAt, c.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create circle conversation: %w", err)
+	}
+
+	selectQuery := `SELECT ` + conversationColumns + ` FROM conversations c WHERE c.circle_id = $1`
+	return r.scanConversation(r.db.writer(ctx).QueryRow(ctx, selectQuery, c.CircleID))
+}
+
+func (r *ConversationRepository) Update(ctx context.Context, c *conversation.Conversation) error {
+	query := `
+		UPDATE conversations
+		SET name = $1, avatar = $2, last_message_id = $3, last_message_at = $4, updated_at = $5
+		WHERE id = $6
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query, c.Name, c.Avatar, c.LastMessageID, c.LastMessageAt, c.UpdatedAt, c.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update conversation: %w", err)
+	}
+	return nil
+}
+
+func (r *ConversationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM conversations WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete conversation: %w", err)
+	}
+	return nil
+}
+
+func (r *ConversationRepository) ListByUser(ctx context.Context, userID uuid.UUID, includeArchived bool, limit, offset int) ([]*conversation.Conversation, error) {
+	query := `
+		SELECT ` + conversationColumns + `
+		FROM conversations c
+		INNER JOIN conversation_participants cp ON c.id = cp.conversation_id
+		WHERE cp.user_id = $1
+		  AND cp.left_at IS NULL
+		  AND ($2 OR cp.is_archived = false)
+		ORDER BY c.last_message_at DESC NULLS LAST, c.created_at DESC
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, includeArchived, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", err)
+	}
+	defer rows.Close()
+
+	var conversations []*conversation.Conversation
+	for rows.Next() {
+		var c conversation.Conversation
+		if err := rows.Scan(
+			&c.ID, &c.Type, &c.CircleID, &c.Name, &c.Avatar, &c.LastMessageID, &c.LastMessageAt, &c.CreatedAt, &c.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan conversation: %w", err)
+		}
+		conversations = append(conversations, &c)
+	}
+	return conversations, rows.Err()
+}
+
+func (r *ConversationRepository) CountByUser(ctx context.Context, userID uuid.UUID, includeArchived bool) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM conversation_participants
+		WHERE user_id = $1 AND left_at IS NULL AND ($2 OR is_archived = false)
+	`
+	var count int64
+	err := r.db.reader(ctx).QueryRow(ctx, query, userID, includeArchived).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count conversations: %w", err)
+	}
+	return count, nil
+}
+
+// AddParticipant inserts a new participant, or reactivates one who previously
+// left the conversation.
+func (r *ConversationRepository) AddParticipant(ctx context.Context, p *conversation.Participant) error {
+	query := `
+		INSERT INTO conversation_participants (id, conversation_id, user_id, is_muted, is_archived, last_read_at, joined_at, left_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (conversation_id, user_id) DO UPDATE
+		SET is_muted = EXCLUDED.is_muted, is_archived = EXCLUDED.is_archived, last_read_at = EXCLUDED.last_read_at,
+			joined_at = EXCLUDED.joined_at, left_at = NULL, updated_at = EXCLUDED.updated_at
+		WHERE conversation_participants.left_at IS NOT NULL
+		RETURNING id
+	`
+	err := r.db.writer(ctx).QueryRow(ctx, query,
+		p.ID, p.ConversationID, p.UserID, p.IsMuted, p.IsArchived, p.LastReadAt, p.JoinedAt, p.LeftAt, p.UpdatedAt,
+	).Scan(&p.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return conversation.ErrAlreadyParticipant
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add conversation participant: %w", err)
+	}
+	return nil
+}
+
+func (r *ConversationRepository) GetParticipant(ctx context.Context, conversationID, userID uuid.UUID) (*conversation.Participant, error) {
+	query := `SELECT ` + participantColumns + ` FROM conversation_participants WHERE conversation_id = $1 AND user_id = $2`
+	row := r.db.reader(ctx).QueryRow(ctx, query, conversationID, userID)
+
+	var p conversation.Participant
+	err := row.Scan(
+		&p.ID, &p.ConversationID, &p.UserID, &p.IsMuted, &p.IsArchived, &p.LastReadAt, &p.JoinedAt, &p.LeftAt, &p.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, conversation.ErrParticipantNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation participant: %w", err)
+	}
+	return &p, nil
+}
+
+func (r *ConversationRepository) ListParticipantsByUser(ctx context.Context, userID uuid.UUID, conversationIDs []uuid.UUID) ([]*conversation.Participant, error) {
+	query := `
+		SELECT ` + participantColumns + `
+		FROM conversation_participants
+		WHERE user_id = $1 AND conversation_id = ANY($2)
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, conversationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversation participants: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanParticipants(rows)
+}
+
+func (r *ConversationRepository) UpdateParticipant(ctx context.Context, p *conversation.Participant) error {
+	query := `
+		UPDATE conversation_participants
+		SET is_muted = $1, is_archived = $2, last_read_at = $3, left_at = $4, updated_at = $5
+		WHERE id = $6
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query, p.IsMuted, p.IsArchived, p.LastReadAt, p.LeftAt, p.UpdatedAt, p.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update conversation participant: %w", err)
+	}
+	return nil
+}
+
+func (r *ConversationRepository) RemoveParticipant(ctx context.Context, conversationID, userID uuid.UUID) error {
+	query := `DELETE FROM conversation_participants WHERE conversation_id = $1 AND user_id = $2`
+	_, err := r.db.writer(ctx).Exec(ctx, query, conversationID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove conversation participant: %w", err)
+	}
+	return nil
+}
+
+func (r *ConversationRepository) ListParticipants(ctx context.Context, conversationID uuid.UUID) ([]*conversation.Participant, error) {
+	query := `
+		SELECT ` + participantColumns + `
+		FROM conversation_participants
+		WHERE conversation_id = $1
+		ORDER BY joined_at
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversation participants: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanParticipants(rows)
+}
+
+func (r *ConversationRepository) ListActiveParticipants(ctx context.Context, conversationID uuid.UUID) ([]*conversation.Participant, error) {
+	query := `
+		SELECT ` + participantColumns + `
+		FROM conversation_participants
+		WHERE conversation_id = $1 AND left_at IS NULL
+		ORDER BY joined_at
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active conversation participants: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanParticipants(rows)
+}
+
+func (r *ConversationRepository) IsParticipant(ctx context.Context, conversationID, userID uuid.UUID) (bool, error) {
+	query := `SELECT 1 FROM conversation_participants WHERE conversation_id = $1 AND user_id = $2 AND left_at IS NULL`
+	var exists int
+	err := r.db.reader(ctx).QueryRow(ctx, query, conversationID, userID).Scan(&exists)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check conversation participant: %w", err)
+	}
+	return true, nil
+}
+
+func (r *ConversationRepository) CountUnreadConversations(ctx context.Context, userID uuid.UUID) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM conversation_participants cp
+		WHERE cp.user_id = $1
+		  AND cp.left_at IS NULL
+		  AND EXISTS (
+			SELECT 1 FROM messages m
+			WHERE m.conversation_id = cp.conversation_id
+			  AND m.sender_id <> $1
+			  AND m.deleted_for_all = false
+			  AND m.created_at > COALESCE(cp.last_read_at, cp.joined_at)
+		  )
+	`
+	var count int64
+	err := r.db.reader(ctx).QueryRow(ctx, query, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread conversations: %w", err)
+	}
+	return count, nil
+}
+
+func (r *ConversationRepository) scanConversation(row pgx.Row) (*conversation.Conversation, error) {
+	var c conversation.Conversation
+	err := row.Scan(
+		&c.ID, &c.Type, &c.CircleID, &c.Name, &c.Avatar, &c.LastMessageID, &c.LastMessageAt, &c.CreatedAt, &c.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, conversation.ErrConversationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan conversation: %w", err)
+	}
+	return &c, nil
+}
+
+func (r *ConversationRepository) scanParticipants(rows pgx.Rows) ([]*conversation.Participant, error) {
+	var participants []*conversation.Participant
+	for rows.Next() {
+		var p conversation.Participant
+		if err := rows.Scan(
+			&p.ID, &p.ConversationID, &p.UserID, &p.IsMuted, &p.IsArchived, &p.LastReadAt, &p.JoinedAt, &p.LeftAt, &p.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan conversation participant: %w", err)
+		}
+		participants = append(participants, &p)
+	}
+	return participants, rows.Err()
+}
+
+var _ conversation.Repository = (*ConversationRepository)(nil)
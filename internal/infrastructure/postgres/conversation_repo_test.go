@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestConversationRepositoryGetOrCreateDirectConversationConcurrently(t *testing.T) {
+	db := testDB(t)
+	repo := NewConversationRepository(db)
+	ctx := context.Background()
+
+	// The callers race on separate connections, so the rows are committed
+	// and removed afterwards instead of rolled back.
+	alice, bob := createUser(t, ctx, db, "alice"), createUser(t, ctx, db, "bob")
+	t.Cleanup(func() {
+		users := []uuid.UUID{alice, bob}
+		_, err := db.writer(ctx).Exec(ctx, `
+			DELETE FROM conversations WHERE id IN (
+				SELECT conversation_id FROM conversation_participants WHERE user_id = ANY($1)
+			)
+		`, users)
+		if err == nil {
+			_, err = db.writer(ctx).Exec(ctx, `DELETE FROM users WHERE id = ANY($1)`, users)
+		}
+		if err != nil {
+			t.Errorf("cleanup: %v", err)
+		}
+	})
+
+	const callers = 8
+	var (
+		wg   sync.WaitGroup
+		ids  [callers]uuid.UUID
+		errs [callers]error
+	)
+	for i := range callers {
+		wg.Go(func() {
+			// Half the callers name the users the other way round.
+			a, b := alice, bob
+			if i%2 == 1 {
+				a, b = bob, alice
+			}
+			conv, err := repo.GetOrCreateDirectConversation(ctx, a, b)
+			if err == nil {
+				ids[i] = conv.ID
+			}
+			errs[i] = err
+		})
+	}
+	wg.Wait()
+
+	for i := range callers {
+		if errs[i] != nil {
+			t.Fatalf("caller %d: %v", i, errs[i])
+		}
+		if ids[i] != ids[0] {
+			t.Fatalf("caller %d got conversation %v, caller 0 got %v", i, ids[i], ids[0])
+		}
+	}
+
+	participants, err := repo.ListParticipants(ctx, ids[0])
+	if err != nil {
+		t.Fatalf("ListParticipants: %v", err)
+	}
+	if len(participants) != 2 {
+		t.Errorf("got %d participants, want 2", len(participants))
+	}
+
+	var conversations int
+	err = db.reader(ctx).QueryRow(ctx, `
+		SELECT COUNT(DISTINCT conversation_id) FROM conversation_participants WHERE user_id = ANY($1)
+	`, []uuid.UUID{alice, bob}).Scan(&conversations)
+	if err != nil {
+		t.Fatalf("count conversations: %v", err)
+	}
+	if conversations != 1 {
+		t.Errorf("users share %d conversations, want 1", conversations)
+	}
+}
@@ -0,0 +1,447 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const locationColumns = `
+	user_id, latitude, longitude, accuracy, altitude, speed, heading, place_id,
+	country, city, neighborhood, address, is_moving, updated_at
+`
+
+const locationHistoryColumns = `
+	id, user_id, latitude, longitude, accuracy, place_id, created_at
+`
+
+const placeColumns = `
+	id, user_id, name, type, latitude, longitude, radius, address, icon, created_at, updated_at
+`
+
+const checkInColumns = `
+	id, user_id, place_id, type, latitude, longitude, note, auto_check, created_at
+`
+
+// distanceSQL is the haversine distance in meters between the row's
+// coordinates and ($2, $3).
+const distanceSQL = `
+	2 * 6371000 * asin(sqrt(
+		power(sin(radians(latitude - $2) / 2), 2) +
+		cos(radians($2)) * cos(radians(latitude)) * power(sin(radians(longitude - $3) / 2), 2)
+	))
+`
+
+// boxSQL matches rows whose coordinates fall in the box ($4, $5, $6, $7) as
+// laid out by location.Box, so that the latitude index narrows the rows
+// before distanceSQL is computed. The casts are needed because $6 <= $7
+// alone would otherwise type both as text.
+const boxSQL = `
+	latitude BETWEEN $4::float8 AND $5::float8
+	AND (
+		($6::float8 <= $7::float8 AND longitude BETWEEN $6::float8 AND $7::float8)
+		OR ($6::float8 > $7::float8 AND (longitude >= $6::float8 OR longitude <= $7::float8))
+	)
+`
+
+type LocationRepository struct {
+	db *DB
+}
+
+func NewLocationRepository(db *DB) *LocationRepository {
+	return &LocationRepository{db: db}
+}
+
+// CreateOrUpdate keeps the stored geocoded info where l has none, so that a
+// move shows the last known city until the enricher catches up.
+func (r *LocationRepository) CreateOrUpdate(ctx context.Context, l *location.Location) error {
+	query := `
+		INSERT INTO user_locations (` + locationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (user_id) DO UPDATE SET
+			latitude = EXCLUDED.latitude,
+			longitude = EXCLUDED.longitude,
+			accuracy = EXCLUDED.accuracy,
+			altitude = EXCLUDED.altitude,
+			speed = EXCLUDED.speed,
+			heading = EXCLUDED.heading,
+			place_id = EXCLUDED.place_id,
+			country = COALESCE(EXCLUDED.country, user_locations.country),
+			city = COALESCE(EXCLUDED.city, user_locations.city),
+			neighborhood = COALESCE(EXCLUDED.neighborhood, user_locations.neighborhood),
+			address = COALESCE(EXCLUDED.address, user_locations.address),
+			is_moving = EXCLUDED.is_moving,
+			updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		l.UserID, l.Latitude, l.Longitude, l.Accuracy, l.Altitude, l.Speed, l.Heading, l.PlaceID,
+		l.Country, l.City, l.Neighborhood, l.Address, l.IsMoving, l.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert location: %w", err)
+	}
+	return nil
+}
+
+func (r *LocationRepository) SetGeocodedInfo(ctx context.Context, userID uuid.UUID, lat, lng float64, info *location.GeocodedInfo) error {
+	query := `
+		UPDATE user_locations
+		SET country = $1, city = $2, neighborhood = $3, address = $4
+		WHERE user_id = $5 AND latitude = $6 AND longitude = $7
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		info.Country, info.City, info.Neighborhood, info.Address, userID, lat, lng)
+	if err != nil {
+		return fmt.Errorf("failed to set geocoded info: %w", err)
+	}
+	return nil
+}
+
+func (r *LocationRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*location.Location, error) {
+	query := `SELECT ` + locationColumns + ` FROM user_locations WHERE user_id = $1`
+	return r.scanLocation(r.db.reader(ctx).QueryRow(ctx, query, userID))
+}
+
+func (r *LocationRepository) GetByUserIDs(ctx context.Context, userIDs []uuid.UUID) ([]*location.Location, error) {
+	query := `SELECT ` + locationColumns + ` FROM user_locations WHERE user_id = ANY($1)`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locations: %w", err)
+	}
+	defer rows.Close()
+
+	var locations []*location.Location
+	for rows.Next() {
+		l, err := r.scanLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, l)
+	}
+	return locations, rows.Err()
+}
+
+// ListNearby returns the locations of the given users within radius meters of
+// (lat, lng), nearest first.
+func (r *LocationRepository) ListNearby(ctx context.Context, userIDs []uuid.UUID, lat, lng, radius float64) ([]*location.Location, error) {
+	box := location.BoundingBox(lat, lng, radius)
+	query := `
+		SELECT ` + locationColumns + `
+		FROM (
+			SELECT *, ` + distanceSQL + ` AS distance
+			FROM user_locations
+			WHERE user_id = ANY($1) AND ` + boxSQL + `
+		) l
+		WHERE distance <= $8
+		ORDER BY distance ASC
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query,
+		userIDs, lat, lng, box.MinLat, box.MaxLat, box.MinLng, box.MaxLng, radius)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nearby locations: %w", err)
+	}
+	defer rows.Close()
+
+	var locations []*location.Location
+	for rows.Next() {
+		l, err := r.scanLocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, l)
+	}
+	return locations, rows.Err()
+}
+
+func (r *LocationRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM user_locations WHERE user_id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete location: %w", err)
+	}
+	return nil
+}
+
+func (r *LocationRepository) CreateHistory(ctx context.Context, h *location.LocationHistory) error {
+	query := `
+		INSERT INTO location_history (` + locationHistoryColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		h.ID, h.UserID, h.Latitude, h.Longitude, h.Accuracy, h.PlaceID, h.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create location history: %w", err)
+	}
+	return nil
+}
+
+// ListHistoryByUser returns the user's latest limit points in [from, to),
+// oldest first.
+func (r *LocationRepository) ListHistoryByUser(ctx context.Context, userID uuid.UUID, from, to time.Time, limit int) ([]*location.LocationHistory, error) {
+	query := `
+		SELECT ` + locationHistoryColumns + `
+		FROM (
+			SELECT ` + locationHistoryColumns + `
+			FROM location_history
+			WHERE user_id = $1 AND created_at >= $2 AND created_at < $3
+			ORDER BY created_at DESC
+			LIMIT $4
+		) h
+		ORDER BY created_at ASC
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list location history: %w", err)
+	}
+	defer rows.Close()
+
+	var history []*location.LocationHistory
+	for rows.Next() {
+		var h location.LocationHistory
+		if err := rows.Scan(&h.ID, &h.UserID, &h.Latitude, &h.Longitude, &h.Accuracy, &h.PlaceID, &h.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan location history: %w", err)
+		}
+		history = append(history, &h)
+	}
+	return history, rows.Err()
+}
+
+func (r *LocationRepository) DeleteHistoryOlderThan(ctx context.Context, userID uuid.UUID, before time.Time) error {
+	query := `DELETE FROM location_history WHERE user_id = $1 AND created_at < $2`
+	_, err := r.db.writer(ctx).Exec(ctx, query, userID, before)
+	if err != nil {
+		return fmt.Errorf("failed to delete location history: %w", err)
+	}
+	return nil
+}
+
+// DeleteHistoryBefore deletes up to limit points, of any user, recorded
+// before the given time.
+func (r *LocationRepository) DeleteHistoryBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM location_history
+		WHERE id IN (
+			SELECT id FROM location_history
+			WHERE created_at < $1
+			LIMIT $2
+		)
+	`
+	tag, err := r.db.writer(ctx).Exec(ctx, query, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired location history: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// DeleteHistoryByRetention deletes up to limit points recorded before the
+// given time by users who chose the retention.
+func (r *LocationRepository) DeleteHistoryByRetention(ctx context.Context, retention location.Retention, before time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM location_history
+		WHERE id IN (
+			SELECT h.id
+			FROM user_preferences p
+			JOIN location_history h ON h.user_id = p.user_id
+			WHERE p.location_retention = $1 AND h.created_at < $2
+			LIMIT $3
+		)
+	`
+	tag, err := r.db.writer(ctx).Exec(ctx, query, retention, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired location history: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *LocationRepository) CreatePlace(ctx context.Context, p *location.Place) error {
+	query := `
+		INSERT INTO places (` + placeColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		p.ID, p.UserID, p.Name, p.Type, p.Latitude, p.Longitude, p.Radius, p.Address, p.Icon, p.CreatedAt, p.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create place: %w", err)
+	}
+	return nil
+}
+
+func (r *LocationRepository) GetPlaceByID(ctx context.Context, id uuid.UUID) (*location.Place, error) {
+	query := `SELECT ` + placeColumns + ` FROM places WHERE id = $1`
+	return r.scanPlace(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *LocationRepository) UpdatePlace(ctx context.Context, p *location.Place) error {
+	query := `
+		UPDATE places
+		SET name = $1, type = $2, latitude = $3, longitude = $4, radius = $5, address = $6, icon = $7, updated_at = $8
+		WHERE id = $9
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		p.Name, p.Type, p.Latitude, p.Longitude, p.Radius, p.Address, p.Icon, p.UpdatedAt, p.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update place: %w", err)
+	}
+	return nil
+}
+
+func (r *LocationRepository) DeletePlace(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM places WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete place: %w", err)
+	}
+	return nil
+}
+
+func (r *LocationRepository) ListPlacesByUser(ctx context.Context, userID uuid.UUID) ([]*location.Place, error) {
+	query := `SELECT ` + placeColumns + ` FROM places WHERE user_id = $1 ORDER BY created_at ASC`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list places: %w", err)
+	}
+	defer rows.Close()
+
+	var places []*location.Place
+	for rows.Next() {
+		p, err := r.scanPlace(rows)
+		if err != nil {
+			return nil, err
+		}
+		places = append(places, p)
+	}
+	return places, rows.Err()
+}
+
+// FindPlaceByLocation returns the nearest of the user's places whose radius
+// contains the point. Only places within the largest allowed radius are
+// measured.
+func (r *LocationRepository) FindPlaceByLocation(ctx context.Context, userID uuid.UUID, lat, lng float64) (*location.Place, error) {
+	box := location.BoundingBox(lat, lng, location.MaxPlaceRadius)
+	query := `
+		SELECT ` + placeColumns + `
+		FROM (
+			SELECT *, ` + distanceSQL + ` AS distance
+			FROM places
+			WHERE user_id = $1 AND ` + boxSQL + `
+		) p
+		WHERE distance <= radius
+		ORDER BY distance ASC
+		LIMIT 1
+	`
+	return r.scanPlace(r.db.reader(ctx).QueryRow(ctx, query,
+		userID, lat, lng, box.MinLat, box.MaxLat, box.MinLng, box.MaxLng))
+}
+
+func (r *LocationRepository) CreateCheckIn(ctx context.Context, c *location.CheckIn) error {
+	query := `
+		INSERT INTO check_ins (` + checkInColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		c.ID, c.UserID, c.PlaceID, c.Type, c.Latitude, c.Longitude, c.Note, c.AutoCheck, c.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create check-in: %w", err)
+	}
+	return nil
+}
+
+func (r *LocationRepository) GetCheckInByID(ctx context.Context, id uuid.UUID) (*location.CheckIn, error) {
+	query := `SELECT ` + checkInColumns + ` FROM check_ins WHERE id = $1`
+	return r.scanCheckIn(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *LocationRepository) ListCheckInsByUser(ctx context.Context, userID uuid.UUID, limit int) ([]*location.CheckIn, error) {
+	query := `
+		SELECT ` + checkInColumns + `
+		FROM check_ins
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	return r.queryCheckIns(ctx, query, userID, limit)
+}
+
+func (r *LocationRepository) ListCheckInsByPlace(ctx context.Context, placeID uuid.UUID, limit int) ([]*location.CheckIn, error) {
+	query := `
+		SELECT ` + checkInColumns + `
+		FROM check_ins
+		WHERE place_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+	return r.queryCheckIns(ctx, query, placeID, limit)
+}
+
+func (r *LocationRepository) GetLatestCheckIn(ctx context.Context, userID uuid.UUID) (*location.CheckIn, error) {
+	query := `
+		SELECT ` + checkInColumns + `
+		FROM check_ins
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+	return r.scanCheckIn(r.db.reader(ctx).QueryRow(ctx, query, userID))
+}
+
+func (r *LocationRepository) queryCheckIns(ctx context.Context, query string, args ...any) ([]*location.CheckIn, error) {
+	rows, err := r.db.reader(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list check-ins: %w", err)
+	}
+	defer rows.Close()
+
+	var checkIns []*location.CheckIn
+	for rows.Next() {
+		c, err := r.scanCheckIn(rows)
+		if err != nil {
+			return nil, err
+		}
+		checkIns = append(checkIns, c)
+	}
+	return checkIns, rows.Err()
+}
+
+func (r *LocationRepository) scanLocation(row pgx.Row) (*location.Location, error) {
+	var l location.Location
+	err := row.Scan(&l.UserID, &l.Latitude, &l.Longitude, &l.Accuracy, &l.Altitude, &l.Speed, &l.Heading, &l.PlaceID,
+		&l.Country, &l.City, &l.Neighborhood, &l.Address, &l.IsMoving, &l.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, location.ErrLocationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan location: %w", err)
+	}
+	return &l, nil
+}
+
+func (r *LocationRepository) scanPlace(row pgx.Row) (*location.Place, error) {
+	var p location.Place
+	err := row.Scan(&p.ID, &p.UserID, &p.Name, &p.Type, &p.Latitude, &p.Longitude, &p.Radius,
+		&p.Address, &p.Icon, &p.CreatedAt, &p.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, location.ErrPlaceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan place: %w", err)
+	}
+	return &p, nil
+}
+
+func (r *LocationRepository) scanCheckIn(row pgx.Row) (*location.CheckIn, error) {
+	var c location.CheckIn
+	err := row.Scan(&c.ID, &c.UserID, &c.PlaceID, &c.Type, &c.Latitude, &c.Longitude, &c.Note, &c.AutoCheck, &c.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, location.ErrCheckInNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan check-in: %w", err)
+	}
+	return &c, nil
+}
+
+var _ location.Repository = (*LocationRepository)(nil)
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/danielng/kin-core-svc/internal/config"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+var errRollback = errors.New("rollback")
+
+// testDB connects to the migrated database in TEST_DATABASE_URL, skipping the
+// test when it is not set.
+func testDB(t *testing.T) *DB {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	db, err := NewDB(context.Background(), config.DatabaseConfig{WriteURL: url, MaxOpenConns: 2, MaxIdleConns: 1})
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(db.Close)
+	return db
+}
+
+// inRollback runs fn in a transaction that is always rolled back, so tests
+// leave nothing behind.
+func inRollback(t *testing.T, db *DB, fn func(ctx context.Context)) {
+	t.Helper()
+
+	err := db.Do(context.Background(), func(ctx context.Context) error {
+		fn(ctx)
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("transaction: %v", err)
+	}
+}
+
+func TestLocationRepositoryListNearby(t *testing.T) {
+	db := testDB(t)
+	users := NewUserRepository(db)
+	repo := NewLocationRepository(db)
+
+	inRollback(t, db, func(ctx context.Context) {
+		at := func(name string, lat, lng float64) uuid.UUID {
+			u := user.NewUser("test|"+uuid.NewString(), name)
+			if err := users.Create(ctx, u); err != nil {
+				t.Fatalf("create user: %v", err)
+			}
+			if err := repo.CreateOrUpdate(ctx, location.NewLocation(u.ID, lat, lng)); err != nil {
+				t.Fatalf("create location: %v", err)
+			}
+			return u.ID
+		}
+
+		near := at("near", 51.5045, -0.12) // About 500 m north
+		far := at("far", 51.6, -0.12)      // About 11 km north
+		west := at("west", 51.5, -0.1275)  // About 520 m west
+		east := at("east", 0, -179.999)    // Across the antimeridian from (0, 179.999)
+		beyond := at("beyond", 0, -179.9)  // About 11 km across the antimeridian
+		ids := []uuid.UUID{near, far, west, east, beyond}
+
+		tests := []struct {
+			name     string
+			lat, lng float64
+			radius   float64
+			want     []uuid.UUID
+		}{
+			{name: "within radius, nearest first", lat: 51.5, lng: -0.12, radius: 1000, want: []uuid.UUID{near, west}},
+			{name: "larger radius", lat: 51.5, lng: -0.12, radius: 20000, want: []uuid.UUID{near, west, far}},
+			{name: "across the antimeridian", lat: 0, lng: 179.999, radius: 1000, want: []uuid.UUID{east}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := repo.ListNearby(ctx, ids, tt.lat, tt.lng, tt.radius)
+				if err != nil {
+					t.Fatalf("ListNearby: %v", err)
+				}
+
+				gotIDs := make([]uuid.UUID, len(got))
+				for i, l := range got {
+					gotIDs[i] = l.UserID
+				}
+				if !slices.Equal(gotIDs, tt.want) {
+					t.Errorf("ListNearby = %v, want %v", gotIDs, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestLocationRepositoryFindPlaceByLocation(t *testing.T) {
+	db := testDB(t)
+	users := NewUserRepository(db)
+	repo := NewLocationRepository(db)
+
+	inRollback(t, db, func(ctx context.Context) {
+		u := user.NewUser("test|"+uuid.NewString(), "places")
+		if err := users.Create(ctx, u); err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+
+		for i, p := range []*location.Place{
+			location.NewPlace(u.ID, "Home", location.PlaceTypeHome, 51.5, -0.12, 100),
+			location.NewPlace(u.ID, "Hut", location.PlaceTypeOther, 0, 179.9995, 200),
+		} {
+			if err := repo.CreatePlace(ctx, p); err != nil {
+				t.Fatalf("create place %d: %v", i, err)
+			}
+		}
+
+		tests := []struct {
+			lat, lng float64
+			want     string
+		}{
+			{51.5004, -0.12, "Home"},
+			{51.502, -0.12, ""},
+			{0, -179.9995, "Hut"},
+		}
+
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%v,%v", tt.lat, tt.lng), func(t *testing.T) {
+				p, err := repo.FindPlaceByLocation(ctx, u.ID, tt.lat, tt.lng)
+				if tt.want == "" {
+					if !errors.Is(err, location.ErrPlaceNotFound) {
+						t.Errorf("FindPlaceByLocation = %v, %v, want %v", p, err, location.ErrPlaceNotFound)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("FindPlaceByLocation: %v", err)
+				}
+				if p.Name != tt.want {
+					t.Errorf("FindPlaceByLocation = %s, want %s", p.Name, tt.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,573 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/danielng/kin-core-svc/pkg/uid"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const messageColumns = `
+	id, conversation_id, sender_id, content_type, content_text, content_media_id,
+	content_media_url, content_metadata, reply_to_id, is_edited, edited_at, deleted_for_all, created_at
+`
+
+type MessageRepository struct {
+	db *DB
+}
+
+func NewMessageRepository(db *DB) *MessageRepository {
+	return &MessageRepository{db: db}
+}
+
+func (r *MessageRepository) Create(ctx context.Context, m *messaging.Message) error {
+	query := `
+		INSERT INTO messages (
+			id, conversation_id, sender_id, content_type, content_text, content_media_id,
+			content_media_url, content_metadata, reply_to_id, is_edited, edited_at, deleted_for_all, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+	err := pgx.BeginFunc(ctx, r.db.writer(ctx), func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, query,
+			m.ID, m.ConversationID, m.SenderID, m.Content.Type, m.Content.Text, m.Content.MediaID,
+			m.Content.MediaURL, m.Content.Metadata, m.ReplyToID, m.IsEdited, m.EditedAt, m.DeletedForAll, m.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create message: %w", err)
+		}
+		return insertEvents(ctx, tx, m.Events())
+	})
+	if err != nil {
+		return err
+	}
+	r.db.afterCommit(ctx, m.ClearEvents)
+	return nil
+}
+
+func (r *MessageRepository) GetByID(ctx context.Context, id uuid.UUID) (*messaging.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE id = $1`
+	return r.scanMessage(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *MessageRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*messaging.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE id = ANY($1)`
+	rows, err := r.db.reader(ctx).Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanMessages(rows)
+}
+
+func (r *MessageRepository) Update(ctx context.Context, m *messaging.Message) error {
+	query := `
+		UPDATE messages
+		SET content_type = $1, content_text = $2, content_media_id = $3, content_media_url = $4,
+			content_metadata = $5, is_edited = $6, edited_at = $7, deleted_for_all = $8
+		WHERE id = $9
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		m.Content.Type, m.Content.Text, m.Content.MediaID, m.Content.MediaURL,
+		m.Content.Metadata, m.IsEdited, m.EditedAt, m.DeletedForAll, m.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update message: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM messages WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+	return nil
+}
+
+// ListByConversation returns messages newest first. When cursor is set, only
+// messages strictly before it are returned.
+func (r *MessageRepository) ListByConversation(ctx context.Context, conversationID, viewerID uuid.UUID, cursor *messaging.Cursor, limit int) ([]*messaging.Message, error) {
+	var cursorAt *time.Time
+	var cursorID *uuid.UUID
+	if cursor != nil {
+		cursorAt, cursorID = &cursor.CreatedAt, &cursor.ID
+	}
+
+	query := `
+		SELECT ` + messageColumns + `
+		FROM messages m
+		WHERE m.conversation_id = $1
+		  AND ($3::timestamptz IS NULL OR (m.created_at, m.id) < ($3::timestamptz, $4::uuid))
+		  AND NOT EXISTS (
+			SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = $2
+		  )
+		ORDER BY m.created_at DESC, m.id DESC
+		LIMIT $5
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, conversationID, viewerID, cursorAt, cursorID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanMessages(rows)
+}
+
+func (r *MessageRepository) ListByConversationAfter(ctx context.Context, conversationID uuid.UUID, afterID uuid.UUID, limit int) ([]*messaging.Message, error) {
+	query := `
+		SELECT ` + messageColumns + `
+		FROM messages
+		WHERE conversation_id = $1
+		  AND created_at > (SELECT created_at FROM messages WHERE id = $2)
+		ORDER BY created_at ASC, id ASC
+		LIMIT $3
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, conversationID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanMessages(rows)
+}
+
+func (r *MessageRepository) CountByConversation(ctx context.Context, conversationID uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM messages WHERE conversation_id = $1 AND deleted_for_all = false`
+	var count int64
+	err := r.db.reader(ctx).QueryRow(ctx, query, conversationID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count messages: %w", err)
+	}
+	return count, nil
+}
+
+func (r *MessageRepository) CountUnreadByUser(ctx context.Context, conversationID, userID uuid.UUID, after time.Time) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM messages m
+		WHERE m.conversation_id = $1
+		  AND m.sender_id <> $2
+		  AND m.created_at > $3
+		  AND m.deleted_for_all = false
+		  AND NOT EXISTS (
+			SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = $2
+		  )
+	`
+	var count int64
+	err := r.db.reader(ctx).QueryRow(ctx, query, conversationID, userID, after).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+	return count, nil
+}
+
+func (r *MessageRepository) GetLatestByConversation(ctx context.Context, conversationID, viewerID uuid.UUID) (*messaging.Message, error) {
+	query := `
+		SELECT ` + messageColumns + `
+		FROM messages m
+		WHERE m.conversation_id = $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = $2
+		  )
+		ORDER BY m.created_at DESC, m.id DESC
+		LIMIT 1
+	`
+	return r.scanMessage(r.db.reader(ctx).QueryRow(ctx, query, conversationID, viewerID))
+}
+
+// ListLatestByConversations returns the latest message of each conversation
+// that has any the viewer has not deleted for themselves.
+func (r *MessageRepository) ListLatestByConversations(ctx context.Context, viewerID uuid.UUID, conversationIDs []uuid.UUID) ([]*messaging.Message, error) {
+	query := `
+		SELECT DISTINCT ON (m.conversation_id) ` + messageColumns + `
+		FROM messages m
+		WHERE m.conversation_id = ANY($1)
+		  AND NOT EXISTS (
+			SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = $2
+		  )
+		ORDER BY m.conversation_id, m.created_at DESC, m.id DESC
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, conversationIDs, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list latest messages: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanMessages(rows)
+}
+
+func (r *MessageRepository) CountUnreadByConversations(ctx context.Context, userID uuid.UUID, conversationIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	query := `
+		SELECT m.conversation_id, COUNT(*)
+		FROM messages m
+		INNER JOIN conversation_participants cp ON cp.conversation_id = m.conversation_id AND cp.user_id = $2
+		WHERE m.conversation_id = ANY($1)
+		  AND m.sender_id <> $2
+		  AND m.created_at > GREATEST(cp.joined_at, cp.last_read_at)
+		  AND m.deleted_for_all = false
+		  AND NOT EXISTS (
+			SELECT 1 FROM message_deletions md WHERE md.message_id = m.id AND md.user_id = $2
+		  )
+		GROUP BY m.conversation_id
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, conversationIDs, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[uuid.UUID]int64)
+	for rows.Next() {
+		var conversationID uuid.UUID
+		var count int64
+		if err := rows.Scan(&conversationID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan unread count: %w", err)
+		}
+		counts[conversationID] = count
+	}
+	return counts, rows.Err()
+}
+
+func (r *MessageRepository) SearchInConversation(ctx context.Context, conversationID uuid.UUID, query string, limit int) ([]*messaging.Message, error) {
+	sql := `
+		SELECT ` + messageColumns + `
+		FROM messages
+		WHERE conversation_id = $1
+		  AND deleted_for_all = false
+		  AND content_text ILIKE '%' || $2 || '%'
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, sql, conversationID, escapeLike(query), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanMessages(rows)
+}
+
+func (r *MessageRepository) CreateDeletion(ctx context.Context, d *messaging.MessageDeletion) error {
+	query := `
+		INSERT INTO message_deletions (id, message_id, user_id, deleted_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (message_id, user_id) DO NOTHING
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query, d.ID, d.MessageID, d.UserID, d.DeletedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create message deletion: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) GetDeletion(ctx context.Context, messageID, userID uuid.UUID) (*messaging.MessageDeletion, error) {
+	query := `
+		SELECT id, message_id, user_id, deleted_at
+		FROM message_deletions
+		WHERE message_id = $1 AND user_id = $2
+	`
+	var d messaging.MessageDeletion
+	err := r.db.reader(ctx).QueryRow(ctx, query, messageID, userID).Scan(&d.ID, &d.MessageID, &d.UserID, &d.DeletedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, messaging.ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message deletion: %w", err)
+	}
+	return &d, nil
+}
+
+func (r *MessageRepository) ListDeletedByUser(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT md.message_id
+		FROM message_deletions md
+		INNER JOIN messages m ON m.id = md.message_id
+		WHERE md.user_id = $1 AND m.conversation_id = $2
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted messages: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan deleted message: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *MessageRepository) CreateReceipt(ctx context.Context, rc *messaging.Receipt) error {
+	query := `
+		INSERT INTO message_receipts (id, message_id, user_id, status, delivered_at, read_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		rc.ID, rc.MessageID, rc.UserID, rc.Status, rc.DeliveredAt, rc.ReadAt, rc.CreatedAt, rc.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create message receipt: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) GetReceipt(ctx context.Context, messageID, userID uuid.UUID) (*messaging.Receipt, error) {
+	query := `
+		SELECT id, message_id, user_id, status, delivered_at, read_at, created_at, updated_at
+		FROM message_receipts
+		WHERE message_id = $1 AND user_id = $2
+	`
+	var rc messaging.Receipt
+	err := r.db.reader(ctx).QueryRow(ctx, query, messageID, userID).Scan(
+		&rc.ID, &rc.MessageID, &rc.UserID, &rc.Status, &rc.DeliveredAt, &rc.ReadAt, &rc.CreatedAt, &rc.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, messaging.ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message receipt: %w", err)
+	}
+	return &rc, nil
+}
+
+func (r *MessageRepository) UpdateReceipt(ctx context.Context, rc *messaging.Receipt) error {
+	query := `
+		UPDATE message_receipts
+		SET status = $1, delivered_at = $2, read_at = $3, updated_at = $4
+		WHERE id = $5
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query, rc.Status, rc.DeliveredAt, rc.ReadAt, rc.UpdatedAt, rc.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update message receipt: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) ListReceiptsByMessage(ctx context.Context, messageID uuid.UUID) ([]*messaging.Receipt, error) {
+	query := `
+		SELECT id, message_id, user_id, status, delivered_at, read_at, created_at, updated_at
+		FROM message_receipts
+		WHERE message_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list message receipts: %w", err)
+	}
+	defer rows.Close()
+
+	var receipts []*messaging.Receipt
+	for rows.Next() {
+		var rc messaging.Receipt
+		if err := rows.Scan(
+			&rc.ID, &rc.MessageID, &rc.UserID, &rc.Status, &rc.DeliveredAt, &rc.ReadAt, &rc.CreatedAt, &rc.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan message receipt: %w", err)
+		}
+		receipts = append(receipts, &rc)
+	}
+	return receipts, rows.Err()
+}
+
+// BulkUpdateReceiptsDelivered upserts receipts for the given messages, never
+// downgrading a receipt that has already been read.
+func (r *MessageRepository) BulkUpdateReceiptsDelivered(ctx context.Context, messageIDs []uuid.UUID, userID uuid.UUID) error {
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO message_receipts (id, message_id, user_id, status, delivered_at, created_at, updated_at)
+		SELECT ids.id, ids.message_id, $3, $4, $5, $5, $5
+		FROM unnest($1::uuid[], $2::uuid[]) AS ids(id, message_id)
+		ON CONFLICT (message_id, user_id) DO UPDATE
+		SET status = EXCLUDED.status, delivered_at = EXCLUDED.delivered_at, updated_at = EXCLUDED.updated_at
+		WHERE message_receipts.status = $6
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		newReceiptIDs(len(messageIDs)), messageIDs, userID,
+		messaging.DeliveryStatusDelivered, time.Now(), messaging.DeliveryStatusSent)
+	if err != nil {
+		return fmt.Errorf("failed to mark messages as delivered: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) BulkUpdateReceiptsRead(ctx context.Context, messageIDs []uuid.UUID, userID uuid.UUID) error {
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO message_receipts (id, message_id, user_id, status, delivered_at, read_at, created_at, updated_at)
+		SELECT ids.id, ids.message_id, $3, $4, $5, $5, $5, $5
+		FROM unnest($1::uuid[], $2::uuid[]) AS ids(id, message_id)
+		ON CONFLICT (message_id, user_id) DO UPDATE
+		SET status = EXCLUDED.status,
+			delivered_at = COALESCE(message_receipts.delivered_at, EXCLUDED.delivered_at),
+			read_at = EXCLUDED.read_at,
+			updated_at = EXCLUDED.updated_at
+		WHERE message_receipts.status <> $4
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		newReceiptIDs(len(messageIDs)), messageIDs, userID,
+		messaging.DeliveryStatusRead, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to mark messages as read: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) CreateReaction(ctx context.Context, rc *messaging.Reaction) error {
+	query := `
+		INSERT INTO message_reactions (id, message_id, user_id, emoji, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query, rc.ID, rc.MessageID, rc.UserID, rc.Emoji, rc.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create reaction: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) GetReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) (*messaging.Reaction, error) {
+	query := `
+		SELECT id, message_id, user_id, emoji, created_at
+		FROM message_reactions
+		WHERE message_id = $1 AND user_id = $2 AND emoji = $3
+	`
+	var rc messaging.Reaction
+	err := r.db.reader(ctx).QueryRow(ctx, query, messageID, userID, emoji).Scan(
+		&rc.ID, &rc.MessageID, &rc.UserID, &rc.Emoji, &rc.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, messaging.ErrReactionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reaction: %w", err)
+	}
+	return &rc, nil
+}
+
+func (r *MessageRepository) DeleteReaction(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM message_reactions WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete reaction: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) DeleteUserReaction(ctx context.Context, messageID, userID uuid.UUID, emoji string) error {
+	query := `DELETE FROM message_reactions WHERE message_id = $1 AND user_id = $2 AND emoji = $3`
+	_, err := r.db.writer(ctx).Exec(ctx, query, messageID, userID, emoji)
+	if err != nil {
+		return fmt.Errorf("failed to delete reaction: %w", err)
+	}
+	return nil
+}
+
+func (r *MessageRepository) ListReactionsByMessage(ctx context.Context, messageID uuid.UUID) ([]*messaging.Reaction, error) {
+	query := `
+		SELECT id, message_id, user_id, emoji, created_at
+		FROM message_reactions
+		WHERE message_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reactions: %w", err)
+	}
+	defer rows.Close()
+
+	var reactions []*messaging.Reaction
+	for rows.Next() {
+		var rc messaging.Reaction
+		if err := rows.Scan(&rc.ID, &rc.MessageID, &rc.UserID, &rc.Emoji, &rc.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reaction: %w", err)
+		}
+		reactions = append(reactions, &rc)
+	}
+	return reactions, rows.Err()
+}
+
+func (r *MessageRepository) CountReactionsByMessage(ctx context.Context, messageID uuid.UUID) (map[string]int, error) {
+	query := `
+		SELECT emoji, COUNT(*)
+		FROM message_reactions
+		WHERE message_id = $1
+		GROUP BY emoji
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reactions: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var emoji string
+		var count int
+		if err := rows.Scan(&emoji, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan reaction count: %w", err)
+		}
+		counts[emoji] = count
+	}
+	return counts, rows.Err()
+}
+
+func (r *MessageRepository) scanMessage(row pgx.Row) (*messaging.Message, error) {
+	var m messaging.Message
+	err := row.Scan(
+		&m.ID, &m.ConversationID, &m.SenderID, &m.Content.Type, &m.Content.Text, &m.Content.MediaID,
+		&m.Content.MediaURL, &m.Content.Metadata, &m.ReplyToID, &m.IsEdited, &m.EditedAt, &m.DeletedForAll, &m.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, messaging.ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan message: %w", err)
+	}
+	return &m, nil
+}
+
+func (r *MessageRepository) scanMessages(rows pgx.Rows) ([]*messaging.Message, error) {
+	var messages []*messaging.Message
+	for rows.Next() {
+		var m messaging.Message
+		err := rows.Scan(
+			&m.ID, &m.ConversationID, &m.SenderID, &m.Content.Type, &m.Content.Text, &m.Content.MediaID,
+			&m.Content.MediaURL, &m.Content.Metadata, &m.ReplyToID, &m.IsEdited, &m.EditedAt, &m.DeletedForAll, &m.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan message: %w", err)
+		}
+		messages = append(messages, &m)
+	}
+	return messages, rows.Err()
+}
+
+func newReceiptIDs(n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i] = uid.New()
+	}
+	return ids
+}
+
+func escapeLike(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `%`, `\%`)
+	return strings.ReplaceAll(s, `_`, `\_`)
+}
+
+var _ messaging.Repository = (*MessageRepository)(nil)
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/danielng/kin-core-svc/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+func createUser(t *testing.T, ctx context.Context, db *DB, name string) uuid.UUID {
+	t.Helper()
+
+	u := user.NewUser("test|"+uuid.NewString(), name)
+	if err := NewUserRepository(db).Create(ctx, u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return u.ID
+}
+
+// directConversation creates two users and the conversation between them.
+func directConversation(t *testing.T, ctx context.Context, db *DB) (conversationID, alice, bob uuid.UUID) {
+	t.Helper()
+
+	alice, bob = createUser(t, ctx, db, "alice"), createUser(t, ctx, db, "bob")
+	conv, err := NewConversationRepository(db).GetOrCreateDirectConversation(ctx, alice, bob)
+	if err != nil {
+		t.Fatalf("create conversation: %v", err)
+	}
+	return conv.ID, alice, bob
+}
+
+// sendAt stores a text message sent at the given time.
+func sendAt(t *testing.T, ctx context.Context, repo *MessageRepository, conversationID, senderID uuid.UUID, at time.Time) *messaging.Message {
+	t.Helper()
+
+	m := messaging.NewMessage(conversationID, senderID, messaging.NewTextContent("hi"))
+	m.CreatedAt = at
+	if err := repo.Create(ctx, m); err != nil {
+		t.Fatalf("create message: %v", err)
+	}
+	return m
+}
+
+func TestMessageRepositoryListLatestByConversations(t *testing.T) {
+	db := testDB(t)
+	repo := NewMessageRepository(db)
+
+	inRollback(t, db, func(ctx context.Context) {
+		convID, alice, bob := directConversation(t, ctx, db)
+		start := time.Now().Truncate(time.Microsecond)
+		older := sendAt(t, ctx, repo, convID, alice, start)
+		newer := sendAt(t, ctx, repo, convID, bob, start.Add(time.Second))
+
+		if err := repo.CreateDeletion(ctx, messaging.NewMessageDeletion(newer.ID, alice)); err != nil {
+			t.Fatalf("delete for alice: %v", err)
+		}
+
+		tests := []struct {
+			name   string
+			viewer uuid.UUID
+			want   uuid.UUID
+		}{
+			{name: "skips the message the viewer deleted", viewer: alice, want: older.ID},
+			{name: "others still see it", viewer: bob, want: newer.ID},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				latest, err := repo.ListLatestByConversations(ctx, tt.viewer, []uuid.UUID{convID})
+				if err != nil {
+					t.Fatalf("ListLatestByConversations: %v", err)
+				}
+				if len(latest) != 1 || latest[0].ID != tt.want {
+					t.Fatalf("ListLatestByConversations = %v, want %v", latest, tt.want)
+				}
+
+				got, err := repo.GetLatestByConversation(ctx, convID, tt.viewer)
+				if err != nil {
+					t.Fatalf("GetLatestByConversation: %v", err)
+				}
+				if got.ID != tt.want {
+					t.Errorf("GetLatestByConversation = %v, want %v", got.ID, tt.want)
+				}
+			})
+		}
+
+		t.Run("a message deleted for everyone stays the latest", func(t *testing.T) {
+			newer.DeleteForAll()
+			if err := repo.Update(ctx, newer); err != nil {
+				t.Fatalf("delete for everyone: %v", err)
+			}
+			latest, err := repo.ListLatestByConversations(ctx, bob, []uuid.UUID{convID})
+			if err != nil {
+				t.Fatalf("ListLatestByConversations: %v", err)
+			}
+			if len(latest) != 1 || latest[0].ID != newer.ID || !latest[0].IsDeleted() {
+				t.Errorf("ListLatestByConversations = %v, want deleted %v", latest, newer.ID)
+			}
+		})
+	})
+}
+
+func TestMessageRepositoryListByConversation(t *testing.T) {
+	db := testDB(t)
+	repo := NewMessageRepository(db)
+
+	inRollback(t, db, func(ctx context.Context) {
+		convID, alice, bob := directConversation(t, ctx, db)
+
+		// Three messages share a timestamp, so only the ID tells them apart
+		// at a page boundary.
+		start := time.Now().Truncate(time.Microsecond)
+		var sent []*messaging.Message
+		for i, at := range []time.Time{start, start.Add(time.Second), start.Add(time.Second), start.Add(time.Second), start.Add(2 * time.Second)} {
+			sender := alice
+			if i%2 == 1 {
+				sender = bob
+			}
+			sent = append(sent, sendAt(t, ctx, repo, convID, sender, at))
+		}
+		deleted := sent[3]
+		if err := repo.CreateDeletion(ctx, messaging.NewMessageDeletion(deleted.ID, alice)); err != nil {
+			t.Fatalf("delete for alice: %v", err)
+		}
+
+		// page walks the whole history two messages at a time.
+		page := func(t *testing.T, viewer uuid.UUID) []uuid.UUID {
+			var (
+				ids    []uuid.UUID
+				cursor *messaging.Cursor
+			)
+			for {
+				msgs, err := repo.ListByConversation(ctx, convID, viewer, cursor, 2)
+				if err != nil {
+					t.Fatalf("ListByConversation: %v", err)
+				}
+				for _, m := range msgs {
+					ids = append(ids, m.ID)
+				}
+				if len(msgs) < 2 {
+					return ids
+				}
+				last := msgs[len(msgs)-1]
+				cursor = &messaging.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+			}
+		}
+
+		// newestFirst orders the messages as the history is, leaving out skip.
+		newestFirst := func(skip *messaging.Message) []uuid.UUID {
+			sorted := slices.Clone(sent)
+			slices.SortFunc(sorted, func(a, b *messaging.Message) int {
+				if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+					return c
+				}
+				return bytes.Compare(b.ID[:], a.ID[:])
+			})
+			var ids []uuid.UUID
+			for _, m := range sorted {
+				if m != skip {
+					ids = append(ids, m.ID)
+				}
+			}
+			return ids
+		}
+
+		tests := []struct {
+			name   string
+			viewer uuid.UUID
+			want   []uuid.UUID
+		}{
+			{name: "pages neither skip nor repeat messages sharing a timestamp", viewer: bob, want: newestFirst(nil)},
+			{name: "leaves out messages the viewer deleted", viewer: alice, want: newestFirst(deleted)},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := page(t, tt.viewer); !slices.Equal(got, tt.want) {
+					t.Errorf("paged %v, want %v", got, tt.want)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielng/kin-core-svc/internal/domain/notification"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const notificationColumns = `
+	id, user_id, type, title, body, data, is_read, is_sent, sent_at, read_at, created_at
+`
+
+const notificationPreferencesColumns = `
+	user_id, push_enabled, email_enabled, message_push, message_email, reaction_push,
+	circle_invite_push, circle_invite_email, contact_request_push, check_in_push,
+	availability_push, quiet_hours_enabled, quiet_hours_start, quiet_hours_end,
+	created_at, updated_at
+`
+
+type NotificationRepository struct {
+	db *DB
+}
+
+func NewNotificationRepository(db *DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+	query := `
+		INSERT INTO notifications (` + notificationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		n.ID, n.UserID, n.Type, n.Title, n.Body, n.Data, n.IsRead, n.IsSent, n.SentAt, n.ReadAt, n.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) GetByID(ctx context.Context, id uuid.UUID) (*notification.Notification, error) {
+	query := `SELECT ` + notificationColumns + ` FROM notifications WHERE id = $1`
+	return r.scanNotification(r.db.reader(ctx).QueryRow(ctx, query, id))
+}
+
+func (r *NotificationRepository) Update(ctx context.Context, n *notification.Notification) error {
+	query := `
+		UPDATE notifications
+		SET title = $1, body = $2, data = $3, is_read = $4, is_sent = $5, sent_at = $6, read_at = $7
+		WHERE id = $8
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		n.Title, n.Body, n.Data, n.IsRead, n.IsSent, n.SentAt, n.ReadAt, n.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM notifications WHERE id = $1`
+	_, err := r.db.writer(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete notification: %w", err)
+	}
+	return nil
+}
+
+// ListByUser returns the user's notifications, newest first.
+func (r *NotificationRepository) ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*notification.Notification, error) {
+	query := `
+		SELECT ` + notificationColumns + `
+		FROM notifications
+		WHERE user_id = $1 AND (NOT $2 OR NOT is_read)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.db.reader(ctx).Query(ctx, query, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []*notification.Notification
+	for rows.Next() {
+		n, err := r.scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, rows.Err()
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND NOT is_read`
+	var count int64
+	if err := r.db.reader(ctx).QueryRow(ctx, query, userID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID uuid.UUID) error {
+	query := `UPDATE notifications SET is_read = true, read_at = NOW() WHERE user_id = $1 AND NOT is_read`
+	_, err := r.db.writer(ctx).Exec(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to mark notifications as read: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) DeleteOlderThan(ctx context.Context, userID uuid.UUID, daysOld int) error {
+	query := `DELETE FROM notifications WHERE user_id = $1 AND created_at < NOW() - make_interval(days => $2)`
+	_, err := r.db.writer(ctx).Exec(ctx, query, userID, daysOld)
+	if err != nil {
+		return fmt.Errorf("failed to delete old notifications: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) CreatePreferences(ctx context.Context, p *notification.NotificationPreferences) error {
+	query := `
+		INSERT INTO notification_preferences (` + notificationPreferencesColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		p.UserID, p.PushEnabled, p.EmailEnabled, p.MessagePush, p.MessageEmail, p.ReactionPush,
+		p.CircleInvitePush, p.CircleInviteEmail, p.ContactRequestPush, p.CheckInPush,
+		p.AvailabilityPush, p.QuietHoursEnabled, p.QuietHoursStart, p.QuietHoursEnd,
+		p.CreatedAt, p.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create notification preferences: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) GetPreferences(ctx context.Context, userID uuid.UUID) (*notification.NotificationPreferences, error) {
+	query := `SELECT ` + notificationPreferencesColumns + ` FROM notification_preferences WHERE user_id = $1`
+
+	var p notification.NotificationPreferences
+	err := r.db.reader(ctx).QueryRow(ctx, query, userID).Scan(
+		&p.UserID, &p.PushEnabled, &p.EmailEnabled, &p.MessagePush, &p.MessageEmail, &p.ReactionPush,
+		&p.CircleInvitePush, &p.CircleInviteEmail, &p.ContactRequestPush, &p.CheckInPush,
+		&p.AvailabilityPush, &p.QuietHoursEnabled, &p.QuietHoursStart, &p.QuietHoursEnd,
+		&p.CreatedAt, &p.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, notification.ErrPreferencesNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	return &p, nil
+}
+
+func (r *NotificationRepository) UpdatePreferences(ctx context.Context, p *notification.NotificationPreferences) error {
+	query := `
+		UPDATE notification_preferences
+		SET push_enabled = $1, email_enabled = $2, message_push = $3, message_email = $4,
+			reaction_push = $5, circle_invite_push = $6, circle_invite_email = $7,
+			contact_request_push = $8, check_in_push = $9, availability_push = $10,
+			quiet_hours_enabled = $11, quiet_hours_start = $12, quiet_hours_end = $13,
+			updated_at = $14
+		WHERE user_id = $15
+	`
+	_, err := r.db.writer(ctx).Exec(ctx, query,
+		p.PushEnabled, p.EmailEnabled, p.MessagePush, p.MessageEmail,
+		p.ReactionPush, p.CircleInvitePush, p.CircleInviteEmail,
+		p.ContactRequestPush, p.CheckInPush, p.AvailabilityPush,
+		p.QuietHoursEnabled, p.QuietHoursStart, p.QuietHoursEnd,
+		p.UpdatedAt, p.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to update notification preferences: %w", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) scanNotification(row pgx.Row) (*notification.Notification, error) {
+	var n notification.Notification
+	err := row.Scan(
+		&n.ID, &n.UserID, &n.Type, &n.Title, &n.Body, &n.Data,
+		&n.IsRead, &n.IsSent, &n.SentAt, &n.ReadAt, &n.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, notification.ErrNotificationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan notification: %w", err)
+	}
+	return &n, nil
+}
+
+var _ notification.Repository = (*NotificationRepository)(nil)
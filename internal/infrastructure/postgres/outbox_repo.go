@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/event"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type OutboxRepository struct {
+	db *DB
+}
+
+func NewOutboxRepository(db *DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+func (r *OutboxRepository) Process(ctx context.Context, limit int, fn func(ctx context.Context, events []*event.Event) error) (int, error) {
+	var processed int
+	err := pgx.BeginFunc(ctx, r.db.writer(ctx), func(tx pgx.Tx) error {
+		// SKIP LOCKED lets several relays drain the outbox without handing
+		// out the same event twice.
+		query := `
+			SELECT id, event_type, aggregate_type, aggregate_id, payload, occurred_at
+			FROM outbox_events
+			WHERE published_at IS NULL
+			ORDER BY occurred_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		`
+		rows, err := tx.Query(ctx, query, limit)
+		if err != nil {
+			return fmt.Errorf("failed to claim outbox events: %w", err)
+		}
+
+		var events []*event.Event
+		for rows.Next() {
+			var e event.Event
+			if err := rows.Scan(&e.ID, &e.Type, &e.AggregateType, &e.AggregateID, &e.Payload, &e.OccurredAt); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan outbox event: %w", err)
+			}
+			events = append(events, &e)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to iterate outbox events: %w", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		if err := fn(ctx, events); err != nil {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(events))
+		for i, e := range events {
+			ids[i] = e.ID
+		}
+		if _, err := tx.Exec(ctx, `UPDATE outbox_events SET published_at = NOW() WHERE id = ANY($1)`, ids); err != nil {
+			return fmt.Errorf("failed to mark outbox events published: %w", err)
+		}
+
+		processed = len(events)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return processed, nil
+}
+
+func (r *OutboxRepository) DeletePublished(ctx context.Context, before time.Time, limit int) (int, error) {
+	query := `
+		DELETE FROM outbox_events
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE published_at IS NOT NULL AND published_at < $1
+			LIMIT $2
+		)
+	`
+	tag, err := r.db.writer(ctx).Exec(ctx, query, before, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete published outbox events: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// insertEvents writes an aggregate's recorded events to the outbox within the
+// transaction that persists the aggregate itself.
+func insertEvents(ctx context.Context, tx pgx.Tx, payloads []event.Payload) error {
+	query := `
+		INSERT INTO outbox_events (id, event_type, aggregate_type, aggregate_id, payload, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	for _, p := range payloads {
+		e, err := event.New(p)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, query, e.ID, e.Type, e.AggregateType, e.AggregateID, e.Payload, e.OccurredAt); err != nil {
+			return fmt.Errorf("failed to write outbox event: %w", err)
+		}
+	}
+	return nil
+}
+
+var _ event.Repository = (*OutboxRepository)(nil)
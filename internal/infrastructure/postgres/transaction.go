@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/uow"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	maxTxAttempts = 3
+	txRetryDelay  = 20 * time.Millisecond
+)
+
+// querier is implemented by both the connection pools and pgx.Tx, so
+// repositories can run the same statements inside or outside a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type txKey struct{}
+
+type txState struct {
+	tx       pgx.Tx
+	onCommit []func()
+}
+
+// Do runs fn in a serializable transaction on the write pool. The whole of fn
+// is retried when Postgres reports a serialization failure or deadlock.
+func (db *DB) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxTxAttempts; attempt++ {
+		state := &txState{}
+		err = pgx.BeginTxFunc(ctx, db.write, pgx.TxOptions{IsoLevel: pgx.Serializable}, func(tx pgx.Tx) error {
+			state.tx = tx
+			return fn(context.WithValue(ctx, txKey{}, state))
+		})
+		if err == nil {
+			for _, hook := range state.onCommit {
+				hook()
+			}
+			return nil
+		}
+		if !isRetryable(err) || attempt == maxTxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * txRetryDelay):
+		}
+	}
+	return err
+}
+
+// writer returns the transaction carried by ctx, or the write pool.
+func (db *DB) writer(ctx context.Context) querier {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return db.write
+}
+
+// reader returns the transaction carried by ctx so reads see uncommitted
+// writes of the same unit of work, or the read pool.
+func (db *DB) reader(ctx context.Context) querier {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return db.read
+}
+
+// afterCommit runs fn once the surrounding unit of work commits, or straight
+// away when there is none.
+func (db *DB) afterCommit(ctx context.Context, fn func()) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.onCommit = append(state.onCommit, fn)
+		return
+	}
+	fn()
+}
+
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	// serialization_failure, deadlock_detected
+	return pgErr.Code == "40001" || pgErr.Code == "40P01"
+}
+
+var _ uow.UnitOfWork = (*DB)(nil)
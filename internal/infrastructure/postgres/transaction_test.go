@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// committedUser creates a user outside any unit of work, for tests whose
+// transactions must conflict with writes on other connections, and removes
+// it afterwards.
+func committedUser(t *testing.T, db *DB) uuid.UUID {
+	t.Helper()
+
+	ctx := context.Background()
+	id := createUser(t, ctx, db, "tx")
+	t.Cleanup(func() {
+		if _, err := db.writer(ctx).Exec(ctx, `DELETE FROM users WHERE id = $1`, id); err != nil {
+			t.Errorf("cleanup: %v", err)
+		}
+	})
+	return id
+}
+
+func TestDBDoRetriesSerializationFailures(t *testing.T) {
+	db := testDB(t)
+	userID := committedUser(t, db)
+
+	var attempts, hooks int
+	err := db.Do(context.Background(), func(ctx context.Context) error {
+		attempts++
+		db.afterCommit(ctx, func() { hooks++ })
+
+		var name string
+		if err := db.reader(ctx).QueryRow(ctx, `SELECT display_name FROM users WHERE id = $1`, userID).Scan(&name); err != nil {
+			return err
+		}
+
+		// Change the row on another connection after this transaction took
+		// its snapshot, so that its own update cannot be serialized.
+		if attempts == 1 {
+			if _, err := db.write.Exec(context.Background(), `UPDATE users SET display_name = 'other' WHERE id = $1`, userID); err != nil {
+				t.Fatalf("concurrent update: %v", err)
+			}
+		}
+
+		if _, err := db.writer(ctx).Exec(ctx, `UPDATE users SET display_name = 'mine' WHERE id = $1`, userID); err != nil {
+			return err
+		}
+		if hooks != 0 {
+			t.Errorf("hooks ran before commit")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("ran %d attempts, want 2", attempts)
+	}
+	if hooks != 1 {
+		t.Errorf("hooks ran %d times, want once", hooks)
+	}
+
+	var name string
+	if err := db.reader(context.Background()).QueryRow(context.Background(), `SELECT display_name FROM users WHERE id = $1`, userID).Scan(&name); err != nil {
+		t.Fatalf("read back: %v", err)
+	}
+	if name != "mine" {
+		t.Errorf("display_name = %q, want %q", name, "mine")
+	}
+}
+
+func TestDBDo(t *testing.T) {
+	db := testDB(t)
+	userID := committedUser(t, db)
+	errFailed := errors.New("failed")
+
+	t.Run("failure rolls back without retrying or running hooks", func(t *testing.T) {
+		var attempts, hooks int
+		err := db.Do(context.Background(), func(ctx context.Context) error {
+			attempts++
+			db.afterCommit(ctx, func() { hooks++ })
+			if _, err := db.writer(ctx).Exec(ctx, `UPDATE users SET display_name = 'rolled back' WHERE id = $1`, userID); err != nil {
+				return err
+			}
+			return errFailed
+		})
+		if !errors.Is(err, errFailed) {
+			t.Fatalf("Do error = %v, want %v", err, errFailed)
+		}
+		if attempts != 1 || hooks != 0 {
+			t.Errorf("ran %d attempts and %d hooks, want 1 and 0", attempts, hooks)
+		}
+
+		var name string
+		if err := db.reader(context.Background()).QueryRow(context.Background(), `SELECT display_name FROM users WHERE id = $1`, userID).Scan(&name); err != nil {
+			t.Fatalf("read back: %v", err)
+		}
+		if name == "rolled back" {
+			t.Errorf("update was committed")
+		}
+	})
+
+	t.Run("nested units of work join the outer transaction", func(t *testing.T) {
+		var hooks int
+		err := db.Do(context.Background(), func(ctx context.Context) error {
+			err := db.Do(ctx, func(ctx context.Context) error {
+				db.afterCommit(ctx, func() { hooks++ })
+				_, err := db.writer(ctx).Exec(ctx, `UPDATE users SET display_name = 'nested' WHERE id = $1`, userID)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			if hooks != 0 {
+				t.Errorf("hooks ran when the inner unit of work returned")
+			}
+
+			// The outer transaction reads the inner one's uncommitted write.
+			var name string
+			if err := db.reader(ctx).QueryRow(ctx, `SELECT display_name FROM users WHERE id = $1`, userID).Scan(&name); err != nil {
+				return err
+			}
+			if name != "nested" {
+				t.Errorf("display_name = %q inside the transaction, want %q", name, "nested")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+		if hooks != 1 {
+			t.Errorf("hooks ran %d times, want once", hooks)
+		}
+	})
+
+	t.Run("hooks outside a unit of work run straight away", func(t *testing.T) {
+		var hooks int
+		db.afterCommit(context.Background(), func() { hooks++ })
+		if hooks != 1 {
+			t.Errorf("hooks ran %d times, want once", hooks)
+		}
+	})
+}
@@ -23,7 +23,7 @@ func (r *UserRepository) Create(ctx context.Context, u *user.User) error {
 		INSERT INTO users (id, auth0_sub, display_name, avatar, bio, phone_number, timezone, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
-	_, err := r.db.Write().Exec(ctx, query, u.ID, u.Auth0Sub, u.DisplayName, u.Avatar, u.Bio, u.PhoneNumber, u.Timezone, u.CreatedAt, u.UpdatedAt)
+	_, err := r.db.writer(ctx).Exec(ctx, query, u.ID, u.Auth0Sub, u.DisplayName, u.Avatar, u.Bio, u.PhoneNumber, u.Timezone, u.CreatedAt, u.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create user: %w", err)
 	}
@@ -36,7 +36,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*user.User,
 		FROM users
 		WHERE id = $1
 	`
-	return r.scanUser(r.db.Read().QueryRow(ctx, query, id))
+	return r.scanUser(r.db.reader(ctx).QueryRow(ctx, query, id))
 }
 
 func (r *UserRepository) GetByAuth0Sub(ctx context.Context, auth0Sub string) (*user.User, error) {
@@ -45,7 +45,7 @@ func (r *UserRepository) GetByAuth0Sub(ctx context.Context, auth0Sub string) (*u
 		FROM users
 		WHERE auth0_sub = $1
 	`
-	return r.scanUser(r.db.Read().QueryRow(ctx, query, auth0Sub))
+	return r.scanUser(r.db.reader(ctx).QueryRow(ctx, query, auth0Sub))
 }
 
 func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
@@ -54,7 +54,7 @@ func (r *UserRepository) Update(ctx context.Context, u *user.User) error {
 		SET display_name = $1, avatar = $2, bio = $3, phone_number = $4, timezone = $5, updated_at = $6
 		WHERE id = $7
 	`
-	_, err := r.db.Write().Exec(ctx, query, u.DisplayName, u.Avatar, u.Bio, u.PhoneNumber, u.Timezone, u.UpdatedAt, u.ID)
+	_, err := r.db.writer(ctx).Exec(ctx, query, u.DisplayName, u.Avatar, u.Bio, u.PhoneNumber, u.Timezone, u.UpdatedAt, u.ID)
 	if err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
@@ -71,7 +71,7 @@ func (r *UserRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*use
 		FROM users
 		WHERE id = ANY($1)
 	`
-	rows, err := r.db.Read().Query(ctx, query, ids)
+	rows, err := r.db.reader(ctx).Query(ctx, query, ids)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find users: %w", err)
 	}
@@ -87,7 +87,7 @@ func (r *UserRepository) SearchByDisplayName(ctx context.Context, searchQuery st
 		WHERE display_name ILIKE $1
 		LIMIT $2
 	`
-	rows, err := r.db.Read().Query(ctx, query, "%"+searchQuery+"%", limit)
+	rows, err := r.db.reader(ctx).Query(ctx, query, "%"+searchQuery+"%", limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search users: %w", err)
 	}
@@ -101,13 +101,14 @@ func (r *UserRepository) CreatePreferences(ctx context.Context, prefs *user.Pref
 		INSERT INTO user_preferences (
 			user_id, default_privacy_level, show_online_status, show_last_seen,
 			show_read_receipts, allow_contact_discovery, push_notifications, email_notifications,
-			quiet_hours_enabled, quiet_hours_start, quiet_hours_end, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			quiet_hours_enabled, quiet_hours_start, quiet_hours_end, location_retention, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 	`
-	_, err := r.db.Write().Exec(ctx, query,
+	_, err := r.db.writer(ctx).Exec(ctx, query,
 		prefs.UserID, prefs.DefaultPrivacyLevel, prefs.ShowOnlineStatus, prefs.ShowLastSeen,
 		prefs.ShowReadReceipts, prefs.AllowContactDiscovery, prefs.PushNotifications, prefs.EmailNotifications,
-		prefs.QuietHoursEnabled, prefs.QuietHoursStart, prefs.QuietHoursEnd, prefs.CreatedAt, prefs.UpdatedAt)
+		prefs.QuietHoursEnabled, prefs.QuietHoursStart, prefs.QuietHoursEnd, prefs.LocationRetention,
+		prefs.CreatedAt, prefs.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create user preferences: %w", err)
 	}
@@ -118,17 +119,18 @@ func (r *UserRepository) GetPreferences(ctx context.Context, userID uuid.UUID) (
 	query := `
 		SELECT user_id, default_privacy_level, show_online_status, show_last_seen,
 			   show_read_receipts, allow_contact_discovery, push_notifications, email_notifications,
-			   quiet_hours_enabled, quiet_hours_start, quiet_hours_end, created_at, updated_at
+			   quiet_hours_enabled, quiet_hours_start, quiet_hours_end, location_retention, created_at, updated_at
 		FROM user_preferences
 		WHERE user_id = $1
 	`
-	row := r.db.Read().QueryRow(ctx, query, userID)
+	row := r.db.reader(ctx).QueryRow(ctx, query, userID)
 
 	var prefs user.Preferences
 	err := row.Scan(
 		&prefs.UserID, &prefs.DefaultPrivacyLevel, &prefs.ShowOnlineStatus, &prefs.ShowLastSeen,
 		&prefs.ShowReadReceipts, &prefs.AllowContactDiscovery, &prefs.PushNotifications, &prefs.EmailNotifications,
-		&prefs.QuietHoursEnabled, &prefs.QuietHoursStart, &prefs.QuietHoursEnd, &prefs.CreatedAt, &prefs.UpdatedAt,
+		&prefs.QuietHoursEnabled, &prefs.QuietHoursStart, &prefs.QuietHoursEnd, &prefs.LocationRetention,
+		&prefs.CreatedAt, &prefs.UpdatedAt,
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, user.ErrPreferencesNotFound
@@ -146,14 +148,14 @@ func (r *UserRepository) UpdatePreferences(ctx context.Context, prefs *user.Pref
 		SET default_privacy_level = $1, show_online_status = $2, show_last_seen = $3,
 			show_read_receipts = $4, allow_contact_discovery = $5, push_notifications = $6,
 			email_notifications = $7, quiet_hours_enabled = $8, quiet_hours_start = $9,
-			quiet_hours_end = $10, updated_at = $11
-		WHERE user_id = $12
+			quiet_hours_end = $10, location_retention = $11, updated_at = $12
+		WHERE user_id = $13
 	`
-	_, err := r.db.Write().Exec(ctx, query,
+	_, err := r.db.writer(ctx).Exec(ctx, query,
 		prefs.DefaultPrivacyLevel, prefs.ShowOnlineStatus, prefs.ShowLastSeen,
 		prefs.ShowReadReceipts, prefs.AllowContactDiscovery, prefs.PushNotifications,
 		prefs.EmailNotifications, prefs.QuietHoursEnabled, prefs.QuietHoursStart,
-		prefs.QuietHoursEnd, prefs.UpdatedAt, prefs.UserID)
+		prefs.QuietHoursEnd, prefs.LocationRetention, prefs.UpdatedAt, prefs.UserID)
 	if err != nil {
 		return fmt.Errorf("failed to update user preferences: %w", err)
 	}
@@ -0,0 +1,110 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	eventChannelPrefix = "events:user:"
+
+	subscriptionBufferSize = 64
+)
+
+type EventBroker struct {
+	client *Client
+	logger *slog.Logger
+}
+
+func NewEventBroker(client *Client, logger *slog.Logger) *EventBroker {
+	return &EventBroker{client: client, logger: logger}
+}
+
+func (b *EventBroker) Publish(ctx context.Context, userIDs []uuid.UUID, event *realtime.Event) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	pipe := b.client.Pipeline()
+	for _, userID := range userIDs {
+		pipe.Publish(ctx, eventChannel(userID), data)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	return nil
+}
+
+func (b *EventBroker) Subscribe(ctx context.Context, userID uuid.UUID) (realtime.Subscription, error) {
+	pubsub := b.client.Subscribe(ctx, eventChannel(userID))
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	sub := &eventSubscription{
+		pubsub: pubsub,
+		events: make(chan *realtime.Event, subscriptionBufferSize),
+		logger: b.logger,
+	}
+	go sub.run()
+
+	return sub, nil
+}
+
+type eventSubscription struct {
+	pubsub    *redis.PubSub
+	events    chan *realtime.Event
+	logger    *slog.Logger
+	closeOnce sync.Once
+}
+
+func (s *eventSubscription) run() {
+	defer close(s.events)
+
+	for msg := range s.pubsub.Channel() {
+		var event realtime.Event
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			s.logger.Warn("dropping malformed event", "channel", msg.Channel, "error", err)
+			continue
+		}
+
+		// A slow client must not block the shared pub/sub reader.
+		select {
+		case s.events <- &event:
+		default:
+			s.logger.Warn("dropping event for slow subscriber", "channel", msg.Channel, "event_type", event.Type)
+		}
+	}
+}
+
+func (s *eventSubscription) Events() <-chan *realtime.Event {
+	return s.events
+}
+
+func (s *eventSubscription) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		err = s.pubsub.Close()
+	})
+	return err
+}
+
+func eventChannel(userID uuid.UUID) string {
+	return eventChannelPrefix + userID.String()
+}
+
+var _ realtime.Broker = (*EventBroker)(nil)
@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/event"
+	"github.com/redis/go-redis/v9"
+)
+
+// StreamSink appends outbox events to a Redis stream so that independent
+// consumer groups (notifications, auditing, ...) can each read them.
+type StreamSink struct {
+	client *Client
+	stream string
+	maxLen int64
+}
+
+func NewStreamSink(client *Client, stream string, maxLen int64) *StreamSink {
+	return &StreamSink{client: client, stream: stream, maxLen: maxLen}
+}
+
+func (s *StreamSink) Name() string {
+	return "redis_stream"
+}
+
+func (s *StreamSink) Publish(ctx context.Context, events []*event.Event) error {
+	pipe := s.client.Pipeline()
+	for _, e := range events {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: s.stream,
+			MaxLen: s.maxLen,
+			Approx: true,
+			Values: map[string]any{
+				"id":             e.ID.String(),
+				"type":           e.Type,
+				"aggregate_type": e.AggregateType,
+				"aggregate_id":   e.AggregateID.String(),
+				"payload":        string(e.Payload),
+				"occurred_at":    e.OccurredAt.Format(time.RFC3339Nano),
+			},
+		})
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to append events to stream: %w", err)
+	}
+	return nil
+}
+
+var _ event.Sink = (*StreamSink)(nil)
@@ -0,0 +1,210 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	liveSessionKeyPrefix             = "live_session:"
+	liveSessionsByUserPrefix         = "live_sessions:user:"
+	liveSessionsByCirclePrefix       = "live_sessions:circle:"
+	liveSessionsByConversationPrefix = "live_sessions:conversation:"
+	liveSessionsExpiringKey          = "live_sessions:expiring"
+
+	// liveSessionGrace keeps a session's data after it expires so that
+	// PopExpired can still return it.
+	liveSessionGrace = time.Hour
+)
+
+// LiveSessionRepository stores each session under its own key, kept for a
+// grace period after the session expires, and indexes it in sorted sets scored
+// by expiry so that expired entries can be dropped on read. A global index of
+// every session lets PopExpired find those that have expired.
+type LiveSessionRepository struct {
+	client *Client
+}
+
+func NewLiveSessionRepository(client *Client) *LiveSessionRepository {
+	return &LiveSessionRepository{client: client}
+}
+
+func (r *LiveSessionRepository) Create(ctx context.Context, s *location.LiveSession) error {
+	ttl := time.Until(s.ExpiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to marshal live session: %w", err)
+	}
+
+	member := redis.Z{Score: float64(s.ExpiresAt.UnixNano()), Member: s.ID.String()}
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, liveSessionKey(s.ID), data, ttl+liveSessionGrace)
+		pipe.ZAdd(ctx, liveSessionsExpiringKey, member)
+		for _, key := range liveSessionIndexes(s) {
+			pipe.ZAdd(ctx, key, member)
+			// No session outlives the longest duration, so neither need
+			// an index no session has been added to for that long.
+			pipe.Expire(ctx, key, location.MaxLiveDuration)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create live session: %w", err)
+	}
+	return nil
+}
+
+func (r *LiveSessionRepository) Get(ctx context.Context, id uuid.UUID) (*location.LiveSession, error) {
+	data, err := r.client.Get(ctx, liveSessionKey(id)).Bytes()
+	if err == redis.Nil {
+		return nil, location.ErrLiveSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get live session: %w", err)
+	}
+
+	var s location.LiveSession
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal live session: %w", err)
+	}
+	if !s.IsActive() {
+		return nil, location.ErrLiveSessionNotFound
+	}
+	return &s, nil
+}
+
+func (r *LiveSessionRepository) Delete(ctx context.Context, s *location.LiveSession) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, liveSessionKey(s.ID))
+		pipe.ZRem(ctx, liveSessionsExpiringKey, s.ID.String())
+		for _, key := range liveSessionIndexes(s) {
+			pipe.ZRem(ctx, key, s.ID.String())
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete live session: %w", err)
+	}
+	return nil
+}
+
+func (r *LiveSessionRepository) PopExpired(ctx context.Context, before time.Time, limit int) ([]*location.LiveSession, error) {
+	ids, err := r.client.ZRangeByScore(ctx, liveSessionsExpiringKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(before.UnixNano(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired live sessions: %w", err)
+	}
+
+	sessions := make([]*location.LiveSession, 0, len(ids))
+	for _, id := range ids {
+		// Whoever removes the entry owns the session, so replicas popping at
+		// the same time never both return it.
+		removed, err := r.client.ZRem(ctx, liveSessionsExpiringKey, id).Result()
+		if err != nil {
+			return sessions, fmt.Errorf("failed to pop expired live session: %w", err)
+		}
+		if removed == 0 {
+			continue
+		}
+
+		data, err := r.client.GetDel(ctx, liveSessionKeyPrefix+id).Bytes()
+		if err == redis.Nil {
+			// Expired longer ago than the grace period.
+			continue
+		}
+		if err != nil {
+			return sessions, fmt.Errorf("failed to get expired live session: %w", err)
+		}
+
+		var s location.LiveSession
+		if err := json.Unmarshal(data, &s); err != nil {
+			continue
+		}
+		for _, key := range liveSessionIndexes(&s) {
+			r.client.ZRem(ctx, key, id)
+		}
+		sessions = append(sessions, &s)
+	}
+	return sessions, nil
+}
+
+func (r *LiveSessionRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*location.LiveSession, error) {
+	return r.list(ctx, liveSessionsByUserPrefix+userID.String())
+}
+
+func (r *LiveSessionRepository) ListByCircle(ctx context.Context, circleID uuid.UUID) ([]*location.LiveSession, error) {
+	return r.list(ctx, liveSessionsByCirclePrefix+circleID.String())
+}
+
+func (r *LiveSessionRepository) ListByConversation(ctx context.Context, conversationID uuid.UUID) ([]*location.LiveSession, error) {
+	return r.list(ctx, liveSessionsByConversationPrefix+conversationID.String())
+}
+
+// list returns the unexpired sessions in the index, soonest to expire first.
+func (r *LiveSessionRepository) list(ctx context.Context, indexKey string) ([]*location.LiveSession, error) {
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	r.client.ZRemRangeByScore(ctx, indexKey, "-inf", "("+now)
+
+	ids, err := r.client.ZRangeByScore(ctx, indexKey, &redis.ZRangeBy{Min: now, Max: "+inf"}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list live sessions: %w", err)
+	}
+	if len(ids) == 0 {
+		return []*location.LiveSession{}, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = liveSessionKeyPrefix + id
+	}
+	results, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get live sessions: %w", err)
+	}
+
+	sessions := make([]*location.LiveSession, 0, len(results))
+	for _, result := range results {
+		data, ok := result.(string)
+		if !ok {
+			continue
+		}
+
+		var s location.LiveSession
+		if err := json.Unmarshal([]byte(data), &s); err != nil {
+			continue
+		}
+		sessions = append(sessions, &s)
+	}
+	return sessions, nil
+}
+
+func liveSessionKey(id uuid.UUID) string {
+	return liveSessionKeyPrefix + id.String()
+}
+
+func liveSessionIndexes(s *location.LiveSession) []string {
+	keys := []string{liveSessionsByUserPrefix + s.UserID.String()}
+	if s.CircleID != nil {
+		keys = append(keys, liveSessionsByCirclePrefix+s.CircleID.String())
+	}
+	if s.ConversationID != nil {
+		keys = append(keys, liveSessionsByConversationPrefix+s.ConversationID.String())
+	}
+	return keys
+}
+
+var _ location.LiveSessionRepository = (*LiveSessionRepository)(nil)
@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/danielng/kin-core-svc/internal/domain/lock"
+	"github.com/danielng/kin-core-svc/pkg/uid"
+	"github.com/redis/go-redis/v9"
+)
+
+// releaseScript deletes the key only if it still holds our token, so a lease
+// that expired and was taken over is never released by its previous owner.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// extendScript resets the key's TTL only if it still holds our token.
+var extendScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+type Locker struct {
+	client *Client
+}
+
+func NewLocker(client *Client) *Locker {
+	return &Locker{client: client}
+}
+
+func (l *Locker) TryAcquire(ctx context.Context, key string, ttl time.Duration) (lock.Lock, error) {
+	token := uid.New().String()
+
+	ok, err := l.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !ok {
+		return nil, lock.ErrNotAcquired
+	}
+
+	return &redisLock{client: l.client, key: key, token: token}, nil
+}
+
+type redisLock struct {
+	client *Client
+	key    string
+	token  string
+}
+
+func (l *redisLock) Extend(ctx context.Context, ttl time.Duration) error {
+	ok, err := extendScript.Run(ctx, l.client, []string{l.key}, l.token, ttl.Milliseconds()).Int()
+	if err != nil {
+		return fmt.Errorf("failed to extend lock: %w", err)
+	}
+	if ok == 0 {
+		return lock.ErrLost
+	}
+	return nil
+}
+
+func (l *redisLock) Release(ctx context.Context) error {
+	if err := releaseScript.Run(ctx, l.client, []string{l.key}, l.token).Err(); err != nil {
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+	return nil
+}
+
+var _ lock.Locker = (*Locker)(nil)
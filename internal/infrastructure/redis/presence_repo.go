@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/danielng/kin-core-svc/internal/domain/presence"
@@ -12,12 +13,21 @@ import (
 )
 
 const (
-	presenceKeyPrefix = "presence:"
-	activityKeyPrefix = "activity:"
-	typingKeyPrefix   = "typing:"
-	pushTokenPrefix   = "push_token:"
+	presenceDeviceKeyPrefix  = "presence_device:"
+	presenceDevicesKeyPrefix = "presence_devices:"
+	lastSeenKeyPrefix        = "last_seen:"
+	activityKeyPrefix        = "activity:"
+	typingKeyPrefix          = "typing:"
+	pushTokenPrefix          = "push_token:"
+
+	// lastSeenTTL is how long a user's last seen time outlives their devices.
+	lastSeenTTL = 24 * time.Hour
 )
 
+// PresenceRepository stores each of a user's devices under its own key,
+// expiring on its own, and indexes them in a sorted set scored by expiry so
+// that expired devices can be dropped on read. A user's presence is
+// aggregated from their devices whenever it is read.
 type PresenceRepository struct {
 	client *Client
 }
@@ -26,105 +36,156 @@ func NewPresenceRepository(client *Client) *PresenceRepository {
 	return &PresenceRepository{client: client}
 }
 
-func (r *PresenceRepository) Set(ctx context.Context, p *presence.Presence, ttl time.Duration) error {
-	key := presenceKey(p.UserID)
-	data, err := json.Marshal(p)
-	if err != nil {
-		return fmt.Errorf("failed to marshal presence: %w", err)
-	}
-
-	if err := r.client.Set(ctx, key, data, ttl).Err(); err != nil {
-		return fmt.Errorf("failed to set presence: %w", err)
-	}
-
-	return nil
-}
-
 func (r *PresenceRepository) Get(ctx context.Context, userID uuid.UUID) (*presence.Presence, error) {
-	key := presenceKey(userID)
-	data, err := r.client.Get(ctx, key).Bytes()
-	if err == redis.Nil {
-		return nil, presence.ErrPresenceNotFound
-	}
+	presences, err := r.GetMultiple(ctx, []uuid.UUID{userID})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get presence: %w", err)
+		return nil, err
 	}
-
-	var p presence.Presence
-	if err := json.Unmarshal(data, &p); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal presence: %w", err)
+	if len(presences) == 0 {
+		return nil, presence.ErrPresenceNotFound
 	}
-
-	return &p, nil
+	return presences[0], nil
 }
 
+// GetMultiple leaves out users with neither a device nor a last seen time.
+// Each user's devices are listed most recently active first.
 func (r *PresenceRepository) GetMultiple(ctx context.Context, userIDs []uuid.UUID) ([]*presence.Presence, error) {
 	if len(userIDs) == 0 {
 		return []*presence.Presence{}, nil
 	}
 
-	keys := make([]string, len(userIDs))
-	for i, id := range userIDs {
-		keys[i] = presenceKey(id)
-	}
-
-	results, err := r.client.MGet(ctx, keys...).Result()
-	if err != nil {
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	deviceKeys := make([]*redis.StringSliceCmd, len(userIDs))
+	lastSeen := make([]*redis.StringCmd, len(userIDs))
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, id := range userIDs {
+			pipe.ZRemRangeByScore(ctx, presenceDevicesKey(id), "-inf", "("+now)
+			deviceKeys[i] = pipe.ZRevRangeByScore(ctx, presenceDevicesKey(id), &redis.ZRangeBy{Min: now, Max: "+inf"})
+			lastSeen[i] = pipe.Get(ctx, lastSeenKey(id))
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
 		return nil, fmt.Errorf("failed to get multiple presences: %w", err)
 	}
 
-	presences := make([]*presence.Presence, 0, len(results))
-	for _, result := range results {
-		if result == nil {
-			continue
+	var keys []string
+	for i, id := range userIDs {
+		for _, key := range deviceKeys[i].Val() {
+			keys = append(keys, presenceDeviceKey(id, key))
+		}
+	}
+	devices := make(map[string]*presence.Device, len(keys))
+	if len(keys) > 0 {
+		results, err := r.client.MGet(ctx, keys...).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get presence devices: %w", err)
+		}
+		for i, result := range results {
+			data, ok := result.(string)
+			if !ok {
+				continue
+			}
+
+			var d presence.Device
+			if err := json.Unmarshal([]byte(data), &d); err != nil {
+				continue
+			}
+			devices[keys[i]] = &d
 		}
+	}
 
-		data, ok := result.(string)
-		if !ok {
-			continue
+	presences := make([]*presence.Presence, 0, len(userIDs))
+	for i, id := range userIDs {
+		var userDevices []*presence.Device
+		for _, key := range deviceKeys[i].Val() {
+			if d, ok := devices[presenceDeviceKey(id, key)]; ok {
+				userDevices = append(userDevices, d)
+			}
 		}
 
-		var p presence.Presence
-		if err := json.Unmarshal([]byte(data), &p); err != nil {
+		seenAt, _ := time.Parse(time.RFC3339Nano, lastSeen[i].Val())
+		if len(userDevices) == 0 && seenAt.IsZero() {
 			continue
 		}
-		presences = append(presences, &p)
+		presences = append(presences, presence.Aggregate(id, userDevices, seenAt))
 	}
 
 	return presences, nil
 }
 
 func (r *PresenceRepository) Delete(ctx context.Context, userID uuid.UUID) error {
-	key := presenceKey(userID)
-	if err := r.client.Del(ctx, key).Err(); err != nil {
+	deviceKeys, err := r.client.ZRange(ctx, presenceDevicesKey(userID), 0, -1).Result()
+	if err != nil {
+		return fmt.Errorf("failed to delete presence: %w", err)
+	}
+
+	keys := []string{presenceDevicesKey(userID), lastSeenKey(userID)}
+	for _, key := range deviceKeys {
+		keys = append(keys, presenceDeviceKey(userID, key))
+	}
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
 		return fmt.Errorf("failed to delete presence: %w", err)
 	}
 	return nil
 }
 
+func (r *PresenceRepository) SetDevice(ctx context.Context, userID uuid.UUID, d *presence.Device, ttl time.Duration) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("failed to marshal presence device: %w", err)
+	}
+
+	indexKey := presenceDevicesKey(userID)
+	member := redis.Z{Score: float64(time.Now().Add(ttl).UnixNano()), Member: d.Key()}
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, presenceDeviceKey(userID, d.Key()), data, ttl)
+		pipe.ZAdd(ctx, indexKey, member)
+		// Outlive every device, however long the TTLs they were set with.
+		pipe.Expire(ctx, indexKey, max(ttl, lastSeenTTL))
+		pipe.Set(ctx, lastSeenKey(userID), d.LastSeenAt.Format(time.RFC3339Nano), lastSeenTTL)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set presence device: %w", err)
+	}
+	return nil
+}
+
 func (r *PresenceRepository) SetOnline(ctx context.Context, userID uuid.UUID, deviceType presence.DeviceType, deviceID *string, ttl time.Duration) error {
-	p := presence.NewPresence(userID)
-	p.SetOnline(deviceType, deviceID)
-	return r.Set(ctx, p, ttl)
+	return r.SetDevice(ctx, userID, presence.NewDevice(deviceType, deviceID), ttl)
 }
 
-func (r *PresenceRepository) SetOffline(ctx context.Context, userID uuid.UUID) error {
-	p, err := r.Get(ctx, userID)
+// SetOffline drops the device, leaving the user's others as they are.
+func (r *PresenceRepository) SetOffline(ctx context.Context, userID uuid.UUID, deviceType presence.DeviceType, deviceID *string) error {
+	key := presence.DeviceKey(deviceType, deviceID)
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, presenceDeviceKey(userID, key))
+		pipe.ZRem(ctx, presenceDevicesKey(userID), key)
+		pipe.Set(ctx, lastSeenKey(userID), time.Now().Format(time.RFC3339Nano), lastSeenTTL)
+		return nil
+	})
 	if err != nil {
-		p = presence.NewPresence(userID)
+		return fmt.Errorf("failed to set device offline: %w", err)
 	}
-	p.SetOffline()
-
-	return r.Set(ctx, p, 24*time.Hour)
+	return nil
 }
 
-func (r *PresenceRepository) Heartbeat(ctx context.Context, userID uuid.UUID, ttl time.Duration) error {
-	p, err := r.Get(ctx, userID)
+func (r *PresenceRepository) Heartbeat(ctx context.Context, userID uuid.UUID, deviceType presence.DeviceType, deviceID *string, ttl time.Duration) error {
+	data, err := r.client.Get(ctx, presenceDeviceKey(userID, presence.DeviceKey(deviceType, deviceID))).Bytes()
+	if err == redis.Nil {
+		return presence.ErrPresenceNotFound
+	}
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get presence device: %w", err)
+	}
+
+	var d presence.Device
+	if err := json.Unmarshal(data, &d); err != nil {
+		return fmt.Errorf("failed to unmarshal presence device: %w", err)
 	}
-	p.Heartbeat()
-	return r.Set(ctx, p, ttl)
+	d.Heartbeat()
+	return r.SetDevice(ctx, userID, &d, ttl)
 }
 
 func (r *PresenceRepository) SetActivity(ctx context.Context, a *presence.Activity) error {
@@ -253,8 +314,16 @@ func (r *PresenceRepository) DeletePushToken(ctx context.Context, userID uuid.UU
 	return nil
 }
 
-func presenceKey(userID uuid.UUID) string {
-	return presenceKeyPrefix + userID.String()
+func presenceDeviceKey(userID uuid.UUID, deviceKey string) string {
+	return presenceDeviceKeyPrefix + userID.String() + ":" + deviceKey
+}
+
+func presenceDevicesKey(userID uuid.UUID) string {
+	return presenceDevicesKeyPrefix + userID.String()
+}
+
+func lastSeenKey(userID uuid.UUID) string {
+	return lastSeenKeyPrefix + userID.String()
 }
 
 func activityKey(userID uuid.UUID) string {
@@ -0,0 +1,305 @@
+package converter
+
+import (
+	"fmt"
+
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/internal/domain/availability"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AvailabilityToProto(a *availability.Availability) *kinv1.Availability {
+	if a == nil {
+		return nil
+	}
+
+	pb := &kinv1.Availability{
+		UserId:        a.UserID.String(),
+		Status:        AvailabilityStatusToProto(a.Status),
+		StatusMessage: a.StatusMessage,
+		AutoStatus:    a.AutoStatus,
+		UpdatedAt:     timestamppb.New(a.UpdatedAt),
+	}
+	if a.ManualUntil != nil {
+		pb.ManualUntil = timestamppb.New(*a.ManualUntil)
+	}
+
+	return pb
+}
+
+func ResolutionToProto(r *availability.Resolution) *kinv1.EffectiveAvailability {
+	if r == nil {
+		return nil
+	}
+
+	pb := &kinv1.EffectiveAvailability{
+		Status:        AvailabilityStatusToProto(r.Status),
+		Source:        AvailabilitySourceToProto(r.Source),
+		StatusMessage: r.Message,
+	}
+	if r.SourceID != nil {
+		sourceID := r.SourceID.String()
+		pb.SourceId = &sourceID
+	}
+	if r.NextChange != nil {
+		pb.NextChange = timestamppb.New(*r.NextChange)
+	}
+
+	return pb
+}
+
+func WindowToProto(w *availability.Window) *kinv1.AvailabilityWindow {
+	if w == nil {
+		return nil
+	}
+
+	return &kinv1.AvailabilityWindow{
+		Id:        w.ID.String(),
+		UserId:    w.UserID.String(),
+		Name:      w.Name,
+		Weekday:   WeekdayToProto(w.Weekday),
+		StartTime: w.StartTime,
+		EndTime:   w.EndTime,
+		Status:    AvailabilityStatusToProto(w.Status),
+		IsActive:  w.IsActive,
+		CreatedAt: timestamppb.New(w.CreatedAt),
+		UpdatedAt: timestamppb.New(w.UpdatedAt),
+	}
+}
+
+func WindowsToProto(windows []*availability.Window) []*kinv1.AvailabilityWindow {
+	result := make([]*kinv1.AvailabilityWindow, len(windows))
+	for i, w := range windows {
+		result[i] = WindowToProto(w)
+	}
+	return result
+}
+
+func AutoRuleToProto(r *availability.AutoRule) *kinv1.AutoRule {
+	if r == nil {
+		return nil
+	}
+
+	return &kinv1.AutoRule{
+		Id:           r.ID.String(),
+		UserId:       r.UserID.String(),
+		Name:         r.Name,
+		Condition:    ConditionToProto(r.Condition),
+		TargetStatus: AvailabilityStatusToProto(r.TargetStatus),
+		Priority:     int32(r.Priority),
+		IsActive:     r.IsActive,
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+		UpdatedAt:    timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func AutoRulesToProto(rules []*availability.AutoRule) []*kinv1.AutoRule {
+	result := make([]*kinv1.AutoRule, len(rules))
+	for i, r := range rules {
+		result[i] = AutoRuleToProto(r)
+	}
+	return result
+}
+
+func ConditionToProto(c availability.Condition) *kinv1.AutoRuleCondition {
+	pb := &kinv1.AutoRuleCondition{
+		Type:      ConditionTypeToProto(c.Type),
+		StartTime: c.StartTime,
+		EndTime:   c.EndTime,
+		Weekdays:  make([]kinv1.Weekday, len(c.Weekdays)),
+	}
+	for i, d := range c.Weekdays {
+		pb.Weekdays[i] = WeekdayToProto(d)
+	}
+	if c.PlaceID != nil {
+		placeID := c.PlaceID.String()
+		pb.PlaceId = &placeID
+	}
+	if c.CalendarID != nil {
+		calendarID := c.CalendarID.String()
+		pb.CalendarId = &calendarID
+	}
+
+	return pb
+}
+
+func ConditionFromProto(pb *kinv1.AutoRuleCondition) (availability.Condition, error) {
+	if pb == nil {
+		return availability.Condition{}, availability.ErrInvalidCondition
+	}
+
+	c := availability.Condition{
+		Type:      ConditionTypeFromProto(pb.Type),
+		StartTime: pb.StartTime,
+		EndTime:   pb.EndTime,
+	}
+	for _, d := range pb.Weekdays {
+		c.Weekdays = append(c.Weekdays, WeekdayFromProto(d))
+	}
+
+	if pb.PlaceId != nil {
+		placeID, err := uuid.Parse(*pb.PlaceId)
+		if err != nil {
+			return availability.Condition{}, fmt.Errorf("invalid UUID for parameter 'condition.place_id': %w", err)
+		}
+		c.PlaceID = &placeID
+	}
+
+	if pb.CalendarId != nil {
+		calendarID, err := uuid.Parse(*pb.CalendarId)
+		if err != nil {
+			return availability.Condition{}, fmt.Errorf("invalid UUID for parameter 'condition.calendar_id': %w", err)
+		}
+		c.CalendarID = &calendarID
+	}
+
+	return c, nil
+}
+
+func CalendarToProto(c *availability.Calendar) *kinv1.Calendar {
+	if c == nil {
+		return nil
+	}
+
+	pb := &kinv1.Calendar{
+		Id:         c.ID.String(),
+		UserId:     c.UserID.String(),
+		Name:       c.Name,
+		Url:        c.URL,
+		EventCount: int32(len(c.Events)),
+		IsActive:   c.IsActive,
+		LastError:  c.LastError,
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+		UpdatedAt:  timestamppb.New(c.UpdatedAt),
+	}
+	if c.LastSyncedAt != nil {
+		pb.LastSyncedAt = timestamppb.New(*c.LastSyncedAt)
+	}
+
+	return pb
+}
+
+func CalendarsToProto(calendars []*availability.Calendar) []*kinv1.Calendar {
+	result := make([]*kinv1.Calendar, len(calendars))
+	for i, c := range calendars {
+		result[i] = CalendarToProto(c)
+	}
+	return result
+}
+
+func BestTimesToProto(b *availability.BestTimes) *kinv1.FindBestTimesResponse {
+	pb := &kinv1.FindBestTimesResponse{
+		Slots:           make([]*kinv1.BestTimeSlot, len(b.Slots)),
+		Participants:    make([]*kinv1.BestTimeParticipant, len(b.Participants)),
+		ExcludedUserIds: make([]string, len(b.Excluded)),
+	}
+	for i, slot := range b.Slots {
+		pb.Slots[i] = &kinv1.BestTimeSlot{
+			Start: timestamppb.New(slot.Start),
+			End:   timestamppb.New(slot.End),
+			Score: slot.Score,
+		}
+	}
+	for i, p := range b.Participants {
+		pb.Participants[i] = &kinv1.BestTimeParticipant{
+			UserId:   p.UserID.String(),
+			Timezone: p.Timezone,
+		}
+	}
+	for i, id := range b.Excluded {
+		pb.ExcludedUserIds[i] = id.String()
+	}
+
+	return pb
+}
+
+func AvailabilityStatusToProto(s availability.Status) kinv1.AvailabilityStatus {
+	switch s {
+	case availability.StatusFree:
+		return kinv1.AvailabilityStatus_AVAILABILITY_STATUS_FREE
+	case availability.StatusBusy:
+		return kinv1.AvailabilityStatus_AVAILABILITY_STATUS_BUSY
+	case availability.StatusDoNotDisturb:
+		return kinv1.AvailabilityStatus_AVAILABILITY_STATUS_DO_NOT_DISTURB
+	case availability.StatusSleeping:
+		return kinv1.AvailabilityStatus_AVAILABILITY_STATUS_SLEEPING
+	case availability.StatusAway:
+		return kinv1.AvailabilityStatus_AVAILABILITY_STATUS_AWAY
+	default:
+		return kinv1.AvailabilityStatus_AVAILABILITY_STATUS_UNSPECIFIED
+	}
+}
+
+// AvailabilityStatusFromProto maps UNSPECIFIED to an invalid status so that
+// domain validation rejects it.
+func AvailabilityStatusFromProto(s kinv1.AvailabilityStatus) availability.Status {
+	switch s {
+	case kinv1.AvailabilityStatus_AVAILABILITY_STATUS_FREE:
+		return availability.StatusFree
+	case kinv1.AvailabilityStatus_AVAILABILITY_STATUS_BUSY:
+		return availability.StatusBusy
+	case kinv1.AvailabilityStatus_AVAILABILITY_STATUS_DO_NOT_DISTURB:
+		return availability.StatusDoNotDisturb
+	case kinv1.AvailabilityStatus_AVAILABILITY_STATUS_SLEEPING:
+		return availability.StatusSleeping
+	case kinv1.AvailabilityStatus_AVAILABILITY_STATUS_AWAY:
+		return availability.StatusAway
+	default:
+		return ""
+	}
+}
+
+func AvailabilitySourceToProto(s availability.Source) kinv1.AvailabilitySource {
+	switch s {
+	case availability.SourceManual:
+		return kinv1.AvailabilitySource_AVAILABILITY_SOURCE_MANUAL
+	case availability.SourceRule:
+		return kinv1.AvailabilitySource_AVAILABILITY_SOURCE_RULE
+	case availability.SourceWindow:
+		return kinv1.AvailabilitySource_AVAILABILITY_SOURCE_WINDOW
+	case availability.SourceDefault:
+		return kinv1.AvailabilitySource_AVAILABILITY_SOURCE_DEFAULT
+	default:
+		return kinv1.AvailabilitySource_AVAILABILITY_SOURCE_UNSPECIFIED
+	}
+}
+
+// The proto enum reserves zero for UNSPECIFIED, so weekdays are shifted by
+// one relative to time.Weekday.
+func WeekdayToProto(d availability.Weekday) kinv1.Weekday {
+	if !availability.IsValidWeekday(d) {
+		return kinv1.Weekday_WEEKDAY_UNSPECIFIED
+	}
+	return kinv1.Weekday(d + 1)
+}
+
+func WeekdayFromProto(d kinv1.Weekday) availability.Weekday {
+	return availability.Weekday(d - 1)
+}
+
+func ConditionTypeToProto(t availability.ConditionType) kinv1.AutoRuleConditionType {
+	switch t {
+	case availability.ConditionTypeTimeRange:
+		return kinv1.AutoRuleConditionType_AUTO_RULE_CONDITION_TYPE_TIME_RANGE
+	case availability.ConditionTypeLocation:
+		return kinv1.AutoRuleConditionType_AUTO_RULE_CONDITION_TYPE_LOCATION
+	case availability.ConditionTypeCalendar:
+		return kinv1.AutoRuleConditionType_AUTO_RULE_CONDITION_TYPE_CALENDAR
+	default:
+		return kinv1.AutoRuleConditionType_AUTO_RULE_CONDITION_TYPE_UNSPECIFIED
+	}
+}
+
+func ConditionTypeFromProto(t kinv1.AutoRuleConditionType) availability.ConditionType {
+	switch t {
+	case kinv1.AutoRuleConditionType_AUTO_RULE_CONDITION_TYPE_TIME_RANGE:
+		return availability.ConditionTypeTimeRange
+	case kinv1.AutoRuleConditionType_AUTO_RULE_CONDITION_TYPE_LOCATION:
+		return availability.ConditionTypeLocation
+	case kinv1.AutoRuleConditionType_AUTO_RULE_CONDITION_TYPE_CALENDAR:
+		return availability.ConditionTypeCalendar
+	default:
+		return ""
+	}
+}
@@ -178,36 +178,6 @@ func SharingPreferenceToProto(sp *circle.SharingPreference) *kinv1.SharingPrefer
 	}
 }
 
-func LocationPrecisionToProto(p circle.LocationPrecision) kinv1.LocationPrecision {
-	switch p {
-	case circle.LocationPrecisionCountry:
-		return kinv1.LocationPrecision_LOCATION_PRECISION_COUNTRY
-	case circle.LocationPrecisionCity:
-		return kinv1.LocationPrecision_LOCATION_PRECISION_CITY
-	case circle.LocationPrecisionNeighborhood:
-		return kinv1.LocationPrecision_LOCATION_PRECISION_NEIGHBORHOOD
-	case circle.LocationPrecisionExact:
-		return kinv1.LocationPrecision_LOCATION_PRECISION_EXACT
-	default:
-		return kinv1.LocationPrecision_LOCATION_PRECISION_UNSPECIFIED
-	}
-}
-
-func LocationPrecisionFromProto(p kinv1.LocationPrecision) circle.LocationPrecision {
-	switch p {
-	case kinv1.LocationPrecision_LOCATION_PRECISION_COUNTRY:
-		return circle.LocationPrecisionCountry
-	case kinv1.LocationPrecision_LOCATION_PRECISION_CITY:
-		return circle.LocationPrecisionCity
-	case kinv1.LocationPrecision_LOCATION_PRECISION_NEIGHBORHOOD:
-		return circle.LocationPrecisionNeighborhood
-	case kinv1.LocationPrecision_LOCATION_PRECISION_EXACT:
-		return circle.LocationPrecisionExact
-	default:
-		return circle.LocationPrecisionCity
-	}
-}
-
 func PrivacyLevelToProtoFromCircle(level user.PrivacyLevel) kinv1.PrivacyLevel {
 	return PrivacyLevelToProto(level)
 }
@@ -0,0 +1,69 @@
+package converter
+
+import (
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/internal/domain/contact"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ContactToProto(c *contact.Contact) *kinv1.Contact {
+	if c == nil {
+		return nil
+	}
+
+	return &kinv1.Contact{
+		Id:         c.ID.String(),
+		UserId:     c.UserID.String(),
+		ContactId:  c.ContactID.String(),
+		Nickname:   c.Nickname,
+		IsFavorite: c.IsFavorite,
+		IsBlocked:  c.IsBlocked,
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+		UpdatedAt:  timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func ContactsToProto(contacts []*contact.Contact) []*kinv1.Contact {
+	result := make([]*kinv1.Contact, len(contacts))
+	for i, c := range contacts {
+		result[i] = ContactToProto(c)
+	}
+	return result
+}
+
+func ContactRequestToProto(r *contact.ContactRequest) *kinv1.ContactRequest {
+	if r == nil {
+		return nil
+	}
+
+	return &kinv1.ContactRequest{
+		Id:         r.ID.String(),
+		FromUserId: r.FromUserID.String(),
+		ToUserId:   r.ToUserID.String(),
+		Message:    r.Message,
+		Status:     ContactRequestStatusToProto(r.Status),
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		UpdatedAt:  timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func ContactRequestsToProto(requests []*contact.ContactRequest) []*kinv1.ContactRequest {
+	result := make([]*kinv1.ContactRequest, len(requests))
+	for i, r := range requests {
+		result[i] = ContactRequestToProto(r)
+	}
+	return result
+}
+
+func ContactRequestStatusToProto(s contact.ContactRequestStatus) kinv1.ContactRequestStatus {
+	switch s {
+	case contact.ContactRequestStatusPending:
+		return kinv1.ContactRequestStatus_CONTACT_REQUEST_STATUS_PENDING
+	case contact.ContactRequestStatusAccepted:
+		return kinv1.ContactRequestStatus_CONTACT_REQUEST_STATUS_ACCEPTED
+	case contact.ContactRequestStatusRejected:
+		return kinv1.ContactRequestStatus_CONTACT_REQUEST_STATUS_REJECTED
+	default:
+		return kinv1.ContactRequestStatus_CONTACT_REQUEST_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,97 @@
+package converter
+
+import (
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	appconversation "github.com/danielng/kin-core-svc/internal/application/conversation"
+	"github.com/danielng/kin-core-svc/internal/domain/conversation"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ConversationToProto(c *conversation.Conversation) *kinv1.Conversation {
+	if c == nil {
+		return nil
+	}
+
+	pb := &kinv1.Conversation{
+		Id:        c.ID.String(),
+		Type:      ConversationTypeToProto(c.Type),
+		Name:      c.Name,
+		Avatar:    c.Avatar,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+
+	if c.CircleID != nil {
+		circleID := c.CircleID.String()
+		pb.CircleId = &circleID
+	}
+	if c.LastMessageAt != nil {
+		pb.LastMessageAt = timestamppb.New(*c.LastMessageAt)
+	}
+
+	return pb
+}
+
+func ConversationTypeToProto(t conversation.ConversationType) kinv1.ConversationType {
+	switch t {
+	case conversation.ConversationTypeDirect:
+		return kinv1.ConversationType_CONVERSATION_TYPE_DIRECT
+	case conversation.ConversationTypeCircle:
+		return kinv1.ConversationType_CONVERSATION_TYPE_CIRCLE
+	default:
+		return kinv1.ConversationType_CONVERSATION_TYPE_UNSPECIFIED
+	}
+}
+
+func ParticipantToProto(p *conversation.Participant) *kinv1.Participant {
+	if p == nil {
+		return nil
+	}
+
+	pb := &kinv1.Participant{
+		Id:             p.ID.String(),
+		ConversationId: p.ConversationID.String(),
+		UserId:         p.UserID.String(),
+		IsMuted:        p.IsMuted,
+		IsArchived:     p.IsArchived,
+		JoinedAt:       timestamppb.New(p.JoinedAt),
+	}
+
+	if p.LastReadAt != nil {
+		pb.LastReadAt = timestamppb.New(*p.LastReadAt)
+	}
+	if p.LeftAt != nil {
+		pb.LeftAt = timestamppb.New(*p.LeftAt)
+	}
+
+	return pb
+}
+
+func ParticipantsToProto(participants []*conversation.Participant) []*kinv1.Participant {
+	result := make([]*kinv1.Participant, len(participants))
+	for i, p := range participants {
+		result[i] = ParticipantToProto(p)
+	}
+	return result
+}
+
+func ConversationSummaryToProto(s *appconversation.Summary) *kinv1.ConversationSummary {
+	if s == nil {
+		return nil
+	}
+
+	return &kinv1.ConversationSummary{
+		Conversation: ConversationToProto(s.Conversation),
+		Participant:  ParticipantToProto(s.Participant),
+		LastMessage:  MessageToProto(s.LastMessage),
+		UnreadCount:  s.UnreadCount,
+	}
+}
+
+func ConversationSummariesToProto(summaries []*appconversation.Summary) []*kinv1.ConversationSummary {
+	result := make([]*kinv1.ConversationSummary, len(summaries))
+	for i, s := range summaries {
+		result[i] = ConversationSummaryToProto(s)
+	}
+	return result
+}
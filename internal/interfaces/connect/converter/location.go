@@ -0,0 +1,311 @@
+package converter
+
+import (
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	applocation "github.com/danielng/kin-core-svc/internal/application/location"
+	"github.com/danielng/kin-core-svc/internal/domain/location"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LocationToProto converts the user's own location, which is always exact.
+func LocationToProto(l *location.Location) *kinv1.Location {
+	return SharedLocationToProto(&location.SharedLocation{Location: l, Precision: location.PrecisionExact})
+}
+
+func SharedLocationToProto(s *location.SharedLocation) *kinv1.Location {
+	if s == nil || s.Location == nil {
+		return nil
+	}
+
+	l := s.Location
+	pb := &kinv1.Location{
+		UserId:       l.UserID.String(),
+		Latitude:     l.Latitude,
+		Longitude:    l.Longitude,
+		Accuracy:     l.Accuracy,
+		Altitude:     l.Altitude,
+		Speed:        l.Speed,
+		Heading:      l.Heading,
+		Country:      l.Country,
+		City:         l.City,
+		Neighborhood: l.Neighborhood,
+		Address:      l.Address,
+		IsMoving:     l.IsMoving,
+		Precision:    LocationPrecisionToProto(s.Precision),
+		UpdatedAt:    timestamppb.New(l.UpdatedAt),
+	}
+	if l.PlaceID != nil {
+		placeID := l.PlaceID.String()
+		pb.PlaceId = &placeID
+	}
+
+	return pb
+}
+
+func SharedLocationsToProto(locations []*location.SharedLocation) []*kinv1.Location {
+	result := make([]*kinv1.Location, len(locations))
+	for i, l := range locations {
+		result[i] = SharedLocationToProto(l)
+	}
+	return result
+}
+
+func NearbyToProto(nearby []*location.Nearby) []*kinv1.NearbyMember {
+	result := make([]*kinv1.NearbyMember, len(nearby))
+	for i, n := range nearby {
+		result[i] = &kinv1.NearbyMember{
+			Location: SharedLocationToProto(n.Location),
+			Distance: n.Distance,
+		}
+	}
+	return result
+}
+
+func LiveSessionToProto(s *location.LiveSession) *kinv1.LiveSession {
+	if s == nil {
+		return nil
+	}
+
+	pb := &kinv1.LiveSession{
+		Id:        s.ID.String(),
+		UserId:    s.UserID.String(),
+		ExpiresAt: timestamppb.New(s.ExpiresAt),
+		CreatedAt: timestamppb.New(s.CreatedAt),
+	}
+	if s.CircleID != nil {
+		circleID := s.CircleID.String()
+		pb.CircleId = &circleID
+	}
+	if s.ConversationID != nil {
+		conversationID := s.ConversationID.String()
+		pb.ConversationId = &conversationID
+	}
+
+	return pb
+}
+
+func LiveLocationsToProto(live []*location.LiveLocation) []*kinv1.LiveSession {
+	result := make([]*kinv1.LiveSession, len(live))
+	for i, l := range live {
+		result[i] = LiveSessionToProto(l.Session)
+		result[i].Location = SharedLocationToProto(l.Location)
+	}
+	return result
+}
+
+func PointFromProto(pb *kinv1.LocationPoint) applocation.Point {
+	p := applocation.Point{
+		Latitude:  pb.Latitude,
+		Longitude: pb.Longitude,
+		Accuracy:  pb.Accuracy,
+		Altitude:  pb.Altitude,
+		Speed:     pb.Speed,
+		Heading:   pb.Heading,
+	}
+	if pb.RecordedAt != nil {
+		p.RecordedAt = pb.RecordedAt.AsTime()
+	}
+	return p
+}
+
+func TrailToProto(t *location.Trail) *kinv1.GetLocationHistoryResponse {
+	pb := &kinv1.GetLocationHistoryResponse{
+		Points:    make([]*kinv1.HistoryPoint, len(t.Points)),
+		Segments:  make([]*kinv1.TrailSegment, len(t.Segments)),
+		Truncated: t.Truncated,
+	}
+	for i, p := range t.Points {
+		pb.Points[i] = &kinv1.HistoryPoint{
+			Latitude:   p.Latitude,
+			Longitude:  p.Longitude,
+			Accuracy:   p.Accuracy,
+			RecordedAt: timestamppb.New(p.CreatedAt),
+		}
+		if p.PlaceID != nil {
+			placeID := p.PlaceID.String()
+			pb.Points[i].PlaceId = &placeID
+		}
+	}
+	for i, s := range t.Segments {
+		pb.Segments[i] = &kinv1.TrailSegment{
+			Type:       SegmentTypeToProto(s.Type),
+			StartedAt:  timestamppb.New(s.StartedAt),
+			EndedAt:    timestamppb.New(s.EndedAt),
+			Latitude:   s.Latitude,
+			Longitude:  s.Longitude,
+			Distance:   s.Distance,
+			PointCount: int32(s.PointCount),
+		}
+		if s.PlaceID != nil {
+			placeID := s.PlaceID.String()
+			pb.Segments[i].PlaceId = &placeID
+		}
+	}
+	return pb
+}
+
+func SegmentTypeToProto(t location.SegmentType) kinv1.TrailSegmentType {
+	switch t {
+	case location.SegmentTypeStay:
+		return kinv1.TrailSegmentType_TRAIL_SEGMENT_TYPE_STAY
+	case location.SegmentTypeTrip:
+		return kinv1.TrailSegmentType_TRAIL_SEGMENT_TYPE_TRIP
+	default:
+		return kinv1.TrailSegmentType_TRAIL_SEGMENT_TYPE_UNSPECIFIED
+	}
+}
+
+func PlaceToProto(p *location.Place) *kinv1.Place {
+	if p == nil {
+		return nil
+	}
+
+	return &kinv1.Place{
+		Id:        p.ID.String(),
+		UserId:    p.UserID.String(),
+		Name:      p.Name,
+		Type:      PlaceTypeToProto(p.Type),
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		Radius:    p.Radius,
+		Address:   p.Address,
+		Icon:      p.Icon,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func PlacesToProto(places []*location.Place) []*kinv1.Place {
+	result := make([]*kinv1.Place, len(places))
+	for i, p := range places {
+		result[i] = PlaceToProto(p)
+	}
+	return result
+}
+
+func CheckInToProto(c *location.CheckIn) *kinv1.CheckIn {
+	if c == nil {
+		return nil
+	}
+
+	return &kinv1.CheckIn{
+		Id:        c.ID.String(),
+		UserId:    c.UserID.String(),
+		PlaceId:   c.PlaceID.String(),
+		Type:      CheckInTypeToProto(c.Type),
+		Latitude:  c.Latitude,
+		Longitude: c.Longitude,
+		Note:      c.Note,
+		AutoCheck: c.AutoCheck,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+}
+
+func CheckInsToProto(checkIns []*location.CheckIn) []*kinv1.CheckIn {
+	result := make([]*kinv1.CheckIn, len(checkIns))
+	for i, c := range checkIns {
+		result[i] = CheckInToProto(c)
+	}
+	return result
+}
+
+func PlaceTypeToProto(t location.PlaceType) kinv1.PlaceType {
+	switch t {
+	case location.PlaceTypeHome:
+		return kinv1.PlaceType_PLACE_TYPE_HOME
+	case location.PlaceTypeWork:
+		return kinv1.PlaceType_PLACE_TYPE_WORK
+	case location.PlaceTypeSchool:
+		return kinv1.PlaceType_PLACE_TYPE_SCHOOL
+	case location.PlaceTypeGym:
+		return kinv1.PlaceType_PLACE_TYPE_GYM
+	case location.PlaceTypeOther:
+		return kinv1.PlaceType_PLACE_TYPE_OTHER
+	default:
+		return kinv1.PlaceType_PLACE_TYPE_UNSPECIFIED
+	}
+}
+
+func PlaceTypeFromProto(t kinv1.PlaceType) location.PlaceType {
+	switch t {
+	case kinv1.PlaceType_PLACE_TYPE_HOME:
+		return location.PlaceTypeHome
+	case kinv1.PlaceType_PLACE_TYPE_WORK:
+		return location.PlaceTypeWork
+	case kinv1.PlaceType_PLACE_TYPE_SCHOOL:
+		return location.PlaceTypeSchool
+	case kinv1.PlaceType_PLACE_TYPE_GYM:
+		return location.PlaceTypeGym
+	case kinv1.PlaceType_PLACE_TYPE_OTHER:
+		return location.PlaceTypeOther
+	default:
+		return ""
+	}
+}
+
+func CheckInTypeToProto(t location.CheckInType) kinv1.CheckInType {
+	switch t {
+	case location.CheckInTypeArrival:
+		return kinv1.CheckInType_CHECK_IN_TYPE_ARRIVAL
+	case location.CheckInTypeDeparture:
+		return kinv1.CheckInType_CHECK_IN_TYPE_DEPARTURE
+	default:
+		return kinv1.CheckInType_CHECK_IN_TYPE_UNSPECIFIED
+	}
+}
+
+func LocationRetentionToProto(r location.Retention) kinv1.LocationRetention {
+	switch r {
+	case location.RetentionDay:
+		return kinv1.LocationRetention_LOCATION_RETENTION_DAY
+	case location.RetentionWeek:
+		return kinv1.LocationRetention_LOCATION_RETENTION_WEEK
+	case location.RetentionMonth:
+		return kinv1.LocationRetention_LOCATION_RETENTION_MONTH
+	default:
+		return kinv1.LocationRetention_LOCATION_RETENTION_UNSPECIFIED
+	}
+}
+
+func LocationRetentionFromProto(r kinv1.LocationRetention) location.Retention {
+	switch r {
+	case kinv1.LocationRetention_LOCATION_RETENTION_DAY:
+		return location.RetentionDay
+	case kinv1.LocationRetention_LOCATION_RETENTION_WEEK:
+		return location.RetentionWeek
+	case kinv1.LocationRetention_LOCATION_RETENTION_MONTH:
+		return location.RetentionMonth
+	default:
+		return ""
+	}
+}
+
+func LocationPrecisionToProto(p location.Precision) kinv1.LocationPrecision {
+	switch p {
+	case location.PrecisionCountry:
+		return kinv1.LocationPrecision_LOCATION_PRECISION_COUNTRY
+	case location.PrecisionCity:
+		return kinv1.LocationPrecision_LOCATION_PRECISION_CITY
+	case location.PrecisionNeighborhood:
+		return kinv1.LocationPrecision_LOCATION_PRECISION_NEIGHBORHOOD
+	case location.PrecisionExact:
+		return kinv1.LocationPrecision_LOCATION_PRECISION_EXACT
+	default:
+		return kinv1.LocationPrecision_LOCATION_PRECISION_UNSPECIFIED
+	}
+}
+
+func LocationPrecisionFromProto(p kinv1.LocationPrecision) location.Precision {
+	switch p {
+	case kinv1.LocationPrecision_LOCATION_PRECISION_COUNTRY:
+		return location.PrecisionCountry
+	case kinv1.LocationPrecision_LOCATION_PRECISION_CITY:
+		return location.PrecisionCity
+	case kinv1.LocationPrecision_LOCATION_PRECISION_NEIGHBORHOOD:
+		return location.PrecisionNeighborhood
+	case kinv1.LocationPrecision_LOCATION_PRECISION_EXACT:
+		return location.PrecisionExact
+	default:
+		return location.PrecisionCity
+	}
+}
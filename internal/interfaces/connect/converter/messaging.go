@@ -0,0 +1,227 @@
+package converter
+
+import (
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MessageToProto(m *messaging.Message) *kinv1.Message {
+	if m == nil {
+		return nil
+	}
+
+	pb := &kinv1.Message{
+		Id:             m.ID.String(),
+		ConversationId: m.ConversationID.String(),
+		SenderId:       m.SenderID.String(),
+		IsEdited:       m.IsEdited,
+		IsDeleted:      m.IsDeleted(),
+		CreatedAt:      timestamppb.New(m.CreatedAt),
+	}
+
+	if !m.IsDeleted() {
+		pb.Content = ContentToProto(m.Content)
+	}
+	if m.ReplyToID != nil {
+		replyToID := m.ReplyToID.String()
+		pb.ReplyToId = &replyToID
+	}
+	if m.EditedAt != nil {
+		pb.EditedAt = timestamppb.New(*m.EditedAt)
+	}
+
+	return pb
+}
+
+func MessagesToProto(messages []*messaging.Message) []*kinv1.Message {
+	result := make([]*kinv1.Message, len(messages))
+	for i, m := range messages {
+		result[i] = MessageToProto(m)
+	}
+	return result
+}
+
+func ContentToProto(c messaging.Content) *kinv1.MessageContent {
+	pb := &kinv1.MessageContent{
+		Type:     ContentTypeToProto(c.Type),
+		Text:     c.Text,
+		MediaUrl: c.MediaURL,
+	}
+
+	if c.MediaID != nil {
+		mediaID := c.MediaID.String()
+		pb.MediaId = &mediaID
+	}
+	if c.Metadata != nil {
+		pb.Metadata = MetadataToProto(c.Metadata)
+	}
+
+	return pb
+}
+
+func ContentFromProto(pb *kinv1.MessageContent) (messaging.Content, error) {
+	if pb == nil {
+		return messaging.Content{}, messaging.ErrEmptyMessage
+	}
+
+	c := messaging.Content{
+		Type:     ContentTypeFromProto(pb.Type),
+		Text:     pb.Text,
+		MediaURL: pb.MediaUrl,
+	}
+
+	if pb.MediaId != nil {
+		mediaID, err := uuid.Parse(*pb.MediaId)
+		if err != nil {
+			return messaging.Content{}, err
+		}
+		c.MediaID = &mediaID
+	}
+	if pb.Metadata != nil {
+		c.Metadata = MetadataFromProto(pb.Metadata)
+	}
+
+	return c, nil
+}
+
+func MetadataToProto(m *messaging.Metadata) *kinv1.ContentMetadata {
+	if m == nil {
+		return nil
+	}
+
+	return &kinv1.ContentMetadata{
+		FileName:    m.FileName,
+		FileSize:    m.FileSize,
+		MimeType:    m.MimeType,
+		Width:       intPtrToInt32Ptr(m.Width),
+		Height:      intPtrToInt32Ptr(m.Height),
+		Duration:    intPtrToInt32Ptr(m.Duration),
+		Thumbnail:   m.Thumbnail,
+		Latitude:    m.Latitude,
+		Longitude:   m.Longitude,
+		PlaceName:   m.PlaceName,
+		Address:     m.Address,
+		StickerId:   m.StickerID,
+		StickerPack: m.StickerPack,
+	}
+}
+
+func MetadataFromProto(pb *kinv1.ContentMetadata) *messaging.Metadata {
+	if pb == nil {
+		return nil
+	}
+
+	return &messaging.Metadata{
+		FileName:    pb.FileName,
+		FileSize:    pb.FileSize,
+		MimeType:    pb.MimeType,
+		Width:       int32PtrToIntPtr(pb.Width),
+		Height:      int32PtrToIntPtr(pb.Height),
+		Duration:    int32PtrToIntPtr(pb.Duration),
+		Thumbnail:   pb.Thumbnail,
+		Latitude:    pb.Latitude,
+		Longitude:   pb.Longitude,
+		PlaceName:   pb.PlaceName,
+		Address:     pb.Address,
+		StickerID:   pb.StickerId,
+		StickerPack: pb.StickerPack,
+	}
+}
+
+func ContentTypeToProto(ct messaging.ContentType) kinv1.ContentType {
+	switch ct {
+	case messaging.ContentTypeText:
+		return kinv1.ContentType_CONTENT_TYPE_TEXT
+	case messaging.ContentTypeImage:
+		return kinv1.ContentType_CONTENT_TYPE_IMAGE
+	case messaging.ContentTypeVideo:
+		return kinv1.ContentType_CONTENT_TYPE_VIDEO
+	case messaging.ContentTypeAudio:
+		return kinv1.ContentType_CONTENT_TYPE_AUDIO
+	case messaging.ContentTypeFile:
+		return kinv1.ContentType_CONTENT_TYPE_FILE
+	case messaging.ContentTypeLocation:
+		return kinv1.ContentType_CONTENT_TYPE_LOCATION
+	case messaging.ContentTypeSticker:
+		return kinv1.ContentType_CONTENT_TYPE_STICKER
+	default:
+		return kinv1.ContentType_CONTENT_TYPE_UNSPECIFIED
+	}
+}
+
+func ContentTypeFromProto(ct kinv1.ContentType) messaging.ContentType {
+	switch ct {
+	case kinv1.ContentType_CONTENT_TYPE_TEXT:
+		return messaging.ContentTypeText
+	case kinv1.ContentType_CONTENT_TYPE_IMAGE:
+		return messaging.ContentTypeImage
+	case kinv1.ContentType_CONTENT_TYPE_VIDEO:
+		return messaging.ContentTypeVideo
+	case kinv1.ContentType_CONTENT_TYPE_AUDIO:
+		return messaging.ContentTypeAudio
+	case kinv1.ContentType_CONTENT_TYPE_FILE:
+		return messaging.ContentTypeFile
+	case kinv1.ContentType_CONTENT_TYPE_LOCATION:
+		return messaging.ContentTypeLocation
+	case kinv1.ContentType_CONTENT_TYPE_STICKER:
+		return messaging.ContentTypeSticker
+	default:
+		return ""
+	}
+}
+
+func ReactionToProto(r *messaging.Reaction) *kinv1.Reaction {
+	if r == nil {
+		return nil
+	}
+
+	return &kinv1.Reaction{
+		Id:        r.ID.String(),
+		MessageId: r.MessageID.String(),
+		UserId:    r.UserID.String(),
+		Emoji:     r.Emoji,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+}
+
+func ReactionsToProto(reactions []*messaging.Reaction) []*kinv1.Reaction {
+	result := make([]*kinv1.Reaction, len(reactions))
+	for i, r := range reactions {
+		result[i] = ReactionToProto(r)
+	}
+	return result
+}
+
+func ReactionSummariesToProto(summaries []messaging.ReactionSummary) []*kinv1.ReactionSummary {
+	result := make([]*kinv1.ReactionSummary, len(summaries))
+	for i, s := range summaries {
+		userIDs := make([]string, len(s.Users))
+		for j, u := range s.Users {
+			userIDs[j] = u.String()
+		}
+		result[i] = &kinv1.ReactionSummary{
+			Emoji:   s.Emoji,
+			Count:   int32(s.Count),
+			UserIds: userIDs,
+		}
+	}
+	return result
+}
+
+func intPtrToInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
+
+func int32PtrToIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
@@ -0,0 +1,64 @@
+package converter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/google/uuid"
+)
+
+func TestContentRoundTrip(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	mediaID := uuid.New()
+
+	tests := []struct {
+		name    string
+		content messaging.Content
+	}{
+		{name: "text", content: messaging.NewTextContent("hello")},
+		{name: "image", content: messaging.NewImageContent(mediaID, "https://cdn/i.jpg", 640, 480)},
+		{name: "video", content: messaging.NewVideoContent(mediaID, "https://cdn/v.mp4", 42, ptr("https://cdn/v.jpg"))},
+		{name: "audio", content: messaging.NewAudioContent(mediaID, "https://cdn/a.m4a", 7)},
+		{name: "file", content: messaging.NewFileContent(mediaID, "https://cdn/f.pdf", "f.pdf", 1024, "application/pdf")},
+		{name: "location", content: messaging.NewLocationContent(52.52, 13.40, ptr("Alexanderplatz"), ptr("Berlin"))},
+		{
+			name: "sticker",
+			content: messaging.Content{
+				Type:     messaging.ContentTypeSticker,
+				Metadata: &messaging.Metadata{StickerID: ptr("wave"), StickerPack: ptr("basics")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb := ContentToProto(tt.content)
+			if pb.Type == kinv1.ContentType_CONTENT_TYPE_UNSPECIFIED {
+				t.Fatalf("content type %q has no proto value", tt.content.Type)
+			}
+
+			got, err := ContentFromProto(pb)
+			if err != nil {
+				t.Fatalf("ContentFromProto: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.content) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.content)
+			}
+		})
+	}
+}
+
+func TestContentFromProtoErrors(t *testing.T) {
+	if _, err := ContentFromProto(nil); !errors.Is(err, messaging.ErrEmptyMessage) {
+		t.Errorf("nil content: error = %v, want %v", err, messaging.ErrEmptyMessage)
+	}
+
+	bad := "not-a-uuid"
+	pb := &kinv1.MessageContent{Type: kinv1.ContentType_CONTENT_TYPE_IMAGE, MediaId: &bad}
+	if _, err := ContentFromProto(pb); err == nil {
+		t.Errorf("invalid media ID was accepted")
+	}
+}
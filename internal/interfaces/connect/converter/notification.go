@@ -0,0 +1,44 @@
+package converter
+
+import (
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/internal/domain/notification"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NotificationToProto(n *notification.Notification) *kinv1.Notification {
+	if n == nil {
+		return nil
+	}
+
+	return &kinv1.Notification{
+		Id:        n.ID.String(),
+		Type:      NotificationTypeToProto(n.Type),
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		IsRead:    n.IsRead,
+		CreatedAt: timestamppb.New(n.CreatedAt),
+	}
+}
+
+func NotificationTypeToProto(t notification.NotificationType) kinv1.NotificationType {
+	switch t {
+	case notification.NotificationTypeMessage:
+		return kinv1.NotificationType_NOTIFICATION_TYPE_MESSAGE
+	case notification.NotificationTypeReaction:
+		return kinv1.NotificationType_NOTIFICATION_TYPE_REACTION
+	case notification.NotificationTypeMention:
+		return kinv1.NotificationType_NOTIFICATION_TYPE_MENTION
+	case notification.NotificationTypeCircleInvite:
+		return kinv1.NotificationType_NOTIFICATION_TYPE_CIRCLE_INVITE
+	case notification.NotificationTypeContactRequest:
+		return kinv1.NotificationType_NOTIFICATION_TYPE_CONTACT_REQUEST
+	case notification.NotificationTypeCheckIn:
+		return kinv1.NotificationType_NOTIFICATION_TYPE_CHECK_IN
+	case notification.NotificationTypeAvailability:
+		return kinv1.NotificationType_NOTIFICATION_TYPE_AVAILABILITY
+	default:
+		return kinv1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,92 @@
+package converter
+
+import (
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/internal/domain/presence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func PresenceToProto(p *presence.Presence) *kinv1.Presence {
+	if p == nil {
+		return nil
+	}
+
+	pb := &kinv1.Presence{
+		UserId: p.UserID.String(),
+		Status: OnlineStatusToProto(p.Status),
+	}
+
+	// Left unset when the user hides when they were last seen.
+	if !p.LastSeenAt.IsZero() {
+		pb.LastSeenAt = timestamppb.New(p.LastSeenAt)
+	}
+
+	if p.DeviceType != nil {
+		deviceType := DeviceTypeToProto(*p.DeviceType)
+		pb.DeviceType = &deviceType
+	}
+
+	for _, d := range p.Devices {
+		pb.Devices = append(pb.Devices, DevicePresenceToProto(d))
+	}
+
+	return pb
+}
+
+func DevicePresenceToProto(d *presence.Device) *kinv1.DevicePresence {
+	return &kinv1.DevicePresence{
+		DeviceType: DeviceTypeToProto(d.Type),
+		DeviceId:   d.ID,
+		Status:     OnlineStatusToProto(d.Status),
+		LastSeenAt: timestamppb.New(d.LastSeenAt),
+	}
+}
+
+func PresencesToProto(presences []*presence.Presence) []*kinv1.Presence {
+	result := make([]*kinv1.Presence, len(presences))
+	for i, p := range presences {
+		result[i] = PresenceToProto(p)
+	}
+	return result
+}
+
+func OnlineStatusToProto(s presence.OnlineStatus) kinv1.OnlineStatus {
+	switch s {
+	case presence.OnlineStatusOnline:
+		return kinv1.OnlineStatus_ONLINE_STATUS_ONLINE
+	case presence.OnlineStatusOffline:
+		return kinv1.OnlineStatus_ONLINE_STATUS_OFFLINE
+	case presence.OnlineStatusAway:
+		return kinv1.OnlineStatus_ONLINE_STATUS_AWAY
+	default:
+		return kinv1.OnlineStatus_ONLINE_STATUS_UNSPECIFIED
+	}
+}
+
+func DeviceTypeToProto(t presence.DeviceType) kinv1.DeviceType {
+	switch t {
+	case presence.DeviceTypeMobile:
+		return kinv1.DeviceType_DEVICE_TYPE_MOBILE
+	case presence.DeviceTypeDesktop:
+		return kinv1.DeviceType_DEVICE_TYPE_DESKTOP
+	case presence.DeviceTypeWeb:
+		return kinv1.DeviceType_DEVICE_TYPE_WEB
+	case presence.DeviceTypeTablet:
+		return kinv1.DeviceType_DEVICE_TYPE_TABLET
+	default:
+		return kinv1.DeviceType_DEVICE_TYPE_UNSPECIFIED
+	}
+}
+
+func DeviceTypeFromProto(t kinv1.DeviceType) presence.DeviceType {
+	switch t {
+	case kinv1.DeviceType_DEVICE_TYPE_DESKTOP:
+		return presence.DeviceTypeDesktop
+	case kinv1.DeviceType_DEVICE_TYPE_WEB:
+		return presence.DeviceTypeWeb
+	case kinv1.DeviceType_DEVICE_TYPE_TABLET:
+		return presence.DeviceTypeTablet
+	default:
+		return presence.DeviceTypeMobile
+	}
+}
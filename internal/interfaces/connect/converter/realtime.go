@@ -0,0 +1,86 @@
+package converter
+
+import (
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/internal/domain/realtime"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func EventToProto(e *realtime.Event) *kinv1.Event {
+	if e == nil {
+		return nil
+	}
+
+	pb := &kinv1.Event{
+		Id:           e.ID.String(),
+		Type:         EventTypeToProto(e.Type),
+		ActorId:      e.ActorID.String(),
+		OccurredAt:   timestamppb.New(e.OccurredAt),
+		Message:      MessageToProto(e.Message),
+		Reaction:     ReactionToProto(e.Reaction),
+		Presence:     PresenceToProto(e.Presence),
+		Member:       MemberToProto(e.Member),
+		Availability: AvailabilityToProto(e.Availability),
+		Notification: NotificationToProto(e.Notification),
+		Location:     SharedLocationToProto(e.Location),
+		LiveSession:  LiveSessionToProto(e.LiveSession),
+	}
+
+	if e.ConversationID != nil {
+		conversationID := e.ConversationID.String()
+		pb.ConversationId = &conversationID
+	}
+	if e.CircleID != nil {
+		circleID := e.CircleID.String()
+		pb.CircleId = &circleID
+	}
+	if len(e.MessageIDs) > 0 {
+		pb.MessageIds = make([]string, len(e.MessageIDs))
+		for i, id := range e.MessageIDs {
+			pb.MessageIds[i] = id.String()
+		}
+	}
+
+	return pb
+}
+
+func EventTypeToProto(t realtime.EventType) kinv1.EventType {
+	switch t {
+	case realtime.EventTypeMessageCreated:
+		return kinv1.EventType_EVENT_TYPE_MESSAGE_CREATED
+	case realtime.EventTypeMessageUpdated:
+		return kinv1.EventType_EVENT_TYPE_MESSAGE_UPDATED
+	case realtime.EventTypeMessageDeleted:
+		return kinv1.EventType_EVENT_TYPE_MESSAGE_DELETED
+	case realtime.EventTypeReactionAdded:
+		return kinv1.EventType_EVENT_TYPE_REACTION_ADDED
+	case realtime.EventTypeReactionRemoved:
+		return kinv1.EventType_EVENT_TYPE_REACTION_REMOVED
+	case realtime.EventTypeReceiptDelivered:
+		return kinv1.EventType_EVENT_TYPE_RECEIPT_DELIVERED
+	case realtime.EventTypeReceiptRead:
+		return kinv1.EventType_EVENT_TYPE_RECEIPT_READ
+	case realtime.EventTypeTypingStarted:
+		return kinv1.EventType_EVENT_TYPE_TYPING_STARTED
+	case realtime.EventTypeTypingStopped:
+		return kinv1.EventType_EVENT_TYPE_TYPING_STOPPED
+	case realtime.EventTypePresenceChanged:
+		return kinv1.EventType_EVENT_TYPE_PRESENCE_CHANGED
+	case realtime.EventTypeMemberJoined:
+		return kinv1.EventType_EVENT_TYPE_MEMBER_JOINED
+	case realtime.EventTypeMemberLeft:
+		return kinv1.EventType_EVENT_TYPE_MEMBER_LEFT
+	case realtime.EventTypeAvailabilityChanged:
+		return kinv1.EventType_EVENT_TYPE_AVAILABILITY_CHANGED
+	case realtime.EventTypeNotificationCreated:
+		return kinv1.EventType_EVENT_TYPE_NOTIFICATION_CREATED
+	case realtime.EventTypeLocationUpdated:
+		return kinv1.EventType_EVENT_TYPE_LOCATION_UPDATED
+	case realtime.EventTypeLiveSessionStarted:
+		return kinv1.EventType_EVENT_TYPE_LIVE_SESSION_STARTED
+	case realtime.EventTypeLiveSessionEnded:
+		return kinv1.EventType_EVENT_TYPE_LIVE_SESSION_ENDED
+	default:
+		return kinv1.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
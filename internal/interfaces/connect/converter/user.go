@@ -33,6 +33,24 @@ func UserToProto(u *user.User) *kinv1.User {
 	return pb
 }
 
+// UserProfileToProto leaves out what only the user themself may see.
+func UserProfileToProto(u *user.User) *kinv1.UserProfile {
+	return &kinv1.UserProfile{
+		Id:          u.ID.String(),
+		DisplayName: u.DisplayName,
+		Avatar:      u.Avatar,
+		Bio:         u.Bio,
+	}
+}
+
+func UserProfilesToProto(users []*user.User) []*kinv1.UserProfile {
+	result := make([]*kinv1.UserProfile, len(users))
+	for i, u := range users {
+		result[i] = UserProfileToProto(u)
+	}
+	return result
+}
+
 func PreferencesToProto(p *user.Preferences) *kinv1.Preferences {
 	if p == nil {
 		return nil
@@ -48,6 +66,7 @@ func PreferencesToProto(p *user.Preferences) *kinv1.Preferences {
 		PushNotifications:     p.PushNotifications,
 		EmailNotifications:    p.EmailNotifications,
 		QuietHoursEnabled:     p.QuietHoursEnabled,
+		LocationRetention:     LocationRetentionToProto(p.LocationRetention),
 		CreatedAt:             timestamppb.New(p.CreatedAt),
 		UpdatedAt:             timestamppb.New(p.UpdatedAt),
 	}
@@ -0,0 +1,470 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/gen/proto/kin/v1/kinv1connect"
+	"github.com/danielng/kin-core-svc/internal/application/availability"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/converter"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/interceptors"
+	"github.com/google/uuid"
+)
+
+type AvailabilityHandler struct {
+	kinv1connect.UnimplementedAvailabilityServiceHandler
+	availabilityService *availability.Service
+}
+
+func NewAvailabilityHandler(availabilityService *availability.Service) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		availabilityService: availabilityService,
+	}
+}
+
+func (h *AvailabilityHandler) GetAvailability(ctx context.Context, req *connect.Request[kinv1.GetAvailabilityRequest]) (*connect.Response[kinv1.GetAvailabilityResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	a, err := h.availabilityService.GetAvailability(ctx, availability.GetAvailabilityQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	effective, err := h.availabilityService.ResolveStatus(ctx, availability.ResolveStatusQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.GetAvailabilityResponse{
+		Availability: converter.AvailabilityToProto(a),
+		Effective:    converter.ResolutionToProto(effective),
+	}), nil
+}
+
+func (h *AvailabilityHandler) SetStatus(ctx context.Context, req *connect.Request[kinv1.SetStatusRequest]) (*connect.Response[kinv1.SetStatusResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	cmd := availability.SetStatusCommand{
+		UserID:  userID,
+		Status:  converter.AvailabilityStatusFromProto(req.Msg.Status),
+		Message: req.Msg.StatusMessage,
+	}
+
+	if req.Msg.DurationMinutes != nil {
+		duration := time.Duration(*req.Msg.DurationMinutes) * time.Minute
+		cmd.Duration = &duration
+	}
+
+	a, err := h.availabilityService.SetStatus(ctx, cmd)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SetStatusResponse{
+		Availability: converter.AvailabilityToProto(a),
+	}), nil
+}
+
+func (h *AvailabilityHandler) RevertToAutoStatus(ctx context.Context, req *connect.Request[kinv1.RevertToAutoStatusRequest]) (*connect.Response[kinv1.RevertToAutoStatusResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	a, err := h.availabilityService.RevertToAuto(ctx, availability.RevertToAutoCommand{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.RevertToAutoStatusResponse{
+		Availability: converter.AvailabilityToProto(a),
+	}), nil
+}
+
+func (h *AvailabilityHandler) ListWindows(ctx context.Context, req *connect.Request[kinv1.ListWindowsRequest]) (*connect.Response[kinv1.ListWindowsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	windows, err := h.availabilityService.ListWindows(ctx, availability.ListWindowsQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListWindowsResponse{
+		Windows: converter.WindowsToProto(windows),
+	}), nil
+}
+
+func (h *AvailabilityHandler) CreateWindow(ctx context.Context, req *connect.Request[kinv1.CreateWindowRequest]) (*connect.Response[kinv1.CreateWindowResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	w, err := h.availabilityService.CreateWindow(ctx, availability.CreateWindowCommand{
+		UserID:    userID,
+		Name:      req.Msg.Name,
+		Weekday:   converter.WeekdayFromProto(req.Msg.Weekday),
+		StartTime: req.Msg.StartTime,
+		EndTime:   req.Msg.EndTime,
+		Status:    converter.AvailabilityStatusFromProto(req.Msg.Status),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.CreateWindowResponse{
+		Window: converter.WindowToProto(w),
+	}), nil
+}
+
+func (h *AvailabilityHandler) UpdateWindow(ctx context.Context, req *connect.Request[kinv1.UpdateWindowRequest]) (*connect.Response[kinv1.UpdateWindowResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	windowID, err := uuid.Parse(req.Msg.WindowId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'window_id': %w", err))
+	}
+
+	cmd := availability.UpdateWindowCommand{
+		UserID:    userID,
+		WindowID:  windowID,
+		Name:      req.Msg.Name,
+		StartTime: req.Msg.StartTime,
+		EndTime:   req.Msg.EndTime,
+		IsActive:  req.Msg.IsActive,
+	}
+
+	if req.Msg.Weekday != nil {
+		weekday := converter.WeekdayFromProto(*req.Msg.Weekday)
+		cmd.Weekday = &weekday
+	}
+
+	if req.Msg.Status != nil {
+		status := converter.AvailabilityStatusFromProto(*req.Msg.Status)
+		cmd.Status = &status
+	}
+
+	w, err := h.availabilityService.UpdateWindow(ctx, cmd)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.UpdateWindowResponse{
+		Window: converter.WindowToProto(w),
+	}), nil
+}
+
+func (h *AvailabilityHandler) DeleteWindow(ctx context.Context, req *connect.Request[kinv1.DeleteWindowRequest]) (*connect.Response[kinv1.DeleteWindowResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	windowID, err := uuid.Parse(req.Msg.WindowId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'window_id': %w", err))
+	}
+
+	err = h.availabilityService.DeleteWindow(ctx, availability.DeleteWindowCommand{
+		UserID:   userID,
+		WindowID: windowID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.DeleteWindowResponse{}), nil
+}
+
+func (h *AvailabilityHandler) ListAutoRules(ctx context.Context, req *connect.Request[kinv1.ListAutoRulesRequest]) (*connect.Response[kinv1.ListAutoRulesResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	rules, err := h.availabilityService.ListAutoRules(ctx, availability.ListAutoRulesQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListAutoRulesResponse{
+		Rules: converter.AutoRulesToProto(rules),
+	}), nil
+}
+
+func (h *AvailabilityHandler) CreateAutoRule(ctx context.Context, req *connect.Request[kinv1.CreateAutoRuleRequest]) (*connect.Response[kinv1.CreateAutoRuleResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	if req.Msg.Condition == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'condition' is required"))
+	}
+
+	condition, err := converter.ConditionFromProto(req.Msg.Condition)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	rule, err := h.availabilityService.CreateAutoRule(ctx, availability.CreateAutoRuleCommand{
+		UserID:       userID,
+		Name:         req.Msg.Name,
+		Condition:    condition,
+		TargetStatus: converter.AvailabilityStatusFromProto(req.Msg.TargetStatus),
+		Priority:     int(req.Msg.Priority),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.CreateAutoRuleResponse{
+		Rule: converter.AutoRuleToProto(rule),
+	}), nil
+}
+
+func (h *AvailabilityHandler) UpdateAutoRule(ctx context.Context, req *connect.Request[kinv1.UpdateAutoRuleRequest]) (*connect.Response[kinv1.UpdateAutoRuleResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	ruleID, err := uuid.Parse(req.Msg.RuleId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'rule_id': %w", err))
+	}
+
+	cmd := availability.UpdateAutoRuleCommand{
+		UserID:   userID,
+		RuleID:   ruleID,
+		Name:     req.Msg.Name,
+		IsActive: req.Msg.IsActive,
+	}
+
+	if req.Msg.Condition != nil {
+		condition, err := converter.ConditionFromProto(req.Msg.Condition)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		cmd.Condition = &condition
+	}
+
+	if req.Msg.TargetStatus != nil {
+		status := converter.AvailabilityStatusFromProto(*req.Msg.TargetStatus)
+		cmd.TargetStatus = &status
+	}
+
+	if req.Msg.Priority != nil {
+		priority := int(*req.Msg.Priority)
+		cmd.Priority = &priority
+	}
+
+	rule, err := h.availabilityService.UpdateAutoRule(ctx, cmd)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.UpdateAutoRuleResponse{
+		Rule: converter.AutoRuleToProto(rule),
+	}), nil
+}
+
+func (h *AvailabilityHandler) DeleteAutoRule(ctx context.Context, req *connect.Request[kinv1.DeleteAutoRuleRequest]) (*connect.Response[kinv1.DeleteAutoRuleResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	ruleID, err := uuid.Parse(req.Msg.RuleId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'rule_id': %w", err))
+	}
+
+	err = h.availabilityService.DeleteAutoRule(ctx, availability.DeleteAutoRuleCommand{
+		UserID: userID,
+		RuleID: ruleID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.DeleteAutoRuleResponse{}), nil
+}
+
+func (h *AvailabilityHandler) ListCalendars(ctx context.Context, req *connect.Request[kinv1.ListCalendarsRequest]) (*connect.Response[kinv1.ListCalendarsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	calendars, err := h.availabilityService.ListCalendars(ctx, availability.ListCalendarsQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListCalendarsResponse{
+		Calendars: converter.CalendarsToProto(calendars),
+	}), nil
+}
+
+func (h *AvailabilityHandler) CreateCalendar(ctx context.Context, req *connect.Request[kinv1.CreateCalendarRequest]) (*connect.Response[kinv1.CreateCalendarResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'name' is required"))
+	}
+
+	c, err := h.availabilityService.CreateCalendar(ctx, availability.CreateCalendarCommand{
+		UserID: userID,
+		Name:   req.Msg.Name,
+		URL:    req.Msg.Url,
+		Data:   req.Msg.Data,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.CreateCalendarResponse{
+		Calendar: converter.CalendarToProto(c),
+	}), nil
+}
+
+func (h *AvailabilityHandler) UpdateCalendar(ctx context.Context, req *connect.Request[kinv1.UpdateCalendarRequest]) (*connect.Response[kinv1.UpdateCalendarResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	calendarID, err := uuid.Parse(req.Msg.CalendarId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'calendar_id': %w", err))
+	}
+
+	c, err := h.availabilityService.UpdateCalendar(ctx, availability.UpdateCalendarCommand{
+		UserID:     userID,
+		CalendarID: calendarID,
+		Name:       req.Msg.Name,
+		IsActive:   req.Msg.IsActive,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.UpdateCalendarResponse{
+		Calendar: converter.CalendarToProto(c),
+	}), nil
+}
+
+func (h *AvailabilityHandler) DeleteCalendar(ctx context.Context, req *connect.Request[kinv1.DeleteCalendarRequest]) (*connect.Response[kinv1.DeleteCalendarResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	calendarID, err := uuid.Parse(req.Msg.CalendarId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'calendar_id': %w", err))
+	}
+
+	err = h.availabilityService.DeleteCalendar(ctx, availability.DeleteCalendarCommand{
+		UserID:     userID,
+		CalendarID: calendarID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.DeleteCalendarResponse{}), nil
+}
+
+func (h *AvailabilityHandler) SyncCalendar(ctx context.Context, req *connect.Request[kinv1.SyncCalendarRequest]) (*connect.Response[kinv1.SyncCalendarResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	calendarID, err := uuid.Parse(req.Msg.CalendarId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'calendar_id': %w", err))
+	}
+
+	c, err := h.availabilityService.SyncCalendar(ctx, availability.SyncCalendarCommand{
+		UserID:     userID,
+		CalendarID: calendarID,
+		Data:       req.Msg.Data,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SyncCalendarResponse{
+		Calendar: converter.CalendarToProto(c),
+	}), nil
+}
+
+func (h *AvailabilityHandler) FindBestTimes(ctx context.Context, req *connect.Request[kinv1.FindBestTimesRequest]) (*connect.Response[kinv1.FindBestTimesResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	if (req.Msg.CircleId == nil) == (len(req.Msg.UserIds) == 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("exactly one of circle_id or user_ids is required"))
+	}
+	if req.Msg.Start == nil || req.Msg.End == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start and end are required"))
+	}
+
+	query := availability.FindBestTimesQuery{
+		UserID: userID,
+		From:   req.Msg.Start.AsTime(),
+		To:     req.Msg.End.AsTime(),
+		Limit:  int(req.Msg.Limit),
+	}
+
+	if req.Msg.CircleId != nil {
+		circleID, err := uuid.Parse(*req.Msg.CircleId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'circle_id': %w", err))
+		}
+		query.CircleID = &circleID
+	}
+
+	for _, id := range req.Msg.UserIds {
+		participantID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'user_ids': %w", err))
+		}
+		query.UserIDs = append(query.UserIDs, participantID)
+	}
+
+	if req.Msg.MinDurationMinutes != nil {
+		query.MinDuration = time.Duration(*req.Msg.MinDurationMinutes) * time.Minute
+	}
+
+	result, err := h.availabilityService.FindBestTimes(ctx, query)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(converter.BestTimesToProto(result)), nil
+}
@@ -0,0 +1,298 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/gen/proto/kin/v1/kinv1connect"
+	"github.com/danielng/kin-core-svc/internal/application/contact"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/converter"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/interceptors"
+	"github.com/google/uuid"
+)
+
+type ContactHandler struct {
+	kinv1connect.UnimplementedContactServiceHandler
+	contactService *contact.Service
+}
+
+func NewContactHandler(contactService *contact.Service) *ContactHandler {
+	return &ContactHandler{
+		contactService: contactService,
+	}
+}
+
+func (h *ContactHandler) SendContactRequest(ctx context.Context, req *connect.Request[kinv1.SendContactRequestRequest]) (*connect.Response[kinv1.SendContactRequestResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	toUserID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'user_id': %w", err))
+	}
+
+	request, err := h.contactService.SendContactRequest(ctx, contact.SendContactRequestCommand{
+		UserID:   userID,
+		ToUserID: toUserID,
+		Message:  req.Msg.Message,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SendContactRequestResponse{
+		Request: converter.ContactRequestToProto(request),
+	}), nil
+}
+
+func (h *ContactHandler) ListIncomingContactRequests(ctx context.Context, req *connect.Request[kinv1.ListIncomingContactRequestsRequest]) (*connect.Response[kinv1.ListIncomingContactRequestsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	requests, err := h.contactService.ListIncomingRequests(ctx, contact.ListIncomingRequestsQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListIncomingContactRequestsResponse{
+		Requests: converter.ContactRequestsToProto(requests),
+	}), nil
+}
+
+func (h *ContactHandler) ListOutgoingContactRequests(ctx context.Context, req *connect.Request[kinv1.ListOutgoingContactRequestsRequest]) (*connect.Response[kinv1.ListOutgoingContactRequestsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	requests, err := h.contactService.ListOutgoingRequests(ctx, contact.ListOutgoingRequestsQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListOutgoingContactRequestsResponse{
+		Requests: converter.ContactRequestsToProto(requests),
+	}), nil
+}
+
+func (h *ContactHandler) AcceptContactRequest(ctx context.Context, req *connect.Request[kinv1.AcceptContactRequestRequest]) (*connect.Response[kinv1.AcceptContactRequestResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	requestID, err := uuid.Parse(req.Msg.RequestId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'request_id': %w", err))
+	}
+
+	c, err := h.contactService.AcceptContactRequest(ctx, contact.AcceptContactRequestCommand{
+		UserID:    userID,
+		RequestID: requestID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.AcceptContactRequestResponse{
+		Contact: converter.ContactToProto(c),
+	}), nil
+}
+
+func (h *ContactHandler) RejectContactRequest(ctx context.Context, req *connect.Request[kinv1.RejectContactRequestRequest]) (*connect.Response[kinv1.RejectContactRequestResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	requestID, err := uuid.Parse(req.Msg.RequestId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'request_id': %w", err))
+	}
+
+	request, err := h.contactService.RejectContactRequest(ctx, contact.RejectContactRequestCommand{
+		UserID:    userID,
+		RequestID: requestID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.RejectContactRequestResponse{
+		Request: converter.ContactRequestToProto(request),
+	}), nil
+}
+
+func (h *ContactHandler) ListContacts(ctx context.Context, req *connect.Request[kinv1.ListContactsRequest]) (*connect.Response[kinv1.ListContactsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	offset := int(req.Msg.Offset)
+	if offset < 0 {
+		offset = 0
+	}
+
+	contacts, total, err := h.contactService.ListContacts(ctx, contact.ListContactsQuery{
+		UserID:        userID,
+		FavoritesOnly: req.Msg.FavoritesOnly,
+		Limit:         limit,
+		Offset:        offset,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListContactsResponse{
+		Contacts: converter.ContactsToProto(contacts),
+		Meta: &kinv1.PaginationMeta{
+			Page:    int32(offset/limit + 1),
+			PerPage: int32(limit),
+			Total:   total,
+		},
+	}), nil
+}
+
+func (h *ContactHandler) ListBlockedUsers(ctx context.Context, req *connect.Request[kinv1.ListBlockedUsersRequest]) (*connect.Response[kinv1.ListBlockedUsersResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	contacts, err := h.contactService.ListBlockedUsers(ctx, contact.ListBlockedUsersQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListBlockedUsersResponse{
+		Contacts: converter.ContactsToProto(contacts),
+	}), nil
+}
+
+func (h *ContactHandler) RemoveContact(ctx context.Context, req *connect.Request[kinv1.RemoveContactRequest]) (*connect.Response[kinv1.RemoveContactResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	contactUserID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'user_id': %w", err))
+	}
+
+	err = h.contactService.RemoveContact(ctx, contact.RemoveContactCommand{
+		UserID:        userID,
+		ContactUserID: contactUserID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.RemoveContactResponse{}), nil
+}
+
+func (h *ContactHandler) SetContactNickname(ctx context.Context, req *connect.Request[kinv1.SetContactNicknameRequest]) (*connect.Response[kinv1.SetContactNicknameResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	contactUserID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'user_id': %w", err))
+	}
+
+	c, err := h.contactService.SetNickname(ctx, contact.SetNicknameCommand{
+		UserID:        userID,
+		ContactUserID: contactUserID,
+		Nickname:      req.Msg.Nickname,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SetContactNicknameResponse{
+		Contact: converter.ContactToProto(c),
+	}), nil
+}
+
+func (h *ContactHandler) SetContactFavorite(ctx context.Context, req *connect.Request[kinv1.SetContactFavoriteRequest]) (*connect.Response[kinv1.SetContactFavoriteResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	contactUserID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'user_id': %w", err))
+	}
+
+	c, err := h.contactService.SetFavorite(ctx, contact.SetFavoriteCommand{
+		UserID:        userID,
+		ContactUserID: contactUserID,
+		Favorite:      req.Msg.Favorite,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SetContactFavoriteResponse{
+		Contact: converter.ContactToProto(c),
+	}), nil
+}
+
+func (h *ContactHandler) BlockUser(ctx context.Context, req *connect.Request[kinv1.BlockUserRequest]) (*connect.Response[kinv1.BlockUserResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	targetUserID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'user_id': %w", err))
+	}
+
+	err = h.contactService.BlockUser(ctx, contact.BlockUserCommand{
+		UserID:       userID,
+		TargetUserID: targetUserID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.BlockUserResponse{}), nil
+}
+
+func (h *ContactHandler) UnblockUser(ctx context.Context, req *connect.Request[kinv1.UnblockUserRequest]) (*connect.Response[kinv1.UnblockUserResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	targetUserID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'user_id': %w", err))
+	}
+
+	err = h.contactService.UnblockUser(ctx, contact.UnblockUserCommand{
+		UserID:       userID,
+		TargetUserID: targetUserID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.UnblockUserResponse{}), nil
+}
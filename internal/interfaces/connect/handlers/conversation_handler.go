@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/gen/proto/kin/v1/kinv1connect"
+	"github.com/danielng/kin-core-svc/internal/application/conversation"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/converter"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/interceptors"
+	"github.com/google/uuid"
+)
+
+type ConversationHandler struct {
+	kinv1connect.UnimplementedConversationServiceHandler
+	conversationService *conversation.Service
+}
+
+func NewConversationHandler(conversationService *conversation.Service) *ConversationHandler {
+	return &ConversationHandler{
+		conversationService: conversationService,
+	}
+}
+
+func (h *ConversationHandler) StartDirectConversation(ctx context.Context, req *connect.Request[kinv1.StartDirectConversationRequest]) (*connect.Response[kinv1.StartDirectConversationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	otherUserID, err := uuid.Parse(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'user_id': %w", err))
+	}
+
+	summary, err := h.conversationService.StartDirectConversation(ctx, conversation.StartDirectConversationCommand{
+		UserID:      userID,
+		OtherUserID: otherUserID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.StartDirectConversationResponse{
+		Conversation: converter.ConversationSummaryToProto(summary),
+	}), nil
+}
+
+func (h *ConversationHandler) ListConversations(ctx context.Context, req *connect.Request[kinv1.ListConversationsRequest]) (*connect.Response[kinv1.ListConversationsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	offset := int(req.Msg.Offset)
+	if offset < 0 {
+		offset = 0
+	}
+
+	summaries, total, err := h.conversationService.ListConversations(ctx, conversation.ListConversationsQuery{
+		UserID:          userID,
+		IncludeArchived: req.Msg.IncludeArchived,
+		Limit:           limit,
+		Offset:          offset,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListConversationsResponse{
+		Conversations: converter.ConversationSummariesToProto(summaries),
+		Meta: &kinv1.PaginationMeta{
+			Page:    int32(offset/limit + 1),
+			PerPage: int32(limit),
+			Total:   total,
+		},
+	}), nil
+}
+
+func (h *ConversationHandler) GetConversation(ctx context.Context, req *connect.Request[kinv1.GetConversationRequest]) (*connect.Response[kinv1.GetConversationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	summary, participants, err := h.conversationService.GetConversation(ctx, conversation.GetConversationQuery{
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.GetConversationResponse{
+		Conversation: converter.ConversationSummaryToProto(summary),
+		Participants: converter.ParticipantsToProto(participants),
+	}), nil
+}
+
+func (h *ConversationHandler) MuteConversation(ctx context.Context, req *connect.Request[kinv1.MuteConversationRequest]) (*connect.Response[kinv1.MuteConversationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	participant, err := h.conversationService.MuteConversation(ctx, conversation.MuteConversationCommand{
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.MuteConversationResponse{
+		Participant: converter.ParticipantToProto(participant),
+	}), nil
+}
+
+func (h *ConversationHandler) UnmuteConversation(ctx context.Context, req *connect.Request[kinv1.UnmuteConversationRequest]) (*connect.Response[kinv1.UnmuteConversationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	participant, err := h.conversationService.UnmuteConversation(ctx, conversation.UnmuteConversationCommand{
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.UnmuteConversationResponse{
+		Participant: converter.ParticipantToProto(participant),
+	}), nil
+}
+
+func (h *ConversationHandler) ArchiveConversation(ctx context.Context, req *connect.Request[kinv1.ArchiveConversationRequest]) (*connect.Response[kinv1.ArchiveConversationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	participant, err := h.conversationService.ArchiveConversation(ctx, conversation.ArchiveConversationCommand{
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ArchiveConversationResponse{
+		Participant: converter.ParticipantToProto(participant),
+	}), nil
+}
+
+func (h *ConversationHandler) UnarchiveConversation(ctx context.Context, req *connect.Request[kinv1.UnarchiveConversationRequest]) (*connect.Response[kinv1.UnarchiveConversationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	participant, err := h.conversationService.UnarchiveConversation(ctx, conversation.UnarchiveConversationCommand{
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.UnarchiveConversationResponse{
+		Participant: converter.ParticipantToProto(participant),
+	}), nil
+}
+
+func (h *ConversationHandler) LeaveConversation(ctx context.Context, req *connect.Request[kinv1.LeaveConversationRequest]) (*connect.Response[kinv1.LeaveConversationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	err = h.conversationService.LeaveConversation(ctx, conversation.LeaveConversationCommand{
+		ConversationID: conversationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.LeaveConversationResponse{}), nil
+}
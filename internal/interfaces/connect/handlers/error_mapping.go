@@ -9,6 +9,12 @@ import (
 	"github.com/danielng/kin-core-svc/pkg/apperror"
 )
 
+// connectCodeOverrides lists errors whose HTTP status has no precise Connect
+// equivalent.
+var connectCodeOverrides = map[apperror.ErrorCode]connect.Code{
+	apperror.CodeContactRequestNotPending: connect.CodeFailedPrecondition,
+}
+
 func mapError(err error) error {
 	if err == nil {
 		return nil
@@ -23,7 +29,10 @@ func mapError(err error) error {
 
 	var appErr *apperror.AppError
 	if errors.As(err, &appErr) {
-		code := httpStatusToConnectCode(appErr.HTTPStatus)
+		code, ok := connectCodeOverrides[appErr.Code]
+		if !ok {
+			code = httpStatusToConnectCode(appErr.HTTPStatus)
+		}
 		return connect.NewError(code, errors.New(appErr.Message))
 	}
 
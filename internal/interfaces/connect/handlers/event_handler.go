@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/gen/proto/kin/v1/kinv1connect"
+	"github.com/danielng/kin-core-svc/internal/application/realtime"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/converter"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/interceptors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const eventKeepaliveInterval = 30 * time.Second
+
+type EventHandler struct {
+	kinv1connect.UnimplementedEventServiceHandler
+	realtimeService *realtime.Service
+}
+
+func NewEventHandler(realtimeService *realtime.Service) *EventHandler {
+	return &EventHandler{
+		realtimeService: realtimeService,
+	}
+}
+
+func (h *EventHandler) Subscribe(ctx context.Context, req *connect.Request[kinv1.SubscribeRequest], stream *connect.ServerStream[kinv1.SubscribeResponse]) error {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	deviceType := converter.DeviceTypeFromProto(req.Msg.DeviceType)
+	sub, err := h.realtimeService.Subscribe(ctx, realtime.SubscribeCommand{
+		UserID:     userID,
+		DeviceType: deviceType,
+		DeviceID:   req.Msg.DeviceId,
+	})
+	if err != nil {
+		return mapError(err)
+	}
+	defer func() {
+		_ = sub.Close()
+		// The request context is already cancelled once the client goes away.
+		_ = h.realtimeService.Unsubscribe(context.WithoutCancel(ctx), realtime.UnsubscribeCommand{
+			UserID:     userID,
+			DeviceType: deviceType,
+			DeviceID:   req.Msg.DeviceId,
+		})
+	}()
+
+	// Keepalives refresh presence and stop idle proxies from closing the stream.
+	keepalive := time.NewTicker(eventKeepaliveInterval)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return connect.NewError(connect.CodeUnavailable, errors.New("event subscription closed"))
+			}
+			if err := stream.Send(&kinv1.SubscribeResponse{Event: converter.EventToProto(event)}); err != nil {
+				return err
+			}
+		case <-keepalive.C:
+			if err := h.realtimeService.Heartbeat(ctx, realtime.HeartbeatCommand{
+				UserID:     userID,
+				DeviceType: deviceType,
+				DeviceID:   req.Msg.DeviceId,
+			}); err != nil {
+				return mapError(err)
+			}
+			if err := stream.Send(&kinv1.SubscribeResponse{Event: &kinv1.Event{
+				Type:       kinv1.EventType_EVENT_TYPE_KEEPALIVE,
+				OccurredAt: timestamppb.Now(),
+			}}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,387 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/gen/proto/kin/v1/kinv1connect"
+	"github.com/danielng/kin-core-svc/internal/application/location"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/converter"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/interceptors"
+	"github.com/google/uuid"
+)
+
+type LocationHandler struct {
+	kinv1connect.UnimplementedLocationServiceHandler
+	locationService *location.Service
+}
+
+func NewLocationHandler(locationService *location.Service) *LocationHandler {
+	return &LocationHandler{
+		locationService: locationService,
+	}
+}
+
+func (h *LocationHandler) UpdateLocation(ctx context.Context, req *connect.Request[kinv1.UpdateLocationRequest]) (*connect.Response[kinv1.UpdateLocationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	if req.Msg.Point == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'point' is required"))
+	}
+
+	l, err := h.locationService.UpdateLocation(ctx, location.UpdateLocationCommand{
+		UserID: userID,
+		Point:  converter.PointFromProto(req.Msg.Point),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.UpdateLocationResponse{
+		Location: converter.LocationToProto(l),
+	}), nil
+}
+
+func (h *LocationHandler) BatchUpdateLocation(ctx context.Context, req *connect.Request[kinv1.BatchUpdateLocationRequest]) (*connect.Response[kinv1.BatchUpdateLocationResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	if len(req.Msg.Points) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'points' is required"))
+	}
+
+	points := make([]location.Point, len(req.Msg.Points))
+	for i, p := range req.Msg.Points {
+		points[i] = converter.PointFromProto(p)
+	}
+
+	l, err := h.locationService.BatchUpdateLocation(ctx, location.BatchUpdateLocationCommand{
+		UserID: userID,
+		Points: points,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.BatchUpdateLocationResponse{
+		Location: converter.LocationToProto(l),
+	}), nil
+}
+
+func (h *LocationHandler) GetLocationHistory(ctx context.Context, req *connect.Request[kinv1.GetLocationHistoryRequest]) (*connect.Response[kinv1.GetLocationHistoryResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	query := location.GetHistoryQuery{
+		UserID:    userID,
+		Tolerance: req.Msg.Tolerance,
+	}
+	if req.Msg.From != nil {
+		query.From = req.Msg.From.AsTime()
+	}
+	if req.Msg.To != nil {
+		query.To = req.Msg.To.AsTime()
+	}
+
+	trail, err := h.locationService.GetHistory(ctx, query)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(converter.TrailToProto(trail)), nil
+}
+
+func (h *LocationHandler) DeleteLocationHistory(ctx context.Context, req *connect.Request[kinv1.DeleteLocationHistoryRequest]) (*connect.Response[kinv1.DeleteLocationHistoryResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	cmd := location.DeleteHistoryCommand{UserID: userID}
+	if req.Msg.Before != nil {
+		cmd.Before = req.Msg.Before.AsTime()
+	}
+
+	if err := h.locationService.DeleteHistory(ctx, cmd); err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.DeleteLocationHistoryResponse{}), nil
+}
+
+func (h *LocationHandler) ListCircleLocations(ctx context.Context, req *connect.Request[kinv1.ListCircleLocationsRequest]) (*connect.Response[kinv1.ListCircleLocationsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	circleID, err := uuid.Parse(req.Msg.CircleId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'circle_id': %w", err))
+	}
+
+	locations, err := h.locationService.ListCircleLocations(ctx, location.ListCircleLocationsQuery{
+		UserID:   userID,
+		CircleID: circleID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListCircleLocationsResponse{
+		Locations: converter.SharedLocationsToProto(locations),
+	}), nil
+}
+
+func (h *LocationHandler) ListNearbyMembers(ctx context.Context, req *connect.Request[kinv1.ListNearbyMembersRequest]) (*connect.Response[kinv1.ListNearbyMembersResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	query := location.ListNearbyMembersQuery{
+		UserID: userID,
+		Radius: req.Msg.Radius,
+	}
+	if req.Msg.CircleId != nil {
+		circleID, err := uuid.Parse(*req.Msg.CircleId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'circle_id': %w", err))
+		}
+		query.CircleID = &circleID
+	}
+
+	nearby, err := h.locationService.ListNearbyMembers(ctx, query)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListNearbyMembersResponse{
+		Members: converter.NearbyToProto(nearby),
+	}), nil
+}
+
+func (h *LocationHandler) StartLiveSession(ctx context.Context, req *connect.Request[kinv1.StartLiveSessionRequest]) (*connect.Response[kinv1.StartLiveSessionResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	cmd := location.StartLiveSessionCommand{
+		UserID:   userID,
+		Duration: time.Duration(req.Msg.DurationSeconds) * time.Second,
+	}
+	if req.Msg.CircleId != nil {
+		circleID, err := uuid.Parse(*req.Msg.CircleId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'circle_id': %w", err))
+		}
+		cmd.CircleID = &circleID
+	}
+	if req.Msg.ConversationId != nil {
+		conversationID, err := uuid.Parse(*req.Msg.ConversationId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+		}
+		cmd.ConversationID = &conversationID
+	}
+
+	session, err := h.locationService.StartLiveSession(ctx, cmd)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.StartLiveSessionResponse{
+		Session: converter.LiveSessionToProto(session),
+	}), nil
+}
+
+func (h *LocationHandler) StopLiveSession(ctx context.Context, req *connect.Request[kinv1.StopLiveSessionRequest]) (*connect.Response[kinv1.StopLiveSessionResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	sessionID, err := uuid.Parse(req.Msg.SessionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'session_id': %w", err))
+	}
+
+	if err := h.locationService.StopLiveSession(ctx, location.StopLiveSessionCommand{
+		UserID:    userID,
+		SessionID: sessionID,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.StopLiveSessionResponse{}), nil
+}
+
+func (h *LocationHandler) ListLiveSessions(ctx context.Context, req *connect.Request[kinv1.ListLiveSessionsRequest]) (*connect.Response[kinv1.ListLiveSessionsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	query := location.ListLiveSessionsQuery{UserID: userID}
+	if req.Msg.CircleId != nil {
+		circleID, err := uuid.Parse(*req.Msg.CircleId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'circle_id': %w", err))
+		}
+		query.CircleID = &circleID
+	}
+	if req.Msg.ConversationId != nil {
+		conversationID, err := uuid.Parse(*req.Msg.ConversationId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+		}
+		query.ConversationID = &conversationID
+	}
+
+	live, err := h.locationService.ListLiveSessions(ctx, query)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListLiveSessionsResponse{
+		Sessions: converter.LiveLocationsToProto(live),
+	}), nil
+}
+
+func (h *LocationHandler) ListPlaces(ctx context.Context, req *connect.Request[kinv1.ListPlacesRequest]) (*connect.Response[kinv1.ListPlacesResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	places, err := h.locationService.ListPlaces(ctx, location.ListPlacesQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListPlacesResponse{
+		Places: converter.PlacesToProto(places),
+	}), nil
+}
+
+func (h *LocationHandler) CreatePlace(ctx context.Context, req *connect.Request[kinv1.CreatePlaceRequest]) (*connect.Response[kinv1.CreatePlaceResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'name' is required"))
+	}
+
+	p, err := h.locationService.CreatePlace(ctx, location.CreatePlaceCommand{
+		UserID:    userID,
+		Name:      req.Msg.Name,
+		Type:      converter.PlaceTypeFromProto(req.Msg.Type),
+		Latitude:  req.Msg.Latitude,
+		Longitude: req.Msg.Longitude,
+		Radius:    req.Msg.Radius,
+		Address:   req.Msg.Address,
+		Icon:      req.Msg.Icon,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.CreatePlaceResponse{
+		Place: converter.PlaceToProto(p),
+	}), nil
+}
+
+func (h *LocationHandler) UpdatePlace(ctx context.Context, req *connect.Request[kinv1.UpdatePlaceRequest]) (*connect.Response[kinv1.UpdatePlaceResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	placeID, err := uuid.Parse(req.Msg.PlaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'place_id': %w", err))
+	}
+
+	if req.Msg.Name != nil && *req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'name' must not be empty"))
+	}
+
+	cmd := location.UpdatePlaceCommand{
+		UserID:    userID,
+		PlaceID:   placeID,
+		Name:      req.Msg.Name,
+		Latitude:  req.Msg.Latitude,
+		Longitude: req.Msg.Longitude,
+		Radius:    req.Msg.Radius,
+		Address:   req.Msg.Address,
+		Icon:      req.Msg.Icon,
+	}
+	if req.Msg.Type != nil {
+		placeType := converter.PlaceTypeFromProto(*req.Msg.Type)
+		cmd.Type = &placeType
+	}
+
+	p, err := h.locationService.UpdatePlace(ctx, cmd)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.UpdatePlaceResponse{
+		Place: converter.PlaceToProto(p),
+	}), nil
+}
+
+func (h *LocationHandler) DeletePlace(ctx context.Context, req *connect.Request[kinv1.DeletePlaceRequest]) (*connect.Response[kinv1.DeletePlaceResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	placeID, err := uuid.Parse(req.Msg.PlaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'place_id': %w", err))
+	}
+
+	err = h.locationService.DeletePlace(ctx, location.DeletePlaceCommand{
+		UserID:  userID,
+		PlaceID: placeID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.DeletePlaceResponse{}), nil
+}
+
+func (h *LocationHandler) ListCheckIns(ctx context.Context, req *connect.Request[kinv1.ListCheckInsRequest]) (*connect.Response[kinv1.ListCheckInsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	checkIns, err := h.locationService.ListCheckIns(ctx, location.ListCheckInsQuery{
+		UserID: userID,
+		Limit:  int(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListCheckInsResponse{
+		CheckIns: converter.CheckInsToProto(checkIns),
+	}), nil
+}
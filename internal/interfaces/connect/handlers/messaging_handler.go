@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/gen/proto/kin/v1/kinv1connect"
+	"github.com/danielng/kin-core-svc/internal/application/messaging"
+	domainmessaging "github.com/danielng/kin-core-svc/internal/domain/messaging"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/converter"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/interceptors"
+	"github.com/google/uuid"
+)
+
+type MessagingHandler struct {
+	kinv1connect.UnimplementedMessagingServiceHandler
+	messagingService *messaging.Service
+}
+
+func NewMessagingHandler(messagingService *messaging.Service) *MessagingHandler {
+	return &MessagingHandler{
+		messagingService: messagingService,
+	}
+}
+
+func (h *MessagingHandler) SendMessage(ctx context.Context, req *connect.Request[kinv1.SendMessageRequest]) (*connect.Response[kinv1.SendMessageResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	if req.Msg.Content == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'content' is required"))
+	}
+
+	content, err := converter.ContentFromProto(req.Msg.Content)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'content.media_id': %w", err))
+	}
+
+	cmd := messaging.SendMessageCommand{
+		ConversationID: conversationID,
+		SenderID:       userID,
+		Content:        content,
+	}
+
+	if req.Msg.ReplyToId != nil {
+		replyToID, err := uuid.Parse(*req.Msg.ReplyToId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'reply_to_id': %w", err))
+		}
+		cmd.ReplyToID = &replyToID
+	}
+
+	msg, err := h.messagingService.SendMessage(ctx, cmd)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SendMessageResponse{
+		Message: converter.MessageToProto(msg),
+	}), nil
+}
+
+func (h *MessagingHandler) GetMessage(ctx context.Context, req *connect.Request[kinv1.GetMessageRequest]) (*connect.Response[kinv1.GetMessageResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	messageID, err := uuid.Parse(req.Msg.MessageId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'message_id': %w", err))
+	}
+
+	msg, err := h.messagingService.GetMessage(ctx, messaging.GetMessageQuery{
+		MessageID: messageID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.GetMessageResponse{
+		Message: converter.MessageToProto(msg),
+	}), nil
+}
+
+func (h *MessagingHandler) ListMessages(ctx context.Context, req *connect.Request[kinv1.ListMessagesRequest]) (*connect.Response[kinv1.ListMessagesResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	query := messaging.ListMessagesQuery{
+		ConversationID: conversationID,
+		UserID:         userID,
+		Limit:          int(req.Msg.Limit),
+	}
+
+	if req.Msg.Cursor != nil && *req.Msg.Cursor != "" {
+		cursor, err := parseMessageCursor(*req.Msg.Cursor)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid value for parameter 'cursor': %w", err))
+		}
+		query.Cursor = cursor
+	}
+
+	messages, err := h.messagingService.ListMessages(ctx, query)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	var nextCursor string
+	if len(messages) > 0 {
+		last := messages[len(messages)-1]
+		nextCursor = formatMessageCursor(last.CreatedAt, last.ID)
+	}
+
+	return connect.NewResponse(&kinv1.ListMessagesResponse{
+		Messages:   converter.MessagesToProto(messages),
+		NextCursor: nextCursor,
+	}), nil
+}
+
+func (h *MessagingHandler) EditMessage(ctx context.Context, req *connect.Request[kinv1.EditMessageRequest]) (*connect.Response[kinv1.EditMessageResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	messageID, err := uuid.Parse(req.Msg.MessageId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'message_id': %w", err))
+	}
+
+	if req.Msg.Content == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'content' is required"))
+	}
+
+	content, err := converter.ContentFromProto(req.Msg.Content)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'content.media_id': %w", err))
+	}
+
+	msg, err := h.messagingService.EditMessage(ctx, messaging.EditMessageCommand{
+		MessageID: messageID,
+		UserID:    userID,
+		Content:   content,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.EditMessageResponse{
+		Message: converter.MessageToProto(msg),
+	}), nil
+}
+
+func (h *MessagingHandler) DeleteMessage(ctx context.Context, req *connect.Request[kinv1.DeleteMessageRequest]) (*connect.Response[kinv1.DeleteMessageResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	messageID, err := uuid.Parse(req.Msg.MessageId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'message_id': %w", err))
+	}
+
+	err = h.messagingService.DeleteMessage(ctx, messaging.DeleteMessageCommand{
+		MessageID:   messageID,
+		UserID:      userID,
+		ForEveryone: req.Msg.ForEveryone,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.DeleteMessageResponse{}), nil
+}
+
+func (h *MessagingHandler) AddReaction(ctx context.Context, req *connect.Request[kinv1.AddReactionRequest]) (*connect.Response[kinv1.AddReactionResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	messageID, err := uuid.Parse(req.Msg.MessageId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'message_id': %w", err))
+	}
+
+	if req.Msg.Emoji == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'emoji' is required"))
+	}
+
+	reaction, err := h.messagingService.AddReaction(ctx, messaging.AddReactionCommand{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     req.Msg.Emoji,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.AddReactionResponse{
+		Reaction: converter.ReactionToProto(reaction),
+	}), nil
+}
+
+func (h *MessagingHandler) RemoveReaction(ctx context.Context, req *connect.Request[kinv1.RemoveReactionRequest]) (*connect.Response[kinv1.RemoveReactionResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	messageID, err := uuid.Parse(req.Msg.MessageId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'message_id': %w", err))
+	}
+
+	if req.Msg.Emoji == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'emoji' is required"))
+	}
+
+	err = h.messagingService.RemoveReaction(ctx, messaging.RemoveReactionCommand{
+		MessageID: messageID,
+		UserID:    userID,
+		Emoji:     req.Msg.Emoji,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.RemoveReactionResponse{}), nil
+}
+
+func (h *MessagingHandler) ListReactions(ctx context.Context, req *connect.Request[kinv1.ListReactionsRequest]) (*connect.Response[kinv1.ListReactionsResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	messageID, err := uuid.Parse(req.Msg.MessageId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'message_id': %w", err))
+	}
+
+	reactions, err := h.messagingService.ListReactions(ctx, messaging.ListMessageReactionsQuery{
+		MessageID: messageID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListReactionsResponse{
+		Reactions: converter.ReactionsToProto(reactions),
+		Summaries: converter.ReactionSummariesToProto(domainmessaging.GetReactionSummaries(reactions)),
+	}), nil
+}
+
+func (h *MessagingHandler) MarkAsRead(ctx context.Context, req *connect.Request[kinv1.MarkAsReadRequest]) (*connect.Response[kinv1.MarkAsReadResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	upToMessageID, err := uuid.Parse(req.Msg.UpToMessageId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'up_to_message_id': %w", err))
+	}
+
+	err = h.messagingService.MarkAsRead(ctx, messaging.MarkAsReadCommand{
+		ConversationID: conversationID,
+		UserID:         userID,
+		UpToMessageID:  upToMessageID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.MarkAsReadResponse{}), nil
+}
+
+func (h *MessagingHandler) MarkAsDelivered(ctx context.Context, req *connect.Request[kinv1.MarkAsDeliveredRequest]) (*connect.Response[kinv1.MarkAsDeliveredResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	messageIDs := make([]uuid.UUID, 0, len(req.Msg.MessageIds))
+	for _, id := range req.Msg.MessageIds {
+		messageID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'message_ids': %w", err))
+		}
+		messageIDs = append(messageIDs, messageID)
+	}
+
+	err := h.messagingService.MarkAsDelivered(ctx, messaging.MarkAsDeliveredCommand{
+		MessageIDs: messageIDs,
+		UserID:     userID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.MarkAsDeliveredResponse{}), nil
+}
+
+func (h *MessagingHandler) SearchMessages(ctx context.Context, req *connect.Request[kinv1.SearchMessagesRequest]) (*connect.Response[kinv1.SearchMessagesResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	if req.Msg.Query == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'query' is required"))
+	}
+
+	messages, err := h.messagingService.SearchMessages(ctx, messaging.SearchMessagesQuery{
+		ConversationID: conversationID,
+		UserID:         userID,
+		Query:          req.Msg.Query,
+		Limit:          int(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SearchMessagesResponse{
+		Messages: converter.MessagesToProto(messages),
+	}), nil
+}
+
+// Message cursors are the creation time and ID of the last message on the
+// page. A bare timestamp, as issued before IDs were added, is still accepted.
+func formatMessageCursor(createdAt time.Time, id uuid.UUID) string {
+	return createdAt.UTC().Format(time.RFC3339Nano) + "_" + id.String()
+}
+
+func parseMessageCursor(s string) (*domainmessaging.Cursor, error) {
+	at, id, hasID := strings.Cut(s, "_")
+	createdAt, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := &domainmessaging.Cursor{CreatedAt: createdAt}
+	if hasID {
+		if cursor.ID, err = uuid.Parse(id); err != nil {
+			return nil, err
+		}
+	}
+	return cursor, nil
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestMessageCursor(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 12, 30, 0, 123456789, time.FixedZone("CET", 3600))
+	id := uuid.New()
+
+	cursor, err := parseMessageCursor(formatMessageCursor(createdAt, id))
+	if err != nil {
+		t.Fatalf("parseMessageCursor: %v", err)
+	}
+	if !cursor.CreatedAt.Equal(createdAt) || cursor.ID != id {
+		t.Errorf("cursor = (%v, %v), want (%v, %v)", cursor.CreatedAt, cursor.ID, createdAt, id)
+	}
+
+	// Cursors issued before the ID tie-breaker carry only the timestamp.
+	cursor, err = parseMessageCursor(createdAt.Format(time.RFC3339Nano))
+	if err != nil {
+		t.Fatalf("parseMessageCursor of a bare timestamp: %v", err)
+	}
+	if !cursor.CreatedAt.Equal(createdAt) || cursor.ID != uuid.Nil {
+		t.Errorf("cursor = (%v, %v), want (%v, nil ID)", cursor.CreatedAt, cursor.ID, createdAt)
+	}
+
+	for _, bad := range []string{"", "yesterday", createdAt.Format(time.RFC3339Nano) + "_nope"} {
+		if _, err := parseMessageCursor(bad); err == nil {
+			t.Errorf("parseMessageCursor(%q) accepted an invalid cursor", bad)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
+	"github.com/danielng/kin-core-svc/gen/proto/kin/v1/kinv1connect"
+	"github.com/danielng/kin-core-svc/internal/application/presence"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/converter"
+	"github.com/danielng/kin-core-svc/internal/interfaces/connect/interceptors"
+	"github.com/google/uuid"
+)
+
+type PresenceHandler struct {
+	kinv1connect.UnimplementedPresenceServiceHandler
+	presenceService *presence.Service
+}
+
+func NewPresenceHandler(presenceService *presence.Service) *PresenceHandler {
+	return &PresenceHandler{
+		presenceService: presenceService,
+	}
+}
+
+func (h *PresenceHandler) Heartbeat(ctx context.Context, req *connect.Request[kinv1.HeartbeatRequest]) (*connect.Response[kinv1.HeartbeatResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	p, err := h.presenceService.Heartbeat(ctx, presence.HeartbeatCommand{
+		UserID:     userID,
+		DeviceType: converter.DeviceTypeFromProto(req.Msg.DeviceType),
+		DeviceID:   req.Msg.DeviceId,
+		Away:       req.Msg.Away,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.HeartbeatResponse{
+		Presence: converter.PresenceToProto(p),
+	}), nil
+}
+
+func (h *PresenceHandler) GoOffline(ctx context.Context, req *connect.Request[kinv1.GoOfflineRequest]) (*connect.Response[kinv1.GoOfflineResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	if err := h.presenceService.GoOffline(ctx, presence.GoOfflineCommand{
+		UserID:     userID,
+		DeviceType: converter.DeviceTypeFromProto(req.Msg.DeviceType),
+		DeviceID:   req.Msg.DeviceId,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.GoOfflineResponse{}), nil
+}
+
+func (h *PresenceHandler) GetPresence(ctx context.Context, req *connect.Request[kinv1.GetPresenceRequest]) (*connect.Response[kinv1.GetPresenceResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	p, err := h.presenceService.GetPresence(ctx, presence.GetPresenceQuery{UserID: userID})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.GetPresenceResponse{
+		Presence: converter.PresenceToProto(p),
+	}), nil
+}
+
+func (h *PresenceHandler) ListCirclePresences(ctx context.Context, req *connect.Request[kinv1.ListCirclePresencesRequest]) (*connect.Response[kinv1.ListCirclePresencesResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	circleID, err := uuid.Parse(req.Msg.CircleId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'circle_id': %w", err))
+	}
+
+	presences, err := h.presenceService.ListCirclePresences(ctx, presence.ListCirclePresencesQuery{
+		UserID:   userID,
+		CircleID: circleID,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ListCirclePresencesResponse{
+		Presences: converter.PresencesToProto(presences),
+	}), nil
+}
+
+func (h *PresenceHandler) SetTyping(ctx context.Context, req *connect.Request[kinv1.SetTypingRequest]) (*connect.Response[kinv1.SetTypingResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	if err := h.presenceService.SetTyping(ctx, presence.SetTypingCommand{
+		UserID:         userID,
+		ConversationID: conversationID,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SetTypingResponse{}), nil
+}
+
+func (h *PresenceHandler) ClearTyping(ctx context.Context, req *connect.Request[kinv1.ClearTypingRequest]) (*connect.Response[kinv1.ClearTypingResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user ID in context"))
+	}
+
+	conversationID, err := uuid.Parse(req.Msg.ConversationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid UUID for parameter 'conversation_id': %w", err))
+	}
+
+	if err := h.presenceService.ClearTyping(ctx, presence.ClearTypingCommand{
+		UserID:         userID,
+		ConversationID: conversationID,
+	}); err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.ClearTypingResponse{}), nil
+}
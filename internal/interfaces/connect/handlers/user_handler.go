@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"connectrpc.com/connect"
 	kinv1 "github.com/danielng/kin-core-svc/gen/proto/kin/v1"
@@ -121,6 +123,11 @@ func (h *UserHandler) UpdatePreferences(ctx context.Context, req *connect.Reques
 		QuietHoursEnd:         req.Msg.QuietHoursEnd,
 	}
 
+	if req.Msg.LocationRetention != nil {
+		retention := string(converter.LocationRetentionFromProto(*req.Msg.LocationRetention))
+		cmd.LocationRetention = &retention
+	}
+
 	if req.Msg.DefaultPrivacyLevel != nil {
 		level := string(converter.PrivacyLevelFromProto(*req.Msg.DefaultPrivacyLevel))
 		cmd.DefaultPrivacyLevel = &level
@@ -135,3 +142,27 @@ func (h *UserHandler) UpdatePreferences(ctx context.Context, req *connect.Reques
 		Preferences: converter.PreferencesToProto(prefs),
 	}), nil
 }
+
+func (h *UserHandler) SearchUsers(ctx context.Context, req *connect.Request[kinv1.SearchUsersRequest]) (*connect.Response[kinv1.SearchUsersResponse], error) {
+	userID, ok := ctx.Value(interceptors.UserIDKey).(uuid.UUID)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if strings.TrimSpace(req.Msg.Query) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validation failed: 'query' is required"))
+	}
+
+	users, err := h.userService.SearchUsers(ctx, user.SearchUsersQuery{
+		UserID: userID,
+		Query:  req.Msg.Query,
+		Limit:  int(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return connect.NewResponse(&kinv1.SearchUsersResponse{
+		Users: converter.UserProfilesToProto(users),
+	}), nil
+}
@@ -13,7 +13,14 @@ import (
 	"connectrpc.com/grpcreflect"
 	"connectrpc.com/otelconnect"
 	"github.com/danielng/kin-core-svc/gen/proto/kin/v1/kinv1connect"
+	"github.com/danielng/kin-core-svc/internal/application/availability"
 	"github.com/danielng/kin-core-svc/internal/application/circle"
+	"github.com/danielng/kin-core-svc/internal/application/contact"
+	"github.com/danielng/kin-core-svc/internal/application/conversation"
+	"github.com/danielng/kin-core-svc/internal/application/location"
+	"github.com/danielng/kin-core-svc/internal/application/messaging"
+	"github.com/danielng/kin-core-svc/internal/application/presence"
+	"github.com/danielng/kin-core-svc/internal/application/realtime"
 	"github.com/danielng/kin-core-svc/internal/application/user"
 	"github.com/danielng/kin-core-svc/internal/infrastructure/auth"
 	"github.com/danielng/kin-core-svc/internal/interfaces/connect/handlers"
@@ -34,14 +41,21 @@ type BuildInfo struct {
 }
 
 type ServerConfig struct {
-	Logger           *slog.Logger
-	Auth0Validator   *auth.Auth0Validator
-	UserService      *user.Service
-	CircleService    *circle.Service
-	BuildInfo        BuildInfo
-	HealthCheckers   []HealthChecker
-	EnableTracing    bool
-	EnableReflection bool // Enable gRPC reflection (for development only)
+	Logger              *slog.Logger
+	Auth0Validator      *auth.Auth0Validator
+	UserService         *user.Service
+	CircleService       *circle.Service
+	MessagingService    *messaging.Service
+	ConversationService *conversation.Service
+	RealtimeService     *realtime.Service
+	ContactService      *contact.Service
+	AvailabilityService *availability.Service
+	LocationService     *location.Service
+	PresenceService     *presence.Service
+	BuildInfo           BuildInfo
+	HealthCheckers      []HealthChecker
+	EnableTracing       bool
+	EnableReflection    bool // Enable gRPC reflection (for development only)
 }
 
 func (cfg ServerConfig) validate() error {
@@ -53,6 +67,13 @@ func (cfg ServerConfig) validate() error {
 		{cfg.Auth0Validator != nil, "Auth0Validator is required"},
 		{cfg.UserService != nil, "UserService is required"},
 		{cfg.CircleService != nil, "CircleService is required"},
+		{cfg.MessagingService != nil, "MessagingService is required"},
+		{cfg.ConversationService != nil, "ConversationService is required"},
+		{cfg.RealtimeService != nil, "RealtimeService is required"},
+		{cfg.ContactService != nil, "ContactService is required"},
+		{cfg.AvailabilityService != nil, "AvailabilityService is required"},
+		{cfg.LocationService != nil, "LocationService is required"},
+		{cfg.PresenceService != nil, "PresenceService is required"},
 		{cfg.BuildInfo.Version != "", "BuildInfo.Version is required"},
 		{cfg.HealthCheckers != nil, "HealthCheckers is required"},
 	}
@@ -102,6 +123,13 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 
 	userHandler := handlers.NewUserHandler(cfg.UserService)
 	circleHandler := handlers.NewCircleHandler(cfg.CircleService)
+	messagingHandler := handlers.NewMessagingHandler(cfg.MessagingService)
+	conversationHandler := handlers.NewConversationHandler(cfg.ConversationService)
+	eventHandler := handlers.NewEventHandler(cfg.RealtimeService)
+	contactHandler := handlers.NewContactHandler(cfg.ContactService)
+	availabilityHandler := handlers.NewAvailabilityHandler(cfg.AvailabilityService)
+	locationHandler := handlers.NewLocationHandler(cfg.LocationService)
+	presenceHandler := handlers.NewPresenceHandler(cfg.PresenceService)
 
 	path, handler := kinv1connect.NewUserServiceHandler(userHandler, handlerOpts...)
 	mux.Handle(path, handler)
@@ -109,11 +137,39 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 	path, handler = kinv1connect.NewCircleServiceHandler(circleHandler, handlerOpts...)
 	mux.Handle(path, handler)
 
+	path, handler = kinv1connect.NewMessagingServiceHandler(messagingHandler, handlerOpts...)
+	mux.Handle(path, handler)
+
+	path, handler = kinv1connect.NewConversationServiceHandler(conversationHandler, handlerOpts...)
+	mux.Handle(path, handler)
+
+	path, handler = kinv1connect.NewEventServiceHandler(eventHandler, handlerOpts...)
+	mux.Handle(path, withoutDeadlines(handler))
+
+	path, handler = kinv1connect.NewContactServiceHandler(contactHandler, handlerOpts...)
+	mux.Handle(path, handler)
+
+	path, handler = kinv1connect.NewAvailabilityServiceHandler(availabilityHandler, handlerOpts...)
+	mux.Handle(path, handler)
+
+	path, handler = kinv1connect.NewLocationServiceHandler(locationHandler, handlerOpts...)
+	mux.Handle(path, handler)
+
+	path, handler = kinv1connect.NewPresenceServiceHandler(presenceHandler, handlerOpts...)
+	mux.Handle(path, handler)
+
 	// Enable gRPC reflection for development (allows service discovery in gRPC clients)
 	if cfg.EnableReflection {
 		reflector := grpcreflect.NewStaticReflector(
 			kinv1connect.UserServiceName,
 			kinv1connect.CircleServiceName,
+			kinv1connect.MessagingServiceName,
+			kinv1connect.ConversationServiceName,
+			kinv1connect.EventServiceName,
+			kinv1connect.ContactServiceName,
+			kinv1connect.AvailabilityServiceName,
+			kinv1connect.LocationServiceName,
+			kinv1connect.PresenceServiceName,
 		)
 		mux.Handle(grpcreflect.NewHandlerV1(reflector))
 		mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
@@ -138,6 +194,17 @@ func (s *Server) Handler() http.Handler {
 	return s.handler
 }
 
+// withoutDeadlines lifts the server's read and write timeouts for long-lived
+// streaming RPCs, which would otherwise be cut off mid-stream.
+func withoutDeadlines(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rc := http.NewResponseController(w)
+		_ = rc.SetReadDeadline(time.Time{})
+		_ = rc.SetWriteDeadline(time.Time{})
+		next.ServeHTTP(w, r)
+	})
+}
+
 type healthResponse struct {
 	Status       string                  `json:"status"`
 	Dependencies map[string]healthStatus `json:"dependencies,omitempty"`
@@ -20,20 +20,24 @@ const (
 	CodeTooManyRequest ErrorCode = "TOO_MANY_REQUESTS"
 
 	// Domain-specific errors
-	CodeUserNotFound         ErrorCode = "USER_NOT_FOUND"
-	CodeUserAlreadyExists    ErrorCode = "USER_ALREADY_EXISTS"
-	CodeCircleNotFound       ErrorCode = "CIRCLE_NOT_FOUND"
-	CodeCircleMemberNotFound ErrorCode = "CIRCLE_MEMBER_NOT_FOUND"
-	CodeNotCircleMember      ErrorCode = "NOT_CIRCLE_MEMBER"
-	CodeNotCircleAdmin       ErrorCode = "NOT_CIRCLE_ADMIN"
-	CodeConversationNotFound ErrorCode = "CONVERSATION_NOT_FOUND"
-	CodeMessageNotFound      ErrorCode = "MESSAGE_NOT_FOUND"
-	CodeContactNotFound      ErrorCode = "CONTACT_NOT_FOUND"
-	CodeContactAlreadyExists ErrorCode = "CONTACT_ALREADY_EXISTS"
-	CodeInvitationNotFound   ErrorCode = "INVITATION_NOT_FOUND"
-	CodeInvitationExpired    ErrorCode = "INVITATION_EXPIRED"
-	CodeInvalidMediaType     ErrorCode = "INVALID_MEDIA_TYPE"
-	CodeMediaTooLarge        ErrorCode = "MEDIA_TOO_LARGE"
+	CodeUserNotFound             ErrorCode = "USER_NOT_FOUND"
+	CodeUserAlreadyExists        ErrorCode = "USER_ALREADY_EXISTS"
+	CodeCircleNotFound           ErrorCode = "CIRCLE_NOT_FOUND"
+	CodeCircleMemberNotFound     ErrorCode = "CIRCLE_MEMBER_NOT_FOUND"
+	CodeNotCircleMember          ErrorCode = "NOT_CIRCLE_MEMBER"
+	CodeNotCircleAdmin           ErrorCode = "NOT_CIRCLE_ADMIN"
+	CodeConversationNotFound     ErrorCode = "CONVERSATION_NOT_FOUND"
+	CodeMessageNotFound          ErrorCode = "MESSAGE_NOT_FOUND"
+	CodeContactNotFound          ErrorCode = "CONTACT_NOT_FOUND"
+	CodeContactAlreadyExists     ErrorCode = "CONTACT_ALREADY_EXISTS"
+	CodeContactRequestNotFound   ErrorCode = "CONTACT_REQUEST_NOT_FOUND"
+	CodeContactRequestExists     ErrorCode = "CONTACT_REQUEST_EXISTS"
+	CodeContactRequestNotPending ErrorCode = "CONTACT_REQUEST_NOT_PENDING"
+	CodeContactBlocked           ErrorCode = "CONTACT_BLOCKED"
+	CodeInvitationNotFound       ErrorCode = "INVITATION_NOT_FOUND"
+	CodeInvitationExpired        ErrorCode = "INVITATION_EXPIRED"
+	CodeInvalidMediaType         ErrorCode = "INVALID_MEDIA_TYPE"
+	CodeMediaTooLarge            ErrorCode = "MEDIA_TOO_LARGE"
 )
 
 type AppError struct {